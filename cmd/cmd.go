@@ -1,25 +1,42 @@
 package cmd
 
 import (
+	"context"
 	"embed"
 	"os"
 	"os/signal"
 	"runtime"
 	"syscall"
+	"time"
 
 	"github.com/Raj63/golang-rest-api/pkg/infrastructure/logger"
 	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/http"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rpc"
 	sdksql "github.com/Raj63/golang-rest-api/pkg/infrastructure/sql"
 	"github.com/spf13/cobra"
 )
 
 // CommandDI is used to inject command dependencies
 type CommandDI struct {
-	HTTPServer  *http.Server
-	HTTPSServer *http.Server
-	Logger      *logger.Logger
-	DB          *sdksql.DB
-	EmbedFS     embed.FS
+	HTTPServer          *http.Server
+	HTTPSServer         *http.Server
+	GRPCServer          *rpc.Server
+	Logger              *logger.Logger
+	DB                  *sdksql.DB
+	EmbedFS             embed.FS
+	AggregationInterval time.Duration
+	WebhookInterval     time.Duration
+}
+
+// appServer is implemented by the HTTP(S) and gRPC servers run by the serve command
+type appServer interface {
+	Addr() string
+	Type() string
+	PreStartCallback() func() error
+	Serve() error
+	GracefulStop() error
+	GracefulShutdownHandler() error
+	TracerProviderShutdownHandler() error
 }
 
 // NewCommand returns a new Set of commands for the given server
@@ -35,10 +52,16 @@ func NewCommand(di CommandDI) *cobra.Command {
 	// initialise commands
 	serveCmd := serveCommand(di)
 	dbUpdateCmd := dbUpdateCommand(di)
+	privacyCmd := privacyCommand(di)
+	reportsCmd := reportsCommand(di)
+	menuCmd := menuCommand(di)
 
 	// append commands
 	cmd.AddCommand(serveCmd)
 	cmd.AddCommand(dbUpdateCmd)
+	cmd.AddCommand(privacyCmd)
+	cmd.AddCommand(reportsCmd)
+	cmd.AddCommand(menuCmd)
 
 	return cmd
 }
@@ -46,7 +69,7 @@ func NewCommand(di CommandDI) *cobra.Command {
 func serveCommand(di CommandDI) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "serve",
-		Short: "Run the HTTP server",
+		Short: "Run the HTTP and gRPC servers",
 		Run: func(cmd *cobra.Command, args []string) {
 			defer func() {
 				if err := di.Logger.Sync(); err != nil {
@@ -64,6 +87,17 @@ func serveCommand(di CommandDI) *cobra.Command {
 				defer di.DB.Close()
 			}
 
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			// refresh the sales aggregates in the background
+			if di.DB != nil && di.AggregationInterval > 0 {
+				go aggregate(ctx, di)
+			}
+			// send the due webhook deliveries in the background
+			if di.DB != nil && di.WebhookInterval > 0 {
+				go deliverWebhooks(ctx, di)
+			}
+
 			di.Logger.Info("Server initiating...")
 			// serve HTTP server
 			if di.HTTPServer != nil {
@@ -75,6 +109,11 @@ func serveCommand(di CommandDI) *cobra.Command {
 				serve(di.HTTPSServer, di.Logger)
 			}
 
+			// serve gRPC server
+			if di.GRPCServer != nil {
+				serve(di.GRPCServer, di.Logger)
+			}
+
 			waitForExitSignal()
 
 			// close HTTP server
@@ -86,13 +125,18 @@ func serveCommand(di CommandDI) *cobra.Command {
 			if di.HTTPSServer != nil {
 				close(di.HTTPSServer, di.Logger)
 			}
+
+			// close gRPC server
+			if di.GRPCServer != nil {
+				close(di.GRPCServer, di.Logger)
+			}
 		},
 	}
 
 	return cmd
 }
 
-func serve(server *http.Server, logger *logger.Logger) {
+func serve(server appServer, logger *logger.Logger) {
 	go func() {
 		logger.Infof(
 			"* Go Version: %s, Env: %s",
@@ -114,7 +158,7 @@ func serve(server *http.Server, logger *logger.Logger) {
 	}()
 }
 
-func close(server *http.Server, logger *logger.Logger) {
+func close(server appServer, logger *logger.Logger) {
 	logger.Infof("* Gracefully shutting down the %s server...", server.Type())
 	if err := server.GracefulStop(); err != nil {
 		logger.Error(err)
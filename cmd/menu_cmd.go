@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+
+	menuService "github.com/Raj63/golang-rest-api/pkg/app/usecases/menu"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/adapter"
+	"github.com/spf13/cobra"
+)
+
+func menuCommand(di CommandDI) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "menu",
+		Short: "Manage the menus of the restaurant",
+	}
+
+	cmd.AddCommand(menuImportCommand(di))
+
+	return cmd
+}
+
+func menuImportCommand(di CommandDI) *cobra.Command {
+	var format string
+	var dryRun bool
+
+	cmd := &cobra.Command{
+		Use:   "import FILE",
+		Short: "Create or update, matching by name, the menus of a CSV or JSON file, all or nothing",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			file, err := os.Open(args[0])
+			if err != nil {
+				di.Logger.Fatal(err)
+			}
+			defer file.Close()
+			if format == "" {
+				format = strings.TrimPrefix(strings.ToLower(filepath.Ext(args[0])), ".")
+			}
+
+			service := openMenuService(di)
+			defer di.DB.Close()
+
+			result, err := service.Import(context.Background(), file, format, dryRun)
+			if err != nil {
+				di.Logger.Fatal(err)
+			}
+
+			encoder := json.NewEncoder(os.Stdout)
+			encoder.SetIndent("", "  ")
+			if err := encoder.Encode(result); err != nil {
+				di.Logger.Fatal(err)
+			}
+			switch {
+			case result.Applied:
+				di.Logger.Infof("* The %d menus of %s are imported: %d created, %d updated, %d unchanged.", result.Rows, args[0], result.Created, result.Updated, result.Unchanged)
+			case len(result.Errors) > 0:
+				di.Logger.Fatalf("%d errors are found in %s, nothing is imported", len(result.Errors), args[0])
+			}
+		},
+	}
+
+	cmd.Flags().StringVar(&format, "format", "", "format of the file, csv or json, defaults to the file extension")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "report the changes and the invalid rows without writing")
+
+	return cmd
+}
+
+func openMenuService(di CommandDI) menuService.Service {
+	if err := di.DB.Open(); err != nil {
+		di.Logger.Fatal(err)
+	}
+
+	return adapter.MenuService(di.DB, di.Logger)
+}
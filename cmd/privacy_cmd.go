@@ -0,0 +1,109 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+
+	privacyService "github.com/Raj63/golang-rest-api/pkg/app/usecases/privacy"
+	domainPrivacy "github.com/Raj63/golang-rest-api/pkg/domain/privacy"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/adapter"
+	"github.com/spf13/cobra"
+)
+
+func privacyCommand(di CommandDI) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "privacy",
+		Short: "Export or anonymise the personal data of a diner",
+	}
+
+	cmd.AddCommand(privacyExportCommand(di))
+	cmd.AddCommand(privacyAnonymiseCommand(di))
+
+	return cmd
+}
+
+func privacyExportCommand(di CommandDI) *cobra.Command {
+	var request privacyService.NewRequest
+	var dinerID int64
+	var output string
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export everything held about a diner as a JSON archive",
+		Run: func(cmd *cobra.Command, args []string) {
+			service := openPrivacyService(di)
+			defer di.DB.Close()
+
+			request.Channel = domainPrivacy.ChannelCLI
+			archive, err := service.Export(context.Background(), dinerID, &request)
+			if err != nil {
+				di.Logger.Fatal(err)
+			}
+
+			out := os.Stdout
+			if output != "" {
+				out, err = os.Create(output)
+				if err != nil {
+					di.Logger.Fatal(err)
+				}
+				defer out.Close()
+			}
+
+			encoder := json.NewEncoder(out)
+			encoder.SetIndent("", "  ")
+			if err := encoder.Encode(archive); err != nil {
+				di.Logger.Fatal(err)
+			}
+			if output != "" {
+				di.Logger.Infof("* The personal data of diner %d is exported to %s.", dinerID, output)
+			}
+		},
+	}
+
+	privacyFlags(cmd, &dinerID, &request)
+	cmd.Flags().StringVarP(&output, "output", "o", "", "file to write the archive to, defaults to stdout")
+
+	return cmd
+}
+
+func privacyAnonymiseCommand(di CommandDI) *cobra.Command {
+	var request privacyService.NewRequest
+	var dinerID int64
+
+	cmd := &cobra.Command{
+		Use:   "anonymise",
+		Short: "Erase the personal data of a diner, keeping their order and payment history",
+		Run: func(cmd *cobra.Command, args []string) {
+			service := openPrivacyService(di)
+			defer di.DB.Close()
+
+			request.Channel = domainPrivacy.ChannelCLI
+			record, err := service.Anonymise(context.Background(), dinerID, &request)
+			if err != nil {
+				di.Logger.Fatal(err)
+			}
+			di.Logger.Infof("* Diner %d is anonymised, audit record %d.", dinerID, record.ID)
+		},
+	}
+
+	privacyFlags(cmd, &dinerID, &request)
+
+	return cmd
+}
+
+func privacyFlags(cmd *cobra.Command, dinerID *int64, request *privacyService.NewRequest) {
+	cmd.Flags().Int64Var(dinerID, "diner-id", 0, "id of the diner")
+	cmd.Flags().StringVar(&request.RequestedBy, "requested-by", "", "who asked for the request, kept in the audit record")
+	cmd.Flags().StringVar(&request.Reason, "reason", "", "why the request is made, kept in the audit record")
+	_ = cmd.MarkFlagRequired("diner-id")
+	_ = cmd.MarkFlagRequired("requested-by")
+}
+
+func openPrivacyService(di CommandDI) privacyService.Service {
+	if err := di.DB.Open(); err != nil {
+		di.Logger.Fatal(err)
+	}
+
+	return adapter.PrivacyService(di.DB, di.Logger)
+}
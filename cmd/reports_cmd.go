@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"context"
+	"time"
+
+	reportService "github.com/Raj63/golang-rest-api/pkg/app/usecases/report"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/adapter"
+	"github.com/spf13/cobra"
+)
+
+const dateLayout = "2006-01-02"
+
+func reportsCommand(di CommandDI) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reports",
+		Short: "Maintain the precomputed sales aggregates the reports read from",
+	}
+
+	cmd.AddCommand(reportsBackfillCommand(di))
+
+	return cmd
+}
+
+func reportsBackfillCommand(di CommandDI) *cobra.Command {
+	var fromStr, toStr string
+
+	cmd := &cobra.Command{
+		Use:   "backfill",
+		Short: "Recompute the sales aggregates of every day within a date range",
+		Run: func(cmd *cobra.Command, args []string) {
+			from, err := time.ParseInLocation(dateLayout, fromStr, time.Local)
+			if err != nil {
+				di.Logger.Fatalf("--from must be a date formatted as YYYY-MM-DD: %v", err)
+			}
+			to, err := time.ParseInLocation(dateLayout, toStr, time.Local)
+			if err != nil {
+				di.Logger.Fatalf("--to must be a date formatted as YYYY-MM-DD: %v", err)
+			}
+
+			service := openReportService(di)
+			defer di.DB.Close()
+
+			days, err := service.Backfill(context.Background(), from, to)
+			if err != nil {
+				di.Logger.Fatalf("backfill stopped after %d days: %v", days, err)
+			}
+			di.Logger.Infof("* The sales aggregates of %d days from %s to %s are recomputed.", days, fromStr, toStr)
+		},
+	}
+
+	cmd.Flags().StringVar(&fromStr, "from", "", "first day to recompute (YYYY-MM-DD)")
+	cmd.Flags().StringVar(&toStr, "to", time.Now().Format(dateLayout), "last day to recompute (YYYY-MM-DD)")
+	_ = cmd.MarkFlagRequired("from")
+
+	return cmd
+}
+
+// aggregate refreshes the sales aggregates every AggregationInterval until the context is cancelled
+func aggregate(ctx context.Context, di CommandDI) {
+	service := adapter.ReportService(di.DB, di.Logger)
+	ticker := time.NewTicker(di.AggregationInterval)
+	defer ticker.Stop()
+
+	for {
+		days, err := service.RefreshAggregates(ctx)
+		if err != nil {
+			di.Logger.Errorf("error refreshing the sales aggregates: %v", err)
+		} else {
+			di.Logger.Infof("* The sales aggregates of %d days are refreshed.", days)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func openReportService(di CommandDI) reportService.Service {
+	if err := di.DB.Open(); err != nil {
+		di.Logger.Fatal(err)
+	}
+
+	return adapter.ReportService(di.DB, di.Logger)
+}
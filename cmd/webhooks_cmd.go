@@ -0,0 +1,39 @@
+package cmd
+
+import (
+	"context"
+	"time"
+
+	webhookService "github.com/Raj63/golang-rest-api/pkg/app/usecases/webhook"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/adapter"
+)
+
+// deliverWebhooks attempts the due webhook deliveries every WebhookInterval until the context is cancelled
+func deliverWebhooks(ctx context.Context, di CommandDI) {
+	service := adapter.WebhookService(di.DB, di.Logger)
+	ticker := time.NewTicker(di.WebhookInterval)
+	defer ticker.Stop()
+
+	for {
+		// a full batch is followed by the next one right away, the backlog being sent before the next tick
+		for {
+			attempted, err := service.DeliverDue(ctx)
+			if err != nil {
+				di.Logger.Errorf("error delivering the webhooks after %d deliveries: %v", attempted, err)
+				break
+			}
+			if attempted > 0 {
+				di.Logger.Infof("* %d webhook deliveries are attempted.", attempted)
+			}
+			if attempted < webhookService.DueBatchSize || ctx.Err() != nil {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
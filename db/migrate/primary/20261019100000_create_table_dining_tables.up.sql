@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS `dining_tables` (
+  `id` BIGINT auto_increment NOT NULL,
+  `table_no` int NOT NULL,
+  `capacity` int NOT NULL,
+  `section` varchar(60) NOT NULL DEFAULT 'main',
+  `created_at` timestamp DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  `updated_at` timestamp DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP NOT NULL,
+  PRIMARY KEY (`id`),
+  INDEX `dining_tables_capacity_IDX` (`capacity`),
+  CONSTRAINT uc_dining_tables_table_no UNIQUE (`table_no`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
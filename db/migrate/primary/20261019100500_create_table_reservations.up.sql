@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS `reservations` (
+  `id` BIGINT auto_increment NOT NULL,
+  `name` varchar(250) NOT NULL,
+  `contact` varchar(120) NOT NULL DEFAULT '',
+  `party_size` int NOT NULL,
+  `table_no` int NOT NULL,
+  `reserved_at` datetime NOT NULL,
+  `duration_minutes` int NOT NULL DEFAULT 90,
+  `status` varchar(20) NOT NULL DEFAULT 'booked',
+  `created_at` timestamp DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  `updated_at` timestamp DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP NOT NULL,
+  PRIMARY KEY (`id`),
+  INDEX `reservations_reserved_at_IDX` (`reserved_at`),
+  INDEX `reservations_table_no_reserved_at_IDX` (`table_no`, `reserved_at`),
+  FOREIGN KEY (table_no) REFERENCES dining_tables (table_no) ON DELETE CASCADE
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS `waitlist_entries` (
+  `id` BIGINT auto_increment NOT NULL,
+  `name` varchar(250) NOT NULL,
+  `contact` varchar(120) NOT NULL DEFAULT '',
+  `party_size` int NOT NULL,
+  `status` varchar(20) NOT NULL DEFAULT 'waiting',
+  `table_no` int NOT NULL DEFAULT 0,
+  `notified_at` timestamp NULL DEFAULT NULL,
+  `created_at` timestamp DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  `updated_at` timestamp DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP NOT NULL,
+  PRIMARY KEY (`id`),
+  INDEX `waitlist_entries_status_created_at_IDX` (`status`, `created_at`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
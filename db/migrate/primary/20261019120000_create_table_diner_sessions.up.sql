@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS `diner_sessions` (
+  `id` BIGINT auto_increment NOT NULL,
+  `diner_id` BIGINT NOT NULL,
+  `table_no` int NOT NULL,
+  `checked_in_at` timestamp DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  `checked_out_at` timestamp NULL DEFAULT NULL,
+  `created_at` timestamp DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  `updated_at` timestamp DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP NOT NULL,
+  PRIMARY KEY (`id`),
+  INDEX `diner_sessions_diner_id_checked_out_at_IDX` (`diner_id`, `checked_out_at`),
+  INDEX `diner_sessions_table_no_checked_out_at_IDX` (`table_no`, `checked_out_at`),
+  FOREIGN KEY (diner_id) REFERENCES diners (id) ON DELETE CASCADE
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
@@ -0,0 +1 @@
+ALTER TABLE `diners` ADD CONSTRAINT uc_diners_tableno_name UNIQUE (`table_no`, `name`);
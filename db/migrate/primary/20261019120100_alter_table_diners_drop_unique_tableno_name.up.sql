@@ -0,0 +1 @@
+ALTER TABLE `diners` DROP INDEX `uc_diners_tableno_name`;
@@ -0,0 +1,6 @@
+ALTER TABLE `orders`
+  DROP INDEX `uc_orders_session_id_and_menu_id`,
+  DROP FOREIGN KEY fk_orders_session_id,
+  DROP INDEX `orders_session_id_IDX`,
+  DROP COLUMN `session_id`,
+  ADD CONSTRAINT uc_orders_diner_id_and_menu_id UNIQUE (`diner_id`, `menu_id`);
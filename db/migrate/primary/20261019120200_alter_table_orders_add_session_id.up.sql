@@ -0,0 +1,6 @@
+ALTER TABLE `orders`
+  ADD COLUMN `session_id` BIGINT NULL DEFAULT NULL AFTER `diner_id`,
+  ADD INDEX `orders_session_id_IDX` (`session_id`),
+  ADD CONSTRAINT fk_orders_session_id FOREIGN KEY (session_id) REFERENCES diner_sessions (id) ON DELETE CASCADE,
+  DROP INDEX `uc_orders_diner_id_and_menu_id`,
+  ADD CONSTRAINT uc_orders_session_id_and_menu_id UNIQUE (`session_id`, `menu_id`);
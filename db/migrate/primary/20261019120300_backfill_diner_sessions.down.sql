@@ -0,0 +1 @@
+DELETE FROM diner_sessions;
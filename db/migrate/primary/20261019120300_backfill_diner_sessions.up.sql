@@ -0,0 +1 @@
+INSERT INTO diner_sessions (diner_id, table_no, checked_in_at) SELECT id, table_no, created_at FROM diners;
@@ -0,0 +1 @@
+UPDATE orders SET session_id = NULL;
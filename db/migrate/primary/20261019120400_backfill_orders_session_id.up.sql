@@ -0,0 +1 @@
+UPDATE orders o INNER JOIN diner_sessions s ON s.diner_id = o.diner_id SET o.session_id = s.id WHERE o.session_id IS NULL;
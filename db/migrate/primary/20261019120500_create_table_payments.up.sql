@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS `payments` (
+  `id` BIGINT auto_increment NOT NULL,
+  `session_id` BIGINT NOT NULL,
+  `amount` int NOT NULL,
+  `paid_at` timestamp DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  `created_at` timestamp DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  `updated_at` timestamp DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP NOT NULL,
+  PRIMARY KEY (`id`),
+  INDEX `payments_paid_at_IDX` (`paid_at`),
+  CONSTRAINT uc_payments_session_id UNIQUE (`session_id`),
+  FOREIGN KEY (session_id) REFERENCES diner_sessions (id) ON DELETE CASCADE
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
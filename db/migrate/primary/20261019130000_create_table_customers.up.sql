@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS `customers` (
+  `id` BIGINT auto_increment NOT NULL,
+  `name` varchar(100) NOT NULL,
+  `email` varchar(255) NULL DEFAULT NULL,
+  `phone` varchar(32) NULL DEFAULT NULL,
+  `preferences` varchar(1000) DEFAULT '' NOT NULL,
+  `created_at` timestamp DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  `updated_at` timestamp DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP NOT NULL,
+  PRIMARY KEY (`id`),
+  CONSTRAINT uc_customers_email UNIQUE (`email`),
+  CONSTRAINT uc_customers_phone UNIQUE (`phone`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
@@ -0,0 +1,4 @@
+ALTER TABLE `diner_sessions`
+  DROP FOREIGN KEY fk_diner_sessions_customer_id,
+  DROP INDEX `diner_sessions_customer_id_IDX`,
+  DROP COLUMN `customer_id`;
@@ -0,0 +1,4 @@
+ALTER TABLE `diner_sessions`
+  ADD COLUMN `customer_id` BIGINT NULL DEFAULT NULL AFTER `diner_id`,
+  ADD INDEX `diner_sessions_customer_id_IDX` (`customer_id`),
+  ADD CONSTRAINT fk_diner_sessions_customer_id FOREIGN KEY (customer_id) REFERENCES customers (id) ON DELETE SET NULL;
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS `loyalty_ledger` (
+  `id` BIGINT auto_increment NOT NULL,
+  `customer_id` BIGINT NOT NULL,
+  `session_id` BIGINT NULL DEFAULT NULL,
+  `kind` varchar(20) NOT NULL,
+  `points` int NOT NULL,
+  `amount` int DEFAULT 0 NOT NULL,
+  `created_at` timestamp DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  `updated_at` timestamp DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP NOT NULL,
+  PRIMARY KEY (`id`),
+  INDEX `loyalty_ledger_customer_id_created_at_IDX` (`customer_id`, `created_at`),
+  CONSTRAINT uc_loyalty_ledger_session_id_and_kind UNIQUE (`session_id`, `kind`),
+  FOREIGN KEY (customer_id) REFERENCES customers (id) ON DELETE CASCADE,
+  FOREIGN KEY (session_id) REFERENCES diner_sessions (id) ON DELETE SET NULL
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
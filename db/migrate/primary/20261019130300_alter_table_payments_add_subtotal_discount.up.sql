@@ -0,0 +1,3 @@
+ALTER TABLE `payments`
+  ADD COLUMN `subtotal` int DEFAULT 0 NOT NULL AFTER `session_id`,
+  ADD COLUMN `discount` int DEFAULT 0 NOT NULL AFTER `subtotal`;
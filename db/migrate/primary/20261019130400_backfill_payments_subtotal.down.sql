@@ -0,0 +1 @@
+UPDATE payments SET subtotal = 0;
@@ -0,0 +1 @@
+UPDATE payments SET subtotal = amount WHERE subtotal = 0;
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS `privacy_requests` (
+  `id` BIGINT auto_increment NOT NULL,
+  `diner_id` BIGINT NOT NULL,
+  `kind` varchar(20) NOT NULL,
+  `requested_by` varchar(100) NOT NULL,
+  `reason` varchar(500) DEFAULT '' NOT NULL,
+  `channel` varchar(20) NOT NULL,
+  `created_at` timestamp DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  `updated_at` timestamp DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP NOT NULL,
+  PRIMARY KEY (`id`),
+  INDEX `privacy_requests_diner_id_IDX` (`diner_id`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
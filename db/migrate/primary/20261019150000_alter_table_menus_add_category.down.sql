@@ -0,0 +1,3 @@
+ALTER TABLE `menus`
+  DROP INDEX `menus_category_IDX`,
+  DROP COLUMN `category`;
@@ -0,0 +1,3 @@
+ALTER TABLE `menus`
+  ADD COLUMN `category` varchar(60) NOT NULL DEFAULT 'uncategorised' AFTER `description`,
+  ADD INDEX `menus_category_IDX` (`category`);
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS `daily_menu_sales` (
+  `day` DATE NOT NULL,
+  `menu_id` BIGINT NOT NULL,
+  `revenue` BIGINT NOT NULL DEFAULT 0,
+  `tickets` int NOT NULL DEFAULT 0,
+  `items_sold` int NOT NULL DEFAULT 0,
+  PRIMARY KEY (`day`, `menu_id`),
+  FOREIGN KEY (menu_id) REFERENCES menus (id) ON DELETE CASCADE
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
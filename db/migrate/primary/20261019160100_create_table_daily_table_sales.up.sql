@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS `daily_table_sales` (
+  `day` DATE NOT NULL,
+  `table_no` int NOT NULL,
+  `revenue` BIGINT NOT NULL DEFAULT 0,
+  `tickets` int NOT NULL DEFAULT 0,
+  `items_sold` int NOT NULL DEFAULT 0,
+  PRIMARY KEY (`day`, `table_no`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS `hourly_sales` (
+  `day` DATE NOT NULL,
+  `hour` TINYINT NOT NULL,
+  `revenue` BIGINT NOT NULL DEFAULT 0,
+  `tickets` int NOT NULL DEFAULT 0,
+  `items_sold` int NOT NULL DEFAULT 0,
+  PRIMARY KEY (`day`, `hour`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
@@ -0,0 +1,5 @@
+CREATE TABLE IF NOT EXISTS `sales_aggregate_days` (
+  `day` DATE NOT NULL,
+  `refreshed_at` timestamp DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  PRIMARY KEY (`day`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
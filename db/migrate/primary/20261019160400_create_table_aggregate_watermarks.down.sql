@@ -0,0 +1 @@
+DROP TABLE IF EXISTS `aggregate_watermarks`;
@@ -0,0 +1,5 @@
+CREATE TABLE IF NOT EXISTS `aggregate_watermarks` (
+  `name` varchar(60) NOT NULL,
+  `watermark` timestamp DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  PRIMARY KEY (`name`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
@@ -0,0 +1 @@
+ALTER TABLE `orders` DROP INDEX `orders_updated_at_IDX`;
@@ -0,0 +1 @@
+ALTER TABLE `orders` ADD INDEX `orders_updated_at_IDX` (`updated_at`);
@@ -0,0 +1 @@
+ALTER TABLE `orders` ADD COLUMN `served_at` timestamp NULL DEFAULT NULL AFTER `quantity`;
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS `menu_translations` (
+  `menu_id` BIGINT NOT NULL,
+  `language` varchar(35) NOT NULL,
+  `name` varchar(120) NOT NULL,
+  `description` varchar(255) NOT NULL DEFAULT '',
+  `created_at` timestamp DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  `updated_at` timestamp DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP NOT NULL,
+  PRIMARY KEY (`menu_id`, `language`),
+  FOREIGN KEY (menu_id) REFERENCES menus (id) ON DELETE CASCADE
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS `webhook_subscriptions` (
+  `id` BIGINT NOT NULL AUTO_INCREMENT,
+  `url` varchar(2048) NOT NULL,
+  `events` varchar(255) NOT NULL,
+  `secret` varchar(255) NOT NULL,
+  `created_at` timestamp DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  `updated_at` timestamp DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP NOT NULL,
+  PRIMARY KEY (`id`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS `webhook_deliveries` (
+  `id` BIGINT NOT NULL AUTO_INCREMENT,
+  `subscription_id` BIGINT NOT NULL,
+  `event` varchar(64) NOT NULL,
+  `payload` JSON NOT NULL,
+  `status` varchar(16) NOT NULL DEFAULT 'pending',
+  `attempts` INT NOT NULL DEFAULT 0,
+  `next_attempt_at` timestamp NULL DEFAULT NULL,
+  `last_status_code` INT NOT NULL DEFAULT 0,
+  `last_error` varchar(1024) NOT NULL DEFAULT '',
+  `delivered_at` timestamp NULL DEFAULT NULL,
+  `created_at` timestamp DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  `updated_at` timestamp DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `webhook_deliveries_status_next_attempt_at_IDX` (`status`, `next_attempt_at`),
+  FOREIGN KEY (subscription_id) REFERENCES webhook_subscriptions (id) ON DELETE CASCADE
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS `webhook_attempts` (
+  `id` BIGINT NOT NULL AUTO_INCREMENT,
+  `delivery_id` BIGINT NOT NULL,
+  `status_code` INT NOT NULL DEFAULT 0,
+  `error` varchar(1024) NOT NULL DEFAULT '',
+  `duration_ms` BIGINT NOT NULL DEFAULT 0,
+  `created_at` timestamp DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  PRIMARY KEY (`id`),
+  FOREIGN KEY (delivery_id) REFERENCES webhook_deliveries (id) ON DELETE CASCADE
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
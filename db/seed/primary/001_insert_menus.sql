@@ -1,5 +1,5 @@
-INSERT INTO menus (`name`, `description`, price) VALUES ('HCDB', 'Hyderabadi Chicken Dum Briyani', '20000')ON DUPLICATE KEY UPDATE updated_at=NOW();
+INSERT INTO menus (`name`, `description`, `category`, price) VALUES ('HCDB', 'Hyderabadi Chicken Dum Briyani', 'briyani', '20000')ON DUPLICATE KEY UPDATE updated_at=NOW();
 
-INSERT INTO menus (`name`, `description`, price) VALUES ('HMDB', 'Hyderabadi Mutton Dum Briyani', '28000')ON DUPLICATE KEY UPDATE updated_at=NOW();
+INSERT INTO menus (`name`, `description`, `category`, price) VALUES ('HMDB', 'Hyderabadi Mutton Dum Briyani', 'briyani', '28000')ON DUPLICATE KEY UPDATE updated_at=NOW();
 
-INSERT INTO menus (`name`, `description`, price) VALUES ('MVB', 'Muglai Veg Briyani', '18050')ON DUPLICATE KEY UPDATE updated_at=NOW();
+INSERT INTO menus (`name`, `description`, `category`, price) VALUES ('MVB', 'Muglai Veg Briyani', 'briyani', '18050')ON DUPLICATE KEY UPDATE updated_at=NOW();
@@ -1,5 +1,11 @@
-INSERT INTO diners (table_no, name) VALUES (1, 'Mr. Smith')ON DUPLICATE KEY UPDATE updated_at=NOW();
+INSERT INTO diners (id, table_no, name) VALUES (1, 1, 'Mr. Smith')ON DUPLICATE KEY UPDATE updated_at=NOW();
 
-INSERT INTO diners (table_no, name) VALUES (2, 'Ms. Sunita Chaudary')ON DUPLICATE KEY UPDATE updated_at=NOW();
+INSERT INTO diners (id, table_no, name) VALUES (2, 2, 'Ms. Sunita Chaudary')ON DUPLICATE KEY UPDATE updated_at=NOW();
 
-INSERT INTO diners (table_no, name) VALUES (3, 'Dr. Giridhari Reddy')ON DUPLICATE KEY UPDATE updated_at=NOW();
+INSERT INTO diners (id, table_no, name) VALUES (3, 3, 'Dr. Giridhari Reddy')ON DUPLICATE KEY UPDATE updated_at=NOW();
+
+INSERT INTO diner_sessions (id, diner_id, table_no) VALUES (1, 1, 1)ON DUPLICATE KEY UPDATE updated_at=NOW();
+
+INSERT INTO diner_sessions (id, diner_id, table_no) VALUES (2, 2, 2)ON DUPLICATE KEY UPDATE updated_at=NOW();
+
+INSERT INTO diner_sessions (id, diner_id, table_no) VALUES (3, 3, 3)ON DUPLICATE KEY UPDATE updated_at=NOW();
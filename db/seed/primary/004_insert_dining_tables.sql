@@ -0,0 +1,11 @@
+INSERT INTO dining_tables (table_no, capacity, section) VALUES (1, 2, 'window')ON DUPLICATE KEY UPDATE updated_at=NOW();
+
+INSERT INTO dining_tables (table_no, capacity, section) VALUES (2, 2, 'window')ON DUPLICATE KEY UPDATE updated_at=NOW();
+
+INSERT INTO dining_tables (table_no, capacity, section) VALUES (3, 4, 'main')ON DUPLICATE KEY UPDATE updated_at=NOW();
+
+INSERT INTO dining_tables (table_no, capacity, section) VALUES (4, 4, 'main')ON DUPLICATE KEY UPDATE updated_at=NOW();
+
+INSERT INTO dining_tables (table_no, capacity, section) VALUES (5, 6, 'main')ON DUPLICATE KEY UPDATE updated_at=NOW();
+
+INSERT INTO dining_tables (table_no, capacity, section) VALUES (6, 8, 'patio')ON DUPLICATE KEY UPDATE updated_at=NOW();
@@ -25,55 +25,74 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/diners": {
-            "get": {
-                "description": "Get all Diners on the system",
+        "/admin/diners/{diner_id}/anonymise": {
+            "post": {
+                "description": "Erase the personal data of a diner, their linked customer profiles and the reservations and waitlist entries made in their name, keeping the order and revenue history",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "diners"
+                    "admin"
                 ],
-                "summary": "Get all Diners",
+                "summary": "Anonymise a diner",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "limit",
-                        "name": "limit",
-                        "in": "query",
+                        "description": "id of diner",
+                        "name": "diner_id",
+                        "in": "path",
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "page",
-                        "name": "page",
-                        "in": "query",
-                        "required": true
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/privacy.PrivacyRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/diner.PaginationResultDiner"
-                            }
+                            "$ref": "#/definitions/github_com_Raj63_golang-rest-api_pkg_domain_privacy.Request"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/diner.MessageResponse"
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/diner.MessageResponse"
+                            "$ref": "#/definitions/controllers.ProblemDetails"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/admin/diners/{diner_id}/export": {
             "post": {
-                "description": "Create new diner on the system",
+                "description": "Download everything held about a diner (profile, sessions, orders, payments and linked customer profiles) as a JSON archive",
                 "consumes": [
                     "application/json"
                 ],
@@ -81,85 +100,68 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "diners"
+                    "admin"
                 ],
-                "summary": "Create New Diner",
+                "summary": "Export the personal data of a diner",
                 "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id of diner",
+                        "name": "diner_id",
+                        "in": "path",
+                        "required": true
+                    },
                     {
                         "description": "body data",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/diner.NewDinerRequest"
+                            "$ref": "#/definitions/privacy.PrivacyRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_Raj63_golang-rest-api_pkg_domain_diner.Diner"
+                            "$ref": "#/definitions/privacy.Archive"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/diner.MessageResponse"
+                            "$ref": "#/definitions/controllers.ProblemDetails"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/diner.MessageResponse"
-                        }
-                    }
-                }
-            }
-        },
-        "/diners/{diner_id}": {
-            "get": {
-                "description": "Get Diners by ID on the system",
-                "tags": [
-                    "diners"
-                ],
-                "summary": "Get diners by ID",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "id of diner",
-                        "name": "diner_id",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/github_com_Raj63_golang-rest-api_pkg_domain_diner.Diner"
+                            "$ref": "#/definitions/controllers.ProblemDetails"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/diner.MessageResponse"
+                            "$ref": "#/definitions/controllers.ProblemDetails"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/diner.MessageResponse"
+                            "$ref": "#/definitions/controllers.ProblemDetails"
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Delete Diners by ID on the system",
+            }
+        },
+        "/admin/diners/{diner_id}/privacy-requests": {
+            "get": {
+                "description": "Get the audit trail of the exports and anonymisations of a diner, latest first",
                 "tags": [
-                    "diners"
+                    "admin"
                 ],
-                "summary": "Delete diners by ID",
+                "summary": "Get the privacy requests of a diner",
                 "parameters": [
                     {
                         "type": "integer",
@@ -173,73 +175,88 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/diner.MessageResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_Raj63_golang-rest-api_pkg_domain_privacy.Request"
+                            }
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/diner.MessageResponse"
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/diner.MessageResponse"
+                            "$ref": "#/definitions/controllers.ProblemDetails"
                         }
                     }
                 }
             }
         },
-        "/menus": {
-            "get": {
-                "description": "Get all Menus on the system",
+        "/batch": {
+            "post": {
+                "description": "Run up to 50 requests to the v1 and v2 routes at once, one after the other, with the headers of the batch request, authentication and rate limits included. The batch requires a token as the admin routes. The response holds the status, headers and body of each operation. A transactional batch runs all its operations in a single database transaction, which is rolled back when an operation fails or the transaction is held for longer than 10 seconds: the operations not run have the 424 status.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "menus"
+                    "batch"
                 ],
-                "summary": "Get all Menus",
+                "summary": "Run a batch of requests",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "limit",
-                        "name": "limit",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "page",
-                        "name": "page",
-                        "in": "query",
-                        "required": true
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/batch.BatchRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/menu.PaginationResultMenu"
-                            }
+                            "$ref": "#/definitions/batch.BatchResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/menu.MessageResponse"
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/menu.MessageResponse"
+                            "$ref": "#/definitions/controllers.ProblemDetails"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/customers/": {
             "post": {
-                "description": "Create new menu on the system",
+                "description": "Create a customer profile that earns loyalty points",
                 "consumes": [
                     "application/json"
                 ],
@@ -247,9 +264,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "menus"
+                    "customers"
                 ],
-                "summary": "Create New Menu",
+                "summary": "Create New Customer",
                 "parameters": [
                     {
                         "description": "body data",
@@ -257,7 +274,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/menu.NewMenuRequest"
+                            "$ref": "#/definitions/customer.NewCustomerRequest"
                         }
                     }
                 ],
@@ -265,77 +282,62 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/github_com_Raj63_golang-rest-api_pkg_domain_menu.Menu"
+                            "$ref": "#/definitions/github_com_Raj63_golang-rest-api_pkg_domain_customer.Customer"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/menu.MessageResponse"
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/menu.MessageResponse"
+                            "$ref": "#/definitions/controllers.ProblemDetails"
                         }
                     }
                 }
             }
         },
-        "/menus/top": {
+        "/customers/tiers": {
             "get": {
-                "description": "Get Top Menus by count on the system",
+                "description": "Get the membership levels with the lifetime points needed to reach them and their points multiplier",
                 "tags": [
-                    "menus"
-                ],
-                "summary": "Get top menus by count",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "top count",
-                        "name": "count",
-                        "in": "query",
-                        "required": true
-                    }
+                    "customers"
                 ],
+                "summary": "Get membership levels",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/github_com_Raj63_golang-rest-api_pkg_domain_menu.Menu"
+                                "$ref": "#/definitions/customer.Tier"
                             }
                         }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/menu.MessageResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/menu.MessageResponse"
-                        }
                     }
                 }
             }
         },
-        "/menus/{menu_id}": {
+        "/customers/{customer_id}": {
             "get": {
-                "description": "Get Menus by ID on the system",
+                "description": "Get a customer profile with their membership level and points balance",
                 "tags": [
-                    "menus"
+                    "customers"
                 ],
-                "summary": "Get menus by ID",
+                "summary": "Get customer by ID",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "id of menu",
-                        "name": "menu_id",
+                        "description": "id of customer",
+                        "name": "customer_id",
                         "in": "path",
                         "required": true
                     }
@@ -344,63 +346,31 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_Raj63_golang-rest-api_pkg_domain_menu.Menu"
+                            "$ref": "#/definitions/github_com_Raj63_golang-rest-api_pkg_domain_customer.Customer"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/menu.MessageResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/menu.MessageResponse"
-                        }
-                    }
-                }
-            },
-            "delete": {
-                "description": "Delete Menus by ID on the system",
-                "tags": [
-                    "menus"
-                ],
-                "summary": "Delete menus by ID",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "id of menu",
-                        "name": "menu_id",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/menu.MessageResponse"
+                            "$ref": "#/definitions/controllers.ProblemDetails"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/menu.MessageResponse"
+                            "$ref": "#/definitions/controllers.ProblemDetails"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/menu.MessageResponse"
+                            "$ref": "#/definitions/controllers.ProblemDetails"
                         }
                     }
                 }
-            }
-        },
-        "/orders": {
-            "post": {
-                "description": "Create new order on the system",
+            },
+            "patch": {
+                "description": "Change the contact details or preferences of a customer",
                 "consumes": [
                     "application/json"
                 ],
@@ -408,54 +378,73 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "orders"
+                    "customers"
                 ],
-                "summary": "Create New order",
+                "summary": "Update customer profile",
                 "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id of customer",
+                        "name": "customer_id",
+                        "in": "path",
+                        "required": true
+                    },
                     {
                         "description": "body data",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/order.NewOrderRequest"
+                            "$ref": "#/definitions/customer.UpdateCustomerRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_Raj63_golang-rest-api_pkg_domain_order.Request"
+                            "$ref": "#/definitions/github_com_Raj63_golang-rest-api_pkg_domain_customer.Customer"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/order.MessageResponse"
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/order.MessageResponse"
+                            "$ref": "#/definitions/controllers.ProblemDetails"
                         }
                     }
                 }
             }
         },
-        "/orders/{diner_id}": {
+        "/customers/{customer_id}/ledger": {
             "get": {
-                "description": "Get orders by Diner ID on the system",
+                "description": "Get the points earned and redeemed by a customer, latest first",
                 "tags": [
-                    "orders"
+                    "customers"
                 ],
-                "summary": "Get orders by Diner ID",
+                "summary": "Get loyalty points ledger",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "id of diner",
-                        "name": "diner_id",
+                        "description": "id of customer",
+                        "name": "customer_id",
                         "in": "path",
                         "required": true
                     }
@@ -466,37 +455,43 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/github_com_Raj63_golang-rest-api_pkg_domain_order.Response"
+                                "$ref": "#/definitions/github_com_Raj63_golang-rest-api_pkg_domain_customer.LedgerEntry"
                             }
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/order.MessageResponse"
+                            "$ref": "#/definitions/controllers.ProblemDetails"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/order.MessageResponse"
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
                         }
                     }
                 }
             }
         },
-        "/orders/{order_id}": {
-            "delete": {
-                "description": "Delete orders by ID on the system",
+        "/customers/{customer_id}/points": {
+            "get": {
+                "description": "Get the points balance, membership level and points to the next level of a customer",
                 "tags": [
-                    "orders"
+                    "customers"
                 ],
-                "summary": "Delete orders by ID",
+                "summary": "Get loyalty points balance",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "id of order",
-                        "name": "order_id",
+                        "description": "id of customer",
+                        "name": "customer_id",
                         "in": "path",
                         "required": true
                     }
@@ -505,107 +500,3302 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/order.MessageResponse"
+                            "$ref": "#/definitions/github_com_Raj63_golang-rest-api_pkg_domain_customer.Balance"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/order.MessageResponse"
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/order.MessageResponse"
+                            "$ref": "#/definitions/controllers.ProblemDetails"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "diner.MessageResponse": {
-            "type": "object",
-            "properties": {
-                "message": {
-                    "type": "string"
+        },
+        "/customers/{customer_id}/redemptions": {
+            "post": {
+                "description": "Spend loyalty points of a customer as a discount on the open bill of the diner they are seated as",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "customers"
+                ],
+                "summary": "Redeem loyalty points",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id of customer",
+                        "name": "customer_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/customer.RedeemRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Raj63_golang-rest-api_pkg_domain_customer.LedgerEntry"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    }
                 }
             }
         },
-        "diner.NewDinerRequest": {
-            "type": "object",
-            "required": [
-                "name",
-                "table_no"
-            ],
-            "properties": {
-                "name": {
-                    "type": "string",
-                    "example": "Mr. Smith"
-                },
-                "table_no": {
-                    "type": "integer",
-                    "example": 101
+        "/customers/{customer_id}/sessions": {
+            "post": {
+                "description": "Link the open session of a diner to a customer so that the bill earns loyalty points",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "customers"
+                ],
+                "summary": "Link a diner visit to a customer",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id of customer",
+                        "name": "customer_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/customer.LinkSessionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/customer.MessageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    }
                 }
             }
         },
-        "diner.PaginationResultDiner": {
-            "type": "object",
-            "properties": {
-                "current": {
-                    "type": "integer"
-                },
-                "data": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_Raj63_golang-rest-api_pkg_domain_diner.Diner"
+        "/diners/": {
+            "get": {
+                "description": "Get all Diners on the system sorted by name or by sort, optionally searched by a case-insensitive part of the name and by table number, in keyset pages following the opaque cursors, or by page number when page is given",
+                "tags": [
+                    "diners"
+                ],
+                "summary": "Get all Diners",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "NextCursor or PrevCursor of a previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "count the total of a keyset page",
+                        "name": "with_total",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page number, counts the total on every call",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields, descending when prefixed by a minus, e.g. table_no,-created_at",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields of each item to return, e.g. id,name",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "part of the diner name",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "table number",
+                        "name": "table_no",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of a previous response, answered with 304 Not Modified while unchanged",
+                        "name": "If-None-Match",
+                        "in": "header"
                     }
-                },
-                "limit": {
-                    "type": "integer"
-                },
-                "nextCursor": {
-                    "type": "integer"
-                },
-                "numPages": {
-                    "type": "integer"
-                },
-                "prevCursor": {
-                    "type": "integer"
-                },
-                "total": {
-                    "type": "integer"
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/diner.PaginationResultDiner"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "strong tag of the body"
+                            },
+                            "Link": {
+                                "type": "string",
+                                "description": "first, next and prev pages"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create new diner on the system",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "diners"
+                ],
+                "summary": "Create New Diner",
+                "parameters": [
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/diner.NewDinerRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Raj63_golang-rest-api_pkg_domain_diner.Diner"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/diners/{diner_id}": {
+            "get": {
+                "description": "Get Diners by ID on the system",
+                "tags": [
+                    "diners"
+                ],
+                "summary": "Get diners by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id of diner",
+                        "name": "diner_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of a previous response, answered with 304 Not Modified while unchanged",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previous response, answered with 304 Not Modified while unchanged",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Raj63_golang-rest-api_pkg_domain_diner.Diner"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "strong tag of the body"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "updated_at of the resource"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete Diners by ID on the system",
+                "tags": [
+                    "diners"
+                ],
+                "summary": "Delete diners by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id of diner",
+                        "name": "diner_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/diner.MessageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Fix the name of a diner or move them to another table",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "diners"
+                ],
+                "summary": "Update diner",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id of diner",
+                        "name": "diner_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/diner.UpdateDinerRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Raj63_golang-rest-api_pkg_domain_diner.Diner"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/diners/{diner_id}/checkout": {
+            "post": {
+                "description": "Record the paid bill of the open session of a diner and close the session",
+                "tags": [
+                    "diners"
+                ],
+                "summary": "Pay the bill of a diner",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id of diner",
+                        "name": "diner_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Raj63_golang-rest-api_pkg_domain_diner.Payment"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/diners/{diner_id}/orders": {
+            "get": {
+                "description": "Get the orders of the current session of a diner, or of every session when history is requested",
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Get orders of a diner",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id of diner",
+                        "name": "diner_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "include the orders of previous sessions",
+                        "name": "history",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_Raj63_golang-rest-api_pkg_domain_order.Response"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/diners/{diner_id}/sessions": {
+            "get": {
+                "description": "Get the dining sessions of a diner, latest first",
+                "tags": [
+                    "diners"
+                ],
+                "summary": "Get diner visits",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id of diner",
+                        "name": "diner_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_Raj63_golang-rest-api_pkg_domain_diner.Session"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Open a new dining session for a returning diner at a table",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "diners"
+                ],
+                "summary": "Check in a returning diner",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id of diner",
+                        "name": "diner_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/diner.CheckInRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Raj63_golang-rest-api_pkg_domain_diner.Session"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/menus/": {
+            "get": {
+                "description": "Get all Menus on the system sorted by name or by sort, in keyset pages following the opaque cursors, or by page number when page is given",
+                "tags": [
+                    "menus"
+                ],
+                "summary": "Get all Menus",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "NextCursor or PrevCursor of a previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "count the total of a keyset page",
+                        "name": "with_total",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page number, counts the total on every call",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields, descending when prefixed by a minus, e.g. -price,name",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields of each item to return, e.g. id,name,price",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "languages to translate the names and descriptions in, falling back to the default language",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of a previous response, answered with 304 Not Modified while unchanged",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/menu.PaginationResultMenu"
+                        },
+                        "headers": {
+                            "Content-Language": {
+                                "type": "string",
+                                "description": "languages of the menus"
+                            },
+                            "ETag": {
+                                "type": "string",
+                                "description": "strong tag of the body"
+                            },
+                            "Link": {
+                                "type": "string",
+                                "description": "first, next and prev pages"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create new menu on the system",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "menus"
+                ],
+                "summary": "Create New Menu",
+                "parameters": [
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/menu.NewMenuRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Raj63_golang-rest-api_pkg_domain_menu.Menu"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/menus/export": {
+            "get": {
+                "description": "Stream every menu sorted by name as CSV, JSON, NDJSON or YAML, in the fields the import reads back",
+                "produces": [
+                    "application/json",
+                    "text/csv",
+                    "application/x-ndjson",
+                    "application/yaml"
+                ],
+                "tags": [
+                    "menus"
+                ],
+                "summary": "Export Menus",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "json, csv, ndjson or yaml, overrides the Accept header",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/menu.ExportMenu"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/menus/import": {
+            "post": {
+                "description": "Create or update, matching by name, the menus of a CSV file with a name, description, category and price header or of a JSON array of menus, all or nothing. Nothing is written when a row is invalid, and a dry run only reports what the import would do.",
+                "consumes": [
+                    "application/json",
+                    "text/csv"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "menus"
+                ],
+                "summary": "Import Menus",
+                "parameters": [
+                    {
+                        "description": "menus to import",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/menu.NewMenuRequest"
+                            }
+                        }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "report the changes and the invalid rows without writing",
+                        "name": "dry_run",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/menu.ImportResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/menu.ImportResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/menus/top": {
+            "get": {
+                "description": "Get the top menus by quantity ordered or revenue earned, optionally within a date window and ranked within each category",
+                "tags": [
+                    "menus"
+                ],
+                "summary": "Get top menus",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "top count",
+                        "name": "count",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "first day of orders to consider (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "last day of orders to consider (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "quantity (default) or revenue",
+                        "name": "rank_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "category to rank within each category",
+                        "name": "group_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "languages to translate the names and descriptions in, falling back to the default language",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of a previous response, answered with 304 Not Modified while unchanged",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_Raj63_golang-rest-api_pkg_domain_menu.Menu"
+                            }
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "strong tag of the body"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/menus/{menu_id}": {
+            "get": {
+                "description": "Get Menus by ID on the system",
+                "tags": [
+                    "menus"
+                ],
+                "summary": "Get menus by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id of menu",
+                        "name": "menu_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "languages to translate the name and description in, falling back to the default language",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of a previous response, answered with 304 Not Modified while unchanged",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previous response, answered with 304 Not Modified while unchanged",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Raj63_golang-rest-api_pkg_domain_menu.Menu"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "strong tag of the body"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "updated_at of the resource"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete Menus by ID on the system",
+                "tags": [
+                    "menus"
+                ],
+                "summary": "Delete menus by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id of menu",
+                        "name": "menu_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/menu.MessageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/menus/{menu_id}/translations": {
+            "get": {
+                "description": "Get the name and description of a menu in every language it is translated in",
+                "tags": [
+                    "menus"
+                ],
+                "summary": "Get the translations of a menu",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id of menu",
+                        "name": "menu_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_Raj63_golang-rest-api_pkg_domain_menu.Translation"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/menus/{menu_id}/translations/{language}": {
+            "put": {
+                "description": "Create or replace the name and description of a menu in a language other than the default, which the description falls back to when empty",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "menus"
+                ],
+                "summary": "Translate a menu",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id of menu",
+                        "name": "menu_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "language tag, e.g. fr or pt-br",
+                        "name": "language",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/menu.TranslationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Raj63_golang-rest-api_pkg_domain_menu.Translation"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete the translation of a menu in a language, which then falls back to the default language",
+                "tags": [
+                    "menus"
+                ],
+                "summary": "Delete the translation of a menu",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id of menu",
+                        "name": "menu_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "language tag, e.g. fr or pt-br",
+                        "name": "language",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/": {
+            "post": {
+                "description": "Create new order on the system",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Create New order",
+                "parameters": [
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/order.NewOrderRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Raj63_golang-rest-api_pkg_domain_order.Request"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/{id}": {
+            "get": {
+                "description": "Get orders by Diner ID on the system",
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Get orders by Diner ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id of diner",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_Raj63_golang-rest-api_pkg_domain_order.Response"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete orders by ID on the system",
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Delete orders by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id of order",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/order.MessageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/{order_id}/serve": {
+            "post": {
+                "description": "Record that an order has been served to the table",
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Serve an order",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id of order",
+                        "name": "order_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/order.MessageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/reports/sales": {
+            "get": {
+                "description": "Get the revenue, tickets, average ticket and items sold between two days (both included, last 7 days by default) grouped by day, hour, weekday, menu or table, as JSON or CSV",
+                "produces": [
+                    "application/json",
+                    "text/csv"
+                ],
+                "tags": [
+                    "reports"
+                ],
+                "summary": "Get sales report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "first day (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "last day (YYYY-MM-DD), today by default",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "day (default), hour, weekday, menu or table",
+                        "name": "group_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "json or csv, overrides the Accept header",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/report.Sales"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/reports/service-times": {
+            "get": {
+                "description": "Get the average seat-to-first-order, order-to-serve and table occupancy minutes, and the total occupancy minutes, of the diners seated between two days (both included, last 7 days by default) grouped by table, section, daypart or weekday, as JSON or CSV",
+                "produces": [
+                    "application/json",
+                    "text/csv"
+                ],
+                "tags": [
+                    "reports"
+                ],
+                "summary": "Get service times report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "first day (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "last day (YYYY-MM-DD), today by default",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "table (default), section, daypart or weekday",
+                        "name": "group_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "json or csv, overrides the Accept header",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/report.ServiceTimes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/reservations/": {
+            "get": {
+                "description": "Get all Reservations of a date on the system",
+                "tags": [
+                    "reservations"
+                ],
+                "summary": "Get all Reservations of a date",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "date (YYYY-MM-DD), defaults to today",
+                        "name": "date",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_Raj63_golang-rest-api_pkg_domain_reservation.Reservation"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create new reservation on the system, auto-assigning a table by capacity when table_no is not given",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reservations"
+                ],
+                "summary": "Create New Reservation",
+                "parameters": [
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/reservation.NewReservationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Raj63_golang-rest-api_pkg_domain_reservation.Reservation"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/reservations/availability": {
+            "get": {
+                "description": "Get the time slots of a date with free tables for a party size",
+                "tags": [
+                    "reservations"
+                ],
+                "summary": "Search available reservation slots",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "date (YYYY-MM-DD), defaults to today",
+                        "name": "date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "party size",
+                        "name": "party_size",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/reservation.Slot"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/reservations/{reservation_id}": {
+            "get": {
+                "description": "Get Reservations by ID on the system",
+                "tags": [
+                    "reservations"
+                ],
+                "summary": "Get reservations by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id of reservation",
+                        "name": "reservation_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Raj63_golang-rest-api_pkg_domain_reservation.Reservation"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/reservations/{reservation_id}/status": {
+            "patch": {
+                "description": "Move a booked reservation to seated, no-show or cancelled",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reservations"
+                ],
+                "summary": "Update reservation status",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id of reservation",
+                        "name": "reservation_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/reservation.UpdateStatusRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Raj63_golang-rest-api_pkg_domain_reservation.Reservation"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/waitlist/": {
+            "get": {
+                "description": "Get the active waitlist with queue positions and estimated wait times",
+                "tags": [
+                    "waitlist"
+                ],
+                "summary": "Get the waitlist",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_Raj63_golang-rest-api_pkg_domain_waitlist.Entry"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Add a walk-in party to the end of the waitlist",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "waitlist"
+                ],
+                "summary": "Join the waitlist",
+                "parameters": [
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/waitlist.NewEntryRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Raj63_golang-rest-api_pkg_domain_waitlist.Entry"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/waitlist/tables/{table_no}/free": {
+            "post": {
+                "description": "Notify the first waiting party that fits the freed table",
+                "tags": [
+                    "waitlist"
+                ],
+                "summary": "Notify the next party of a free table",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "number of the freed table",
+                        "name": "table_no",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Raj63_golang-rest-api_pkg_domain_waitlist.Entry"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/waitlist/{entry_id}": {
+            "get": {
+                "description": "Get a waitlist entry with its queue position and estimated wait time",
+                "tags": [
+                    "waitlist"
+                ],
+                "summary": "Get waitlist entry by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id of waitlist entry",
+                        "name": "entry_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Raj63_golang-rest-api_pkg_domain_waitlist.Entry"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/waitlist/{entry_id}/status": {
+            "patch": {
+                "description": "Mark a waitlist entry as seated or left",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "waitlist"
+                ],
+                "summary": "Update waitlist entry status",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id of waitlist entry",
+                        "name": "entry_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/waitlist.UpdateStatusRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Raj63_golang-rest-api_pkg_domain_waitlist.Entry"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/": {
+            "get": {
+                "description": "Get all webhook subscriptions, without their secret",
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Get all subscriptions",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_Raj63_golang-rest-api_pkg_domain_webhook.Subscription"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Subscribe a partner endpoint to some of the order and menu events. The deliveries are signed with the secret, which is only shown in this response: the X-Webhook-Signature header is sha256= and the hex HMAC-SHA256 of the X-Webhook-Timestamp header, a dot and the body. The endpoint must resolve to public addresses only.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Subscribe to events",
+                "parameters": [
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/webhook.NewSubscriptionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Raj63_golang-rest-api_pkg_domain_webhook.Subscription"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/deliveries/{delivery_id}": {
+            "get": {
+                "description": "Get a delivery with the log of all its attempts",
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Get delivery by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id of delivery",
+                        "name": "delivery_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Raj63_golang-rest-api_pkg_domain_webhook.Delivery"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/deliveries/{delivery_id}/replay": {
+            "post": {
+                "description": "Send a delivery again right away, whatever its status, a dead one included, and answer with the outcome in the log of its attempts. A delivery being attempted meanwhile is not replayed.",
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Replay a delivery",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id of delivery",
+                        "name": "delivery_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Raj63_golang-rest-api_pkg_domain_webhook.Delivery"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/{subscription_id}": {
+            "get": {
+                "description": "Get a webhook subscription, without its secret",
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Get subscription by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id of subscription",
+                        "name": "subscription_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Raj63_golang-rest-api_pkg_domain_webhook.Subscription"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a webhook subscription with its deliveries",
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Unsubscribe",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id of subscription",
+                        "name": "subscription_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/{subscription_id}/deliveries": {
+            "get": {
+                "description": "Get the log of the deliveries of a subscription, the latest first, with the outcome of their last attempt",
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Get the deliveries of a subscription",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id of subscription",
+                        "name": "subscription_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "pending",
+                            "succeeded",
+                            "dead"
+                        ],
+                        "type": "string",
+                        "description": "status of the deliveries",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_Raj63_golang-rest-api_pkg_domain_webhook.Delivery"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProblemDetails"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "batch.BatchRequest": {
+            "type": "object",
+            "required": [
+                "operations"
+            ],
+            "properties": {
+                "operations": {
+                    "type": "array",
+                    "maxItems": 50,
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/batch.OperationRequest"
+                    }
+                },
+                "transactional": {
+                    "description": "Transactional runs all the operations in a single database transaction, rolled back when one of them fails",
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "batch.BatchResponse": {
+            "type": "object",
+            "properties": {
+                "committed": {
+                    "description": "Committed tells whether the transaction of a transactional batch was committed, it is omitted otherwise",
+                    "type": "boolean",
+                    "example": true
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/batch.OperationResponse"
+                    }
+                }
+            }
+        },
+        "batch.OperationRequest": {
+            "type": "object",
+            "required": [
+                "method",
+                "path"
+            ],
+            "properties": {
+                "body": {
+                    "description": "Body is the JSON body of the operation, if any"
+                },
+                "id": {
+                    "description": "ID is given back in the result of the operation, for the client to match them",
+                    "type": "string",
+                    "maxLength": 64,
+                    "example": "order-1"
+                },
+                "method": {
+                    "type": "string",
+                    "enum": [
+                        "GET",
+                        "POST",
+                        "PUT",
+                        "PATCH",
+                        "DELETE"
+                    ],
+                    "example": "POST"
+                },
+                "path": {
+                    "description": "Path is the path of a v1 or v2 route, with its query string if any",
+                    "type": "string",
+                    "maxLength": 2048,
+                    "example": "/v1/orders/"
+                }
+            }
+        },
+        "batch.OperationResponse": {
+            "type": "object",
+            "properties": {
+                "body": {
+                    "description": "Body is the JSON the operation was answered with, or the text when it is not JSON"
+                },
+                "headers": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "type": "string",
+                    "example": "order-1"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 201
+                }
+            }
+        },
+        "controllers.ProblemDetails": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "example": "validation_error"
+                },
+                "detail": {
+                    "type": "string",
+                    "example": "price must be a positive amount with at most 2 decimals"
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/errors.FieldError"
+                    }
+                },
+                "instance": {
+                    "type": "string",
+                    "example": "/v1/menus"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Bad Request"
+                },
+                "trace_id": {
+                    "type": "string",
+                    "example": "4bf92f3577b34da6a3ce929d0e0e4736"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "about:blank"
+                }
+            }
+        },
+        "customer.LinkSessionRequest": {
+            "type": "object",
+            "required": [
+                "diner_id"
+            ],
+            "properties": {
+                "diner_id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "customer.MessageResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "customer.NewCustomerRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "example": "smith@example.com"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Mr. Smith"
+                },
+                "phone": {
+                    "type": "string",
+                    "example": "+91 98765 43210"
+                },
+                "preferences": {
+                    "type": "string",
+                    "example": "window seat, no peanuts"
+                }
+            }
+        },
+        "customer.RedeemRequest": {
+            "type": "object",
+            "required": [
+                "diner_id",
+                "points"
+            ],
+            "properties": {
+                "diner_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "points": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 100
+                }
+            }
+        },
+        "customer.Tier": {
+            "type": "object",
+            "properties": {
+                "minimum_points": {
+                    "type": "integer",
+                    "example": 1000
+                },
+                "multiplier": {
+                    "type": "number",
+                    "example": 1.25
+                },
+                "name": {
+                    "type": "string",
+                    "example": "silver"
+                }
+            }
+        },
+        "customer.UpdateCustomerRequest": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "example": "smith@example.com"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Mr. Smith"
+                },
+                "phone": {
+                    "type": "string",
+                    "example": "+91 98765 43210"
+                },
+                "preferences": {
+                    "type": "string",
+                    "example": "window seat, no peanuts"
+                }
+            }
+        },
+        "diner.CheckInRequest": {
+            "type": "object",
+            "required": [
+                "table_no"
+            ],
+            "properties": {
+                "table_no": {
+                    "type": "integer",
+                    "example": 101
+                }
+            }
+        },
+        "diner.MessageResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "diner.NewDinerRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "table_no"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "example": "Mr. Smith"
+                },
+                "table_no": {
+                    "type": "integer",
+                    "example": 101
+                }
+            }
+        },
+        "diner.PaginationResultDiner": {
+            "type": "object",
+            "properties": {
+                "current": {
+                    "type": "integer"
+                },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Raj63_golang-rest-api_pkg_domain_diner.Diner"
+                    }
+                },
+                "limit": {
+                    "type": "integer"
+                },
+                "nextCursor": {
+                    "type": "string"
+                },
+                "numPages": {
+                    "type": "integer"
+                },
+                "prevCursor": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "diner.UpdateDinerRequest": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "example": "Mr. Smith"
+                },
+                "table_no": {
+                    "type": "integer",
+                    "example": 101
+                }
+            }
+        },
+        "errors.FieldError": {
+            "type": "object",
+            "properties": {
+                "field": {
+                    "type": "string",
+                    "example": "price"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "price must be a positive amount with at most 2 decimals"
+                }
+            }
+        },
+        "github_com_Raj63_golang-rest-api_pkg_domain_customer.Balance": {
+            "type": "object",
+            "properties": {
+                "customer_id": {
+                    "type": "integer",
+                    "example": 7
+                },
+                "lifetime_points": {
+                    "type": "integer",
+                    "example": 1240
+                },
+                "next_tier": {
+                    "type": "string",
+                    "example": "gold"
+                },
+                "points": {
+                    "type": "integer",
+                    "example": 340
+                },
+                "points_to_next_tier": {
+                    "type": "integer",
+                    "example": 3760
+                },
+                "tier": {
+                    "type": "string",
+                    "example": "silver"
+                }
+            }
+        },
+        "github_com_Raj63_golang-rest-api_pkg_domain_customer.Customer": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string",
+                    "example": "smith@example.com"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 123
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Mr. Smith"
+                },
+                "phone": {
+                    "type": "string",
+                    "example": "+91 98765 43210"
+                },
+                "points": {
+                    "type": "integer",
+                    "example": 340
+                },
+                "preferences": {
+                    "type": "string",
+                    "example": "window seat, no peanuts"
+                },
+                "tier": {
+                    "type": "string",
+                    "example": "silver"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2021-02-24 20:19:39"
+                }
+            }
+        },
+        "github_com_Raj63_golang-rest-api_pkg_domain_customer.LedgerEntry": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number",
+                    "example": 480.5
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "customer_id": {
+                    "type": "integer",
+                    "example": 7
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 123
+                },
+                "kind": {
+                    "type": "string",
+                    "example": "accrual"
+                },
+                "points": {
+                    "type": "integer",
+                    "example": 48
+                },
+                "session_id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "github_com_Raj63_golang-rest-api_pkg_domain_diner.Diner": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 123
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Mr. Smith"
+                },
+                "table_no": {
+                    "type": "integer",
+                    "example": 101
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2021-02-24 20:19:39"
+                }
+            }
+        },
+        "github_com_Raj63_golang-rest-api_pkg_domain_diner.Payment": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number",
+                    "example": 480.5
+                },
+                "discount": {
+                    "type": "number",
+                    "example": 20
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 123
+                },
+                "paid_at": {
+                    "type": "string",
+                    "example": "2021-02-24 21:49:39"
+                },
+                "session_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "subtotal": {
+                    "type": "number",
+                    "example": 500.5
+                }
+            }
+        },
+        "github_com_Raj63_golang-rest-api_pkg_domain_diner.Session": {
+            "type": "object",
+            "properties": {
+                "checked_in_at": {
+                    "type": "string",
+                    "example": "2021-02-24 20:19:39"
+                },
+                "checked_out_at": {
+                    "type": "string",
+                    "example": "2021-02-24 21:49:39"
+                },
+                "customer_id": {
+                    "type": "integer",
+                    "example": 7
+                },
+                "diner_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 123
+                },
+                "table_no": {
+                    "type": "integer",
+                    "example": 101
+                }
+            }
+        },
+        "github_com_Raj63_golang-rest-api_pkg_domain_menu.Menu": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "type": "string",
+                    "example": "briyani"
+                },
+                "count": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Some Description"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 123
+                },
+                "language": {
+                    "type": "string",
+                    "example": "fr"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Hyderabadi Dum Briyani"
+                },
+                "price": {
+                    "type": "number",
+                    "example": 200.5
+                },
+                "revenue": {
+                    "type": "number",
+                    "example": 8421
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2021-02-24 20:19:39"
+                }
+            }
+        },
+        "github_com_Raj63_golang-rest-api_pkg_domain_menu.Translation": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Riz parfumé cuit à l'étouffée"
+                },
+                "language": {
+                    "type": "string",
+                    "example": "fr"
+                },
+                "menu_id": {
+                    "type": "integer",
+                    "example": 123
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Biryani Dum d'Hyderabad"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2021-02-24 20:19:39"
+                }
+            }
+        },
+        "github_com_Raj63_golang-rest-api_pkg_domain_order.Request": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "diner_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 123
+                },
+                "menu_id": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "quantity": {
+                    "type": "integer",
+                    "example": 2
+                }
+            }
+        },
+        "github_com_Raj63_golang-rest-api_pkg_domain_order.Response": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "diner_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "diner_name": {
+                    "type": "string",
+                    "example": "Mr. Smith"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 123
+                },
+                "menu_description": {
+                    "type": "string",
+                    "example": "Hyderabadi Chicken Dum Briyani"
+                },
+                "menu_id": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "menu_name": {
+                    "type": "string",
+                    "example": "HCDB"
+                },
+                "quantity": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "served_at": {
+                    "type": "string"
+                },
+                "session_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2021-02-24 20:19:39"
+                }
+            }
+        },
+        "github_com_Raj63_golang-rest-api_pkg_domain_privacy.Request": {
+            "type": "object",
+            "properties": {
+                "channel": {
+                    "type": "string",
+                    "example": "api"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "diner_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 123
+                },
+                "kind": {
+                    "type": "string",
+                    "example": "export"
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "subject access request #42"
+                },
+                "requested_by": {
+                    "type": "string",
+                    "example": "privacy-officer@example.com"
+                }
+            }
+        },
+        "github_com_Raj63_golang-rest-api_pkg_domain_report.SalesRow": {
+            "type": "object",
+            "properties": {
+                "average_ticket": {
+                    "type": "number",
+                    "example": 89.32
+                },
+                "group": {
+                    "type": "string",
+                    "example": "2026-10-19"
+                },
+                "items_sold": {
+                    "type": "integer",
+                    "example": 37
+                },
+                "orders": {
+                    "type": "integer",
+                    "example": 14
+                },
+                "revenue": {
+                    "type": "number",
+                    "example": 1250.5
+                }
+            }
+        },
+        "github_com_Raj63_golang-rest-api_pkg_domain_report.ServiceTimeRow": {
+            "type": "object",
+            "properties": {
+                "group": {
+                    "type": "string",
+                    "example": "12"
+                },
+                "occupancy": {
+                    "type": "number",
+                    "example": 64.8
+                },
+                "order_to_serve": {
+                    "type": "number",
+                    "example": 18.2
+                },
+                "seat_to_first_order": {
+                    "type": "number",
+                    "example": 7.5
+                },
+                "sessions": {
+                    "type": "integer",
+                    "example": 31
+                },
+                "total_occupancy": {
+                    "type": "number",
+                    "example": 1944
+                }
+            }
+        },
+        "github_com_Raj63_golang-rest-api_pkg_domain_reservation.Reservation": {
+            "type": "object",
+            "properties": {
+                "contact": {
+                    "type": "string",
+                    "example": "+91 98765 43210"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "duration_minutes": {
+                    "type": "integer",
+                    "example": 90
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 123
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Mr. Smith"
+                },
+                "party_size": {
+                    "type": "integer",
+                    "example": 4
+                },
+                "reserved_at": {
+                    "type": "string",
+                    "example": "2021-02-24T20:00:00Z"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "booked"
+                },
+                "table_no": {
+                    "type": "integer",
+                    "example": 101
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2021-02-24 20:19:39"
+                }
+            }
+        },
+        "github_com_Raj63_golang-rest-api_pkg_domain_table.Table": {
+            "type": "object",
+            "properties": {
+                "capacity": {
+                    "type": "integer",
+                    "example": 4
+                },
+                "section": {
+                    "type": "string",
+                    "example": "main"
+                },
+                "table_no": {
+                    "type": "integer",
+                    "example": 101
+                }
+            }
+        },
+        "github_com_Raj63_golang-rest-api_pkg_domain_waitlist.Entry": {
+            "type": "object",
+            "properties": {
+                "contact": {
+                    "type": "string",
+                    "example": "+91 98765 43210"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "estimated_wait_minutes": {
+                    "type": "integer",
+                    "example": 25
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 123
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Mr. Smith"
+                },
+                "notified_at": {
+                    "type": "string"
+                },
+                "party_size": {
+                    "type": "integer",
+                    "example": 4
+                },
+                "position": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "status": {
+                    "type": "string",
+                    "example": "waiting"
+                },
+                "table_no": {
+                    "type": "integer",
+                    "example": 101
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2021-02-24 20:19:39"
+                }
+            }
+        },
+        "github_com_Raj63_golang-rest-api_pkg_domain_webhook.Attempt": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "delivery_id": {
+                    "type": "integer",
+                    "example": 123
+                },
+                "duration_ms": {
+                    "type": "integer",
+                    "example": 87
+                },
+                "error": {
+                    "type": "string",
+                    "example": "unexpected status 503"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 123
+                },
+                "status_code": {
+                    "type": "integer",
+                    "example": 503
+                }
+            }
+        },
+        "github_com_Raj63_golang-rest-api_pkg_domain_webhook.Delivery": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "delivered_at": {
+                    "type": "string"
+                },
+                "event": {
+                    "type": "string",
+                    "example": "order.created"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 123
+                },
+                "last_error": {
+                    "type": "string",
+                    "example": "unexpected status 503"
+                },
+                "last_status_code": {
+                    "type": "integer",
+                    "example": 503
+                },
+                "log": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Raj63_golang-rest-api_pkg_domain_webhook.Attempt"
+                    }
+                },
+                "next_attempt_at": {
+                    "type": "string"
+                },
+                "payload": {
+                    "type": "object"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "pending"
+                },
+                "subscription_id": {
+                    "type": "integer",
+                    "example": 7
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2021-02-24 20:19:39"
                 }
             }
         },
-        "github_com_Raj63_golang-rest-api_pkg_domain_diner.Diner": {
+        "github_com_Raj63_golang-rest-api_pkg_domain_webhook.Subscription": {
             "type": "object",
             "properties": {
                 "created_at": {
                     "type": "string"
                 },
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "order.created",
+                        "order.served"
+                    ]
+                },
                 "id": {
                     "type": "integer",
                     "example": 123
                 },
-                "name": {
+                "secret": {
                     "type": "string",
-                    "example": "Mr. Smith"
-                },
-                "table_no": {
-                    "type": "integer",
-                    "example": 101
+                    "example": "5f2b8c0e3a9d4e7f"
                 },
                 "updated_at": {
                     "type": "string",
                     "example": "2021-02-24 20:19:39"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://partner.example.com/webhooks"
                 }
             }
         },
-        "github_com_Raj63_golang-rest-api_pkg_domain_menu.Menu": {
+        "menu.ExportMenu": {
             "type": "object",
             "properties": {
+                "category": {
+                    "type": "string",
+                    "example": "briyani"
+                },
                 "created_at": {
                     "type": "string"
                 },
@@ -626,64 +3816,53 @@ const docTemplate = `{
                     "example": 200.5
                 },
                 "updated_at": {
-                    "type": "string",
-                    "example": "2021-02-24 20:19:39"
+                    "type": "string"
                 }
             }
         },
-        "github_com_Raj63_golang-rest-api_pkg_domain_order.Request": {
+        "menu.ImportError": {
             "type": "object",
             "properties": {
-                "created_at": {
-                    "type": "string"
-                },
-                "diner_id": {
-                    "type": "integer",
-                    "example": 1
+                "field": {
+                    "type": "string",
+                    "example": "price"
                 },
-                "id": {
-                    "type": "integer",
-                    "example": 123
+                "message": {
+                    "type": "string",
+                    "example": "price must be a positive amount with at most 2 decimals"
                 },
-                "menu_id": {
+                "row": {
                     "type": "integer",
                     "example": 3
-                },
-                "quantity": {
-                    "type": "integer",
-                    "example": 2
                 }
             }
         },
-        "github_com_Raj63_golang-rest-api_pkg_domain_order.Response": {
+        "menu.ImportResult": {
             "type": "object",
             "properties": {
-                "created_at": {
-                    "type": "string"
+                "applied": {
+                    "type": "boolean"
                 },
-                "diner_name": {
-                    "type": "string",
-                    "example": "Mr. Smith"
+                "created": {
+                    "type": "integer"
                 },
-                "id": {
-                    "type": "integer",
-                    "example": 123
+                "dry_run": {
+                    "type": "boolean"
                 },
-                "menu_description": {
-                    "type": "string",
-                    "example": "Hyderabadi Chicken Dum Briyani"
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/menu.ImportError"
+                    }
                 },
-                "menu_name": {
-                    "type": "string",
-                    "example": "HCDB"
+                "rows": {
+                    "type": "integer"
                 },
-                "quantity": {
-                    "type": "integer",
-                    "example": 2
+                "unchanged": {
+                    "type": "integer"
                 },
-                "updated_at": {
-                    "type": "string",
-                    "example": "2021-02-24 20:19:39"
+                "updated": {
+                    "type": "integer"
                 }
             }
         },
@@ -703,12 +3882,19 @@ const docTemplate = `{
                 "price"
             ],
             "properties": {
+                "category": {
+                    "type": "string",
+                    "maxLength": 60,
+                    "example": "briyani"
+                },
                 "description": {
                     "type": "string",
+                    "maxLength": 255,
                     "example": "Something"
                 },
                 "name": {
                     "type": "string",
+                    "maxLength": 120,
                     "example": "Paracetamol"
                 },
                 "price": {
@@ -733,19 +3919,37 @@ const docTemplate = `{
                     "type": "integer"
                 },
                 "nextCursor": {
-                    "type": "integer"
+                    "type": "string"
                 },
                 "numPages": {
                     "type": "integer"
                 },
                 "prevCursor": {
-                    "type": "integer"
+                    "type": "string"
                 },
                 "total": {
                     "type": "integer"
                 }
             }
         },
+        "menu.TranslationRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "Riz parfumé cuit à l'étouffée"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 120,
+                    "example": "Biryani Dum d'Hyderabad"
+                }
+            }
+        },
         "order.MessageResponse": {
             "type": "object",
             "properties": {
@@ -764,17 +3968,285 @@ const docTemplate = `{
             "properties": {
                 "diner_id": {
                     "type": "integer",
+                    "minimum": 1,
                     "example": 1
                 },
                 "menu_id": {
                     "type": "integer",
+                    "minimum": 1,
                     "example": 3
                 },
                 "quantity": {
                     "type": "integer",
+                    "maximum": 50,
+                    "minimum": 1,
                     "example": 2
                 }
             }
+        },
+        "privacy.Archive": {
+            "type": "object",
+            "properties": {
+                "customers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/privacy.Customer"
+                    }
+                },
+                "diner": {
+                    "$ref": "#/definitions/github_com_Raj63_golang-rest-api_pkg_domain_diner.Diner"
+                },
+                "exported_at": {
+                    "type": "string"
+                },
+                "orders": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Raj63_golang-rest-api_pkg_domain_order.Response"
+                    }
+                },
+                "payments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Raj63_golang-rest-api_pkg_domain_diner.Payment"
+                    }
+                },
+                "sessions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Raj63_golang-rest-api_pkg_domain_diner.Session"
+                    }
+                }
+            }
+        },
+        "privacy.Customer": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string",
+                    "example": "smith@example.com"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 123
+                },
+                "ledger": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Raj63_golang-rest-api_pkg_domain_customer.LedgerEntry"
+                    }
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Mr. Smith"
+                },
+                "phone": {
+                    "type": "string",
+                    "example": "+91 98765 43210"
+                },
+                "points": {
+                    "type": "integer",
+                    "example": 340
+                },
+                "preferences": {
+                    "type": "string",
+                    "example": "window seat, no peanuts"
+                },
+                "tier": {
+                    "type": "string",
+                    "example": "silver"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2021-02-24 20:19:39"
+                }
+            }
+        },
+        "privacy.PrivacyRequest": {
+            "type": "object",
+            "properties": {
+                "reason": {
+                    "type": "string",
+                    "example": "subject access request #42"
+                }
+            }
+        },
+        "report.Sales": {
+            "type": "object",
+            "properties": {
+                "from": {
+                    "type": "string"
+                },
+                "group_by": {
+                    "type": "string",
+                    "example": "day"
+                },
+                "rows": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Raj63_golang-rest-api_pkg_domain_report.SalesRow"
+                    }
+                },
+                "to": {
+                    "type": "string"
+                }
+            }
+        },
+        "report.ServiceTimes": {
+            "type": "object",
+            "properties": {
+                "from": {
+                    "type": "string"
+                },
+                "group_by": {
+                    "type": "string",
+                    "example": "table"
+                },
+                "rows": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Raj63_golang-rest-api_pkg_domain_report.ServiceTimeRow"
+                    }
+                },
+                "to": {
+                    "type": "string"
+                }
+            }
+        },
+        "reservation.NewReservationRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "party_size",
+                "reserved_at"
+            ],
+            "properties": {
+                "contact": {
+                    "type": "string",
+                    "example": "+91 98765 43210"
+                },
+                "duration_minutes": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 90
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Mr. Smith"
+                },
+                "party_size": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 4
+                },
+                "reserved_at": {
+                    "type": "string",
+                    "example": "2021-02-24T20:00:00Z"
+                },
+                "table_no": {
+                    "type": "integer",
+                    "example": 101
+                }
+            }
+        },
+        "reservation.Slot": {
+            "type": "object",
+            "properties": {
+                "end_at": {
+                    "type": "string",
+                    "example": "2021-02-24T21:30:00Z"
+                },
+                "start_at": {
+                    "type": "string",
+                    "example": "2021-02-24T20:00:00Z"
+                },
+                "tables": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Raj63_golang-rest-api_pkg_domain_table.Table"
+                    }
+                }
+            }
+        },
+        "reservation.UpdateStatusRequest": {
+            "type": "object",
+            "required": [
+                "status"
+            ],
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "example": "seated"
+                }
+            }
+        },
+        "waitlist.NewEntryRequest": {
+            "type": "object",
+            "required": [
+                "contact",
+                "name",
+                "party_size"
+            ],
+            "properties": {
+                "contact": {
+                    "type": "string",
+                    "example": "+91 98765 43210"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Mr. Smith"
+                },
+                "party_size": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 4
+                }
+            }
+        },
+        "waitlist.UpdateStatusRequest": {
+            "type": "object",
+            "required": [
+                "status"
+            ],
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "example": "seated"
+                }
+            }
+        },
+        "webhook.NewSubscriptionRequest": {
+            "type": "object",
+            "required": [
+                "events",
+                "url"
+            ],
+            "properties": {
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "order.created",
+                        "order.served"
+                    ]
+                },
+                "secret": {
+                    "description": "Secret signs the deliveries, a random one is made when it is empty",
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "5f2b8c0e3a9d4e7f9a1c"
+                },
+                "url": {
+                    "type": "string",
+                    "maxLength": 2048,
+                    "example": "https://partner.example.com/webhooks"
+                }
+            }
         }
     }
 }`
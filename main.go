@@ -13,10 +13,13 @@ import (
 	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/http"
 	sdkgin "github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/http/gin"
 	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/routes"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rpc"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rpc/services"
 	"github.com/Raj63/golang-rest-api/pkg/infrastructure/server"
 	sdksql "github.com/Raj63/golang-rest-api/pkg/infrastructure/sql"
 	"github.com/Raj63/golang-rest-api/pkg/infrastructure/tracer"
 	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
 )
 
 //go:embed configs db/migrate db/seed
@@ -58,6 +61,7 @@ func main() {
 	}, _logger)
 
 	var httpServer, httpsServer *http.Server
+	var grpcServer *rpc.Server
 
 	// Setup the HTTP server.
 	if _config.HTTPConfig.Enabled {
@@ -104,7 +108,8 @@ func main() {
 			log.Fatalln(err)
 		}
 
-		routes.ApplicationV1Router(router, _database, _logger)
+		routes.ApplicationV1Router(router, _database, _logger, _config.ContractValidation, _config.JWTAccessSecret)
+		routes.ApplicationV2Router(router, _database, _logger)
 
 		httpServer, err = server.NewServer(server.DI{
 			Config:                        _config,
@@ -134,7 +139,8 @@ func main() {
 			_logger.Errorf("error setting up HTTPS basic middlewares: %v", err)
 			log.Fatalln(err)
 		}
-		routes.ApplicationV1Router(router, _database, _logger)
+		routes.ApplicationV1Router(router, _database, _logger, _config.ContractValidation, _config.JWTAccessSecret)
+		routes.ApplicationV2Router(router, _database, _logger)
 
 		httpsServer, err = server.NewServer(server.DI{
 			Config:                        _config,
@@ -153,13 +159,38 @@ func main() {
 		}
 	}
 
+	// Setup the gRPC server for the internal communication.
+	if _config.GRPCConfig.Enabled {
+		grpcServer, err = server.NewGRPCServer(server.DI{
+			Config:                        _config,
+			Address:                       _config.GRPCConfig.Address,
+			Logger:                        _logger,
+			TracerProvider:                tracerProvider,
+			TracerProviderShutdownHandler: tracerProviderShutdownHandler,
+			PreRunCallback: func() error {
+				// perform pre run stuff here
+				return nil
+			},
+			RegisterServices: func(s *grpc.Server) {
+				services.Register(s, _database, _logger)
+			},
+		})
+		if err != nil {
+			_logger.Errorf("error creating gRPC server: %v", err)
+			log.Fatalln(err)
+		}
+	}
+
 	// Create CLI with Commands & Execute
 	cli := cmd.NewCommand(cmd.CommandDI{
-		HTTPServer:  httpServer,
-		HTTPSServer: httpsServer,
-		Logger:      _logger,
-		DB:          _database,
-		EmbedFS:     embedFS,
+		HTTPServer:          httpServer,
+		HTTPSServer:         httpsServer,
+		GRPCServer:          grpcServer,
+		Logger:              _logger,
+		DB:                  _database,
+		EmbedFS:             embedFS,
+		AggregationInterval: _config.AggregationInterval,
+		WebhookInterval:     _config.WebhookInterval,
 	})
 	if err := cli.Execute(); err != nil {
 		os.Exit(1)
@@ -0,0 +1,209 @@
+// Package customer provides the use case for customer profiles and loyalty points
+package customer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	customerDomain "github.com/Raj63/golang-rest-api/pkg/domain/customer"
+	dinerDomain "github.com/Raj63/golang-rest-api/pkg/domain/diner"
+	domainErrors "github.com/Raj63/golang-rest-api/pkg/domain/errors"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/repository"
+)
+
+var (
+	// PointsPerUnit is how many points a bronze member earns per currency unit of a paid bill
+	PointsPerUnit = 0.1
+	// PointValue is the bill discount a redeemed point is worth
+	PointValue = 0.5
+	// MinimumRedemption is the fewest points that can be redeemed against a bill
+	MinimumRedemption = 50
+)
+
+// Tiers lists the membership levels from the lowest, with the lifetime points needed to reach them
+// and the multiplier applied to the points earned at that level
+var Tiers = []customerDomain.Tier{
+	{Name: customerDomain.TierBronze, MinimumPoints: 0, Multiplier: 1},
+	{Name: customerDomain.TierSilver, MinimumPoints: 500, Multiplier: 1.25},
+	{Name: customerDomain.TierGold, MinimumPoints: 2000, Multiplier: 1.5},
+}
+
+// Service is a struct that contains the repository implementation for customer use case
+type Service struct {
+	CustomerRepository repository.Customers
+}
+
+// GetByID is a function that returns a customer by id with their tier and points balance
+func (s *Service) GetByID(ctx context.Context, id int64) (*customerDomain.Customer, error) {
+	customer, err := s.CustomerRepository.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	balance, err := s.CustomerRepository.GetBalance(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	customer.Points = balance.Points
+	customer.Tier = tierOf(balance.LifetimePoints).Name
+	return customer, nil
+}
+
+// Create is a function that creates a customer profile
+func (s *Service) Create(ctx context.Context, customer *NewCustomer) (*customerDomain.Customer, error) {
+	customerModel := customer.toDomainMapper()
+	if err := validate(customerModel); err != nil {
+		return nil, err
+	}
+
+	created, err := s.CustomerRepository.Create(ctx, customerModel)
+	if err != nil {
+		return nil, err
+	}
+
+	created.Tier = Tiers[0].Name
+	return created, nil
+}
+
+// Update is a function that changes the contact details and preferences of a customer
+func (s *Service) Update(ctx context.Context, id int64, update *UpdateCustomer) (*customerDomain.Customer, error) {
+	customer, err := s.CustomerRepository.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	update.applyTo(customer)
+	if err := validate(customer); err != nil {
+		return nil, err
+	}
+
+	if err := s.CustomerRepository.Update(ctx, customer); err != nil {
+		return nil, err
+	}
+
+	return s.GetByID(ctx, id)
+}
+
+// LinkSession is a function that links the open session of a diner to a customer, so that the bill earns them points
+func (s *Service) LinkSession(ctx context.Context, customerID int64, dinerID int64) error {
+	if _, err := s.CustomerRepository.GetByID(ctx, customerID); err != nil {
+		return err
+	}
+
+	return s.CustomerRepository.LinkSession(ctx, customerID, dinerID)
+}
+
+// GetBalance is a function that returns the points balance and membership level of a customer
+func (s *Service) GetBalance(ctx context.Context, customerID int64) (*customerDomain.Balance, error) {
+	if _, err := s.CustomerRepository.GetByID(ctx, customerID); err != nil {
+		return nil, err
+	}
+
+	balance, err := s.CustomerRepository.GetBalance(ctx, customerID)
+	if err != nil {
+		return nil, err
+	}
+
+	tier := tierOf(balance.LifetimePoints)
+	balance.Tier = tier.Name
+	if next := nextTier(tier); next != nil {
+		balance.NextTier = next.Name
+		balance.PointsToNextTier = next.MinimumPoints - balance.LifetimePoints
+	}
+
+	return balance, nil
+}
+
+// GetLedger is a function that returns the points history of a customer, latest first
+func (s *Service) GetLedger(ctx context.Context, customerID int64) ([]customerDomain.LedgerEntry, error) {
+	if _, err := s.CustomerRepository.GetByID(ctx, customerID); err != nil {
+		return nil, err
+	}
+
+	return s.CustomerRepository.GetLedger(ctx, customerID)
+}
+
+// Redeem is a function that spends points of a customer as a discount on the bill of the diner they are seated as
+func (s *Service) Redeem(ctx context.Context, customerID int64, dinerID int64, points int) (*customerDomain.LedgerEntry, error) {
+	if points < MinimumRedemption {
+		return nil, domainErrors.NewAppError(fmt.Errorf("at least %d points must be redeemed", MinimumRedemption), domainErrors.ValidationError)
+	}
+
+	return s.CustomerRepository.Redeem(ctx, &customerDomain.LedgerEntry{
+		CustomerID: customerID,
+		Kind:       customerDomain.KindRedemption,
+		Points:     -points,
+		Amount:     math.Round(float64(points)*PointValue*100) / 100,
+	}, dinerID)
+}
+
+// BillPaid is a function that credits the customer linked to a paid session with the points earned from the bill
+func (s *Service) BillPaid(ctx context.Context, session *dinerDomain.Session, payment *dinerDomain.Payment) error {
+	if session.CustomerID == 0 {
+		return nil
+	}
+
+	balance, err := s.CustomerRepository.GetBalance(ctx, session.CustomerID)
+	if err != nil {
+		return err
+	}
+
+	points := int(math.Floor(payment.Amount * PointsPerUnit * tierOf(balance.LifetimePoints).Multiplier))
+	if points <= 0 {
+		return nil
+	}
+
+	_, err = s.CustomerRepository.Accrue(ctx, &customerDomain.LedgerEntry{
+		CustomerID: session.CustomerID,
+		SessionID:  session.ID,
+		Kind:       customerDomain.KindAccrual,
+		Points:     points,
+		Amount:     payment.Amount,
+	})
+	return err
+}
+
+// validate rejects customer profiles that cannot be told apart or reached
+func validate(customer *customerDomain.Customer) error {
+	customer.Name = strings.TrimSpace(customer.Name)
+	customer.Email = strings.TrimSpace(customer.Email)
+	customer.Phone = strings.TrimSpace(customer.Phone)
+
+	if customer.Name == "" {
+		return domainErrors.NewAppError(errors.New("customer name is required"), domainErrors.ValidationError)
+	}
+	if customer.Email == "" && customer.Phone == "" {
+		return domainErrors.NewAppError(errors.New("customer needs an email or a phone"), domainErrors.ValidationError)
+	}
+	if customer.Email != "" && !strings.Contains(customer.Email, "@") {
+		return domainErrors.NewAppError(errors.New("customer email is invalid"), domainErrors.ValidationError)
+	}
+
+	return nil
+}
+
+// tierOf returns the highest membership level reached with the given lifetime points
+func tierOf(lifetimePoints int) customerDomain.Tier {
+	tier := Tiers[0]
+	for _, t := range Tiers {
+		if lifetimePoints >= t.MinimumPoints {
+			tier = t
+		}
+	}
+
+	return tier
+}
+
+func nextTier(tier customerDomain.Tier) *customerDomain.Tier {
+	for i := range Tiers {
+		if Tiers[i].MinimumPoints > tier.MinimumPoints {
+			return &Tiers[i]
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,30 @@
+// Package customer provides the use case for customer profiles and loyalty points
+package customer
+
+import (
+	domainCustomer "github.com/Raj63/golang-rest-api/pkg/domain/customer"
+)
+
+func (n *NewCustomer) toDomainMapper() *domainCustomer.Customer {
+	return &domainCustomer.Customer{
+		Name:        n.Name,
+		Email:       n.Email,
+		Phone:       n.Phone,
+		Preferences: n.Preferences,
+	}
+}
+
+func (u *UpdateCustomer) applyTo(customer *domainCustomer.Customer) {
+	if u.Name != nil {
+		customer.Name = *u.Name
+	}
+	if u.Email != nil {
+		customer.Email = *u.Email
+	}
+	if u.Phone != nil {
+		customer.Phone = *u.Phone
+	}
+	if u.Preferences != nil {
+		customer.Preferences = *u.Preferences
+	}
+}
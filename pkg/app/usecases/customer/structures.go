@@ -0,0 +1,18 @@
+// Package customer provides the use case for customer profiles and loyalty points
+package customer
+
+// NewCustomer is a struct that contains the data for a new customer
+type NewCustomer struct {
+	Name        string `json:"name" example:"Mr. Smith"`
+	Email       string `json:"email" example:"smith@example.com"`
+	Phone       string `json:"phone" example:"+91 98765 43210"`
+	Preferences string `json:"preferences" example:"window seat, no peanuts"`
+}
+
+// UpdateCustomer is a struct that contains the profile fields of a customer to change, nil fields are left as they are
+type UpdateCustomer struct {
+	Name        *string `json:"name" example:"Mr. Smith"`
+	Email       *string `json:"email" example:"smith@example.com"`
+	Phone       *string `json:"phone" example:"+91 98765 43210"`
+	Preferences *string `json:"preferences" example:"window seat, no peanuts"`
+}
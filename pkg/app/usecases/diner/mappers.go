@@ -2,7 +2,12 @@
 package diner
 
 import (
+	"errors"
+	"fmt"
+
 	domainDiner "github.com/Raj63/golang-rest-api/pkg/domain/diner"
+	domainErrors "github.com/Raj63/golang-rest-api/pkg/domain/errors"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/repository"
 )
 
 func (n *NewDiner) toDomainMapper() *domainDiner.Diner {
@@ -11,3 +16,30 @@ func (n *NewDiner) toDomainMapper() *domainDiner.Diner {
 		TableNumber: n.TableNumber,
 	}
 }
+
+func (q *PageQuery) toRepositoryMapper() (*repository.PageRequest, error) {
+	if q.Limit <= 0 || q.Limit > MaxPageLimit {
+		return nil, domainErrors.NewAppError(fmt.Errorf("limit must be between 1 and %d", MaxPageLimit), domainErrors.ValidationError)
+	}
+	sort, err := repository.ParseSort(q.Sort, SortFields)
+	if err != nil {
+		return nil, domainErrors.NewAppError(err, domainErrors.ValidationError)
+	}
+	page := &repository.PageRequest{Limit: q.Limit, Sort: sort, WithTotal: q.WithTotal}
+
+	switch {
+	case q.Cursor != "":
+		cursor, err := repository.DecodeCursor(q.Cursor)
+		if err != nil {
+			return nil, domainErrors.NewAppError(err, domainErrors.ValidationError)
+		}
+		if cursor.Sort != repository.SortString(sort) {
+			return nil, domainErrors.NewAppError(errors.New("cursor does not match the sort"), domainErrors.ValidationError)
+		}
+		page.Cursor = cursor
+	case q.Page > 0:
+		page.Offset = (q.Page - 1) * q.Limit
+		page.WithTotal = true
+	}
+	return page, nil
+}
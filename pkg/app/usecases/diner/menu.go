@@ -3,19 +3,94 @@ package diner
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
 
 	dinerDomain "github.com/Raj63/golang-rest-api/pkg/domain/diner"
+	domainErrors "github.com/Raj63/golang-rest-api/pkg/domain/errors"
+	waitlistDomain "github.com/Raj63/golang-rest-api/pkg/domain/waitlist"
 	"github.com/Raj63/golang-rest-api/pkg/infrastructure/repository"
 )
 
+// TableReleaser is a interface that is told about the tables freed by diners checking out
+type TableReleaser interface {
+	TableFreed(ctx context.Context, tableNumber int) (*waitlistDomain.Entry, error)
+}
+
+// BillSettler is a interface that is told about the bills paid by diners checking out
+type BillSettler interface {
+	BillPaid(ctx context.Context, session *dinerDomain.Session, payment *dinerDomain.Payment) error
+}
+
+// Transactor is a interface that runs a function with a context carrying a database transaction, that all the queries
+// run with the context are part of, and commits the transaction when the function succeeds
+type Transactor interface {
+	Transaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
 // Service is a struct that contains the repository implementation for diner use case
 type Service struct {
 	DinerRepository repository.Diners
+	// TableRepository is optional, when set the table a diner is seated at must be one of its tables
+	TableRepository repository.Tables
+	// TableReleaser is optional, when set it is told about the table freed on every checkout
+	TableReleaser TableReleaser
+	// BillSettler is optional, when set it is told about the bill paid on every checkout
+	BillSettler BillSettler
+	// Transactor is optional, when set the checkout and the settlement of its bill are a single transaction, so that
+	// a bill is never paid without the loyalty points it earned
+	Transactor Transactor
 }
 
-// GetAll is a function that returns all diners
-func (s *Service) GetAll(ctx context.Context, page int64, limit int64) (*PaginationResultDiner, error) {
-	all, err := s.DinerRepository.GetAll(ctx, page, limit)
+// GetAll is a function that returns all diners, or the diners matching the search criteria when any is given
+func (s *Service) GetAll(ctx context.Context, page int64, limit int64, search *SearchDiner) (*PaginationResultDiner, error) {
+	var all *repository.PaginationResultDiner
+	var err error
+
+	if search != nil && (strings.TrimSpace(search.Name) != "" || search.TableNumber != 0) {
+		all, err = s.DinerRepository.Search(ctx, &repository.DinerFilter{
+			Name:        strings.TrimSpace(search.Name),
+			TableNumber: search.TableNumber,
+		}, page, limit)
+	} else {
+		all, err = s.DinerRepository.GetAll(ctx, page, limit)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &PaginationResultDiner{
+		Data:       all.Data,
+		Total:      all.Total,
+		Limit:      all.Limit,
+		Current:    all.Current,
+		NextCursor: all.NextCursor,
+		PrevCursor: all.PrevCursor,
+		NumPages:   all.NumPages,
+	}, nil
+}
+
+// MaxPageLimit is the largest number of diners returned in a single page
+var MaxPageLimit int64 = 100
+
+// SortFields are the fields the diners can be sorted by
+var SortFields = []string{"id", "name", "table_no", "created_at"}
+
+// GetPage is a function that returns a keyset page of all diners, or of the diners matching the search criteria
+// when any is given
+func (s *Service) GetPage(ctx context.Context, query *PageQuery, search *SearchDiner) (*PaginationResultDiner, error) {
+	page, err := query.toRepositoryMapper()
+	if err != nil {
+		return nil, err
+	}
+	filter := repository.DinerFilter{}
+	if search != nil {
+		filter.Name = strings.TrimSpace(search.Name)
+		filter.TableNumber = search.TableNumber
+	}
+
+	all, err := s.DinerRepository.SearchPage(ctx, &filter, page)
 	if err != nil {
 		return nil, err
 	}
@@ -36,13 +111,115 @@ func (s *Service) GetByID(ctx context.Context, id int64) (*dinerDomain.Diner, er
 	return s.DinerRepository.GetByID(ctx, id)
 }
 
-// Create is a function that creates a diner
+// GetByIDs is a function that returns many diners at once by their ids
+func (s *Service) GetByIDs(ctx context.Context, ids []int64) ([]dinerDomain.Diner, error) {
+	return s.DinerRepository.GetByIDs(ctx, ids)
+}
+
+// Create is a function that creates a diner and checks them in at their table
 func (s *Service) Create(ctx context.Context, diner *NewDiner) (*dinerDomain.Diner, error) {
+	if err := s.checkTable(ctx, diner.TableNumber); err != nil {
+		return nil, err
+	}
 	dinerModel := diner.toDomainMapper()
 	return s.DinerRepository.Create(ctx, dinerModel)
 }
 
+// Update is a function that renames a diner or moves them to another table
+func (s *Service) Update(ctx context.Context, id int64, update *UpdateDiner) (*dinerDomain.Diner, error) {
+	if update.Name == nil && update.TableNumber == nil {
+		return nil, domainErrors.NewAppError(errors.New("name or table_no is required"), domainErrors.ValidationError)
+	}
+
+	if update.TableNumber != nil {
+		if *update.TableNumber <= 0 {
+			return nil, domainErrors.NewAppError(errors.New("table_no must be greater than zero"), domainErrors.ValidationError)
+		}
+		if err := s.checkTable(ctx, *update.TableNumber); err != nil {
+			return nil, err
+		}
+	}
+
+	diner, err := s.DinerRepository.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if update.Name != nil {
+		diner.Name = strings.TrimSpace(*update.Name)
+		if diner.Name == "" {
+			return nil, domainErrors.NewAppError(errors.New("name must not be empty"), domainErrors.ValidationError)
+		}
+	}
+	if update.TableNumber != nil {
+		diner.TableNumber = *update.TableNumber
+	}
+
+	return s.DinerRepository.Update(ctx, diner)
+}
+
 // Delete is a function that deletes a diner by id
 func (s *Service) Delete(ctx context.Context, id int64) error {
 	return s.DinerRepository.Delete(ctx, id)
 }
+
+// CheckIn is a function that opens a new session for a returning diner at the given table
+func (s *Service) CheckIn(ctx context.Context, dinerID int64, tableNumber int) (*dinerDomain.Session, error) {
+	if err := s.checkTable(ctx, tableNumber); err != nil {
+		return nil, err
+	}
+	return s.DinerRepository.CheckIn(ctx, dinerID, tableNumber)
+}
+
+// GetSessions is a function that returns the visit history of a diner
+func (s *Service) GetSessions(ctx context.Context, dinerID int64) ([]dinerDomain.Session, error) {
+	return s.DinerRepository.GetSessions(ctx, dinerID)
+}
+
+// Checkout is a function that records the paid bill of the open session of a diner and closes the session. The
+// checkout fails when the bill cannot be settled, as when the loyalty points it earned cannot be credited.
+func (s *Service) Checkout(ctx context.Context, dinerID int64) (*dinerDomain.Payment, error) {
+	var session *dinerDomain.Session
+	var payment *dinerDomain.Payment
+	checkout := func(ctx context.Context) (err error) {
+		session, payment, err = s.DinerRepository.Checkout(ctx, dinerID)
+		if err != nil || s.BillSettler == nil {
+			return err
+		}
+		return s.BillSettler.BillPaid(ctx, session, payment)
+	}
+
+	var err error
+	if s.Transactor != nil {
+		err = s.Transactor.Transaction(ctx, checkout)
+	} else {
+		err = checkout(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// the table is already free, so telling the waitlist about it is best effort
+	if s.TableReleaser != nil {
+		_, _ = s.TableReleaser.TableFreed(ctx, session.TableNumber)
+	}
+
+	return payment, nil
+}
+
+// checkTable returns a ValidationError when the table number is not one of the dining tables
+func (s *Service) checkTable(ctx context.Context, tableNumber int) error {
+	if s.TableRepository == nil {
+		return nil
+	}
+	tables, err := s.TableRepository.GetAll(ctx)
+	if err != nil {
+		return err
+	}
+	for _, table := range tables {
+		if table.TableNumber == tableNumber {
+			return nil
+		}
+	}
+	return domainErrors.NewValidationError(domainErrors.FieldError{Field: "table_no", Message: fmt.Sprintf("table_no %d does not exist", tableNumber)})
+}
@@ -11,13 +11,38 @@ type NewDiner struct {
 	TableNumber int    `json:"table_no" example:"101"`
 }
 
+// UpdateDiner is a struct that contains the fields of a diner to change, nil fields are left as they are
+type UpdateDiner struct {
+	Name        *string `json:"name" example:"Mr. Smith"`
+	TableNumber *int    `json:"table_no" example:"101"`
+}
+
+// SearchDiner is a struct that contains the criteria to search diners by, empty fields match every diner
+type SearchDiner struct {
+	Name        string `json:"name" example:"smith"`
+	TableNumber int    `json:"table_no" example:"101"`
+}
+
+// PageQuery is a struct that contains the page requested, the keyset page of Cursor, the page number Page, or the
+// first keyset page when neither is given
+type PageQuery struct {
+	// Cursor is the opaque NextCursor or PrevCursor of a previous page
+	Cursor string
+	Page   int64
+	Limit  int64
+	// Sort is a comma separated list of SortFields, each descending when prefixed by a minus
+	Sort string
+	// WithTotal counts the total of a keyset page, page numbers always come with it
+	WithTotal bool
+}
+
 // PaginationResultDiner is a struct that contains the pagination result for diner
 type PaginationResultDiner struct {
 	Data       *[]domainDiner.Diner
 	Total      int64
 	Limit      int64
 	Current    int64
-	NextCursor uint
-	PrevCursor uint
+	NextCursor string
+	PrevCursor string
 	NumPages   int64
 }
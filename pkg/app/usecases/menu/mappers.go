@@ -2,13 +2,187 @@
 package menu
 
 import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	domainErrors "github.com/Raj63/golang-rest-api/pkg/domain/errors"
 	domainMenu "github.com/Raj63/golang-rest-api/pkg/domain/menu"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/repository"
 )
 
+func (n *NewTranslation) toDomainMapper() *domainMenu.Translation {
+	return &domainMenu.Translation{
+		MenuID:      n.MenuID,
+		Language:    strings.ToLower(strings.TrimSpace(n.Language)),
+		Name:        strings.TrimSpace(n.Name),
+		Description: strings.TrimSpace(n.Description),
+	}
+}
+
 func (n *NewMenu) toDomainMapper() *domainMenu.Menu {
+	category := strings.ToLower(strings.TrimSpace(n.Category))
+	if category == "" {
+		category = domainMenu.DefaultCategory
+	}
 	return &domainMenu.Menu{
-		Name:        n.Name,
-		Description: n.Description,
+		Name:        strings.TrimSpace(n.Name),
+		Description: strings.TrimSpace(n.Description),
+		Category:    category,
 		Price:       n.Price,
 	}
 }
+
+func (q *PageQuery) toRepositoryMapper() (*repository.PageRequest, error) {
+	if q.Limit <= 0 || q.Limit > MaxPageLimit {
+		return nil, domainErrors.NewAppError(fmt.Errorf("limit must be between 1 and %d", MaxPageLimit), domainErrors.ValidationError)
+	}
+	sort, err := repository.ParseSort(q.Sort, SortFields)
+	if err != nil {
+		return nil, domainErrors.NewAppError(err, domainErrors.ValidationError)
+	}
+	page := &repository.PageRequest{Limit: q.Limit, Sort: sort, WithTotal: q.WithTotal}
+
+	switch {
+	case q.Cursor != "":
+		cursor, err := repository.DecodeCursor(q.Cursor)
+		if err != nil {
+			return nil, domainErrors.NewAppError(err, domainErrors.ValidationError)
+		}
+		if cursor.Sort != repository.SortString(sort) {
+			return nil, domainErrors.NewAppError(errors.New("cursor does not match the sort"), domainErrors.ValidationError)
+		}
+		page.Cursor = cursor
+	case q.Page > 0:
+		page.Offset = (q.Page - 1) * q.Limit
+		page.WithTotal = true
+	}
+	return page, nil
+}
+
+func (t *TopMenus) toRepositoryMapper() *repository.TopMenuFilter {
+	rankBy := t.RankBy
+	if rankBy == "" {
+		rankBy = domainMenu.RankByQuantity
+	}
+	return &repository.TopMenuFilter{
+		Count:           t.Count,
+		From:            t.From,
+		To:              t.To,
+		RankBy:          rankBy,
+		GroupByCategory: t.GroupBy == GroupByCategory,
+	}
+}
+
+// validate returns the validation errors of a created or imported menu, sized to the columns of the menus table
+func (n *NewMenu) validate(row int) []ImportError {
+	var importErrors []ImportError
+	invalid := func(field, message string) {
+		importErrors = append(importErrors, ImportError{Row: row, Field: field, Message: message})
+	}
+
+	switch name := strings.TrimSpace(n.Name); {
+	case name == "":
+		invalid("name", "name is required")
+	case utf8.RuneCountInString(name) > 120:
+		invalid("name", "name must be at most 120 characters")
+	}
+	switch description := strings.TrimSpace(n.Description); {
+	case description == "":
+		invalid("description", "description is required")
+	case utf8.RuneCountInString(description) > 255:
+		invalid("description", "description must be at most 255 characters")
+	}
+	if utf8.RuneCountInString(strings.TrimSpace(n.Category)) > 60 {
+		invalid("category", "category must be at most 60 characters")
+	}
+	if !domainMenu.ValidPrice(n.Price) {
+		invalid("price", "price must be "+domainMenu.PriceRule)
+	}
+	return importErrors
+}
+
+// decodeCSV reads the menus of a CSV file by the names of its header, reporting the prices that are not numbers as
+// row errors
+func decodeCSV(r io.Reader) ([]NewMenu, []ImportError, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(records) == 0 {
+		return nil, nil, nil
+	}
+
+	columns := map[string]int{}
+	for i, column := range records[0] {
+		columns[strings.ToLower(strings.TrimSpace(column))] = i
+	}
+	for _, column := range []string{"name", "description", "price"} {
+		if _, ok := columns[column]; !ok {
+			return nil, nil, fmt.Errorf("the CSV header has no %s column", column)
+		}
+	}
+	value := func(record []string, column string) string {
+		if i, ok := columns[column]; ok && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	menus := make([]NewMenu, len(records)-1)
+	var importErrors []ImportError
+	for i, record := range records[1:] {
+		menus[i] = NewMenu{
+			Name:        value(record, "name"),
+			Description: value(record, "description"),
+			Category:    value(record, "category"),
+		}
+		if priceStr := value(record, "price"); priceStr != "" {
+			menus[i].Price, err = strconv.ParseFloat(priceStr, 64)
+			if err != nil {
+				importErrors = append(importErrors, ImportError{Row: i + 1, Field: "price", Message: "price must be a number"})
+			}
+		}
+	}
+	return menus, importErrors, nil
+}
+
+// hasImportError tells whether a field of a row is already reported
+func hasImportError(importErrors []ImportError, row int, field string) bool {
+	for _, importError := range importErrors {
+		if importError.Row == row && importError.Field == field {
+			return true
+		}
+	}
+	return false
+}
+
+// compareImport counts the imported menus that are new, that change an existing menu of the same name and that
+// match it already
+func compareImport(menus []domainMenu.Menu, existing []domainMenu.Menu) (created, updated, unchanged int) {
+	byName := make(map[string]domainMenu.Menu, len(existing))
+	for _, menu := range existing {
+		byName[strings.ToLower(menu.Name)] = menu
+	}
+	cents := func(price float64) int64 { return int64(math.Round(price * 100)) }
+
+	for _, menu := range menus {
+		current, ok := byName[strings.ToLower(menu.Name)]
+		switch {
+		case !ok:
+			created++
+		case current.Description == menu.Description && current.Category == menu.Category && cents(current.Price) == cents(menu.Price):
+			unchanged++
+		default:
+			updated++
+		}
+	}
+	return created, updated, unchanged
+}
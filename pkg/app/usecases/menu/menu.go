@@ -3,14 +3,28 @@ package menu
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
 
+	webhookUseCase "github.com/Raj63/golang-rest-api/pkg/app/usecases/webhook"
+	domainErrors "github.com/Raj63/golang-rest-api/pkg/domain/errors"
 	menuDomain "github.com/Raj63/golang-rest-api/pkg/domain/menu"
+	webhookDomain "github.com/Raj63/golang-rest-api/pkg/domain/webhook"
 	"github.com/Raj63/golang-rest-api/pkg/infrastructure/repository"
 )
 
 // Service is a struct that contains the repository implementation for menu use case
 type Service struct {
 	MenuRepository repository.Menus
+	// TranslationRepository is optional, when set the menus are localised in the languages asked for
+	TranslationRepository repository.MenuTranslations
+	// Events tells the partners about every menu created, updated and deleted
+	Events webhookUseCase.Events
 }
 
 // GetAll is a function that returns all menus
@@ -31,23 +45,293 @@ func (s *Service) GetAll(ctx context.Context, page int64, limit int64) (*Paginat
 	}, nil
 }
 
+// MaxPageLimit is the largest number of menus returned in a single page
+var MaxPageLimit int64 = 100
+
+// SortFields are the fields the menus can be sorted by
+var SortFields = []string{"id", "name", "category", "price", "created_at"}
+
+// GetPage is a function that returns a keyset page of menus
+func (s *Service) GetPage(ctx context.Context, query *PageQuery) (*PaginationResultMenu, error) {
+	page, err := query.toRepositoryMapper()
+	if err != nil {
+		return nil, err
+	}
+	all, err := s.MenuRepository.GetPage(ctx, page)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PaginationResultMenu{
+		Data:       all.Data,
+		Total:      all.Total,
+		Limit:      all.Limit,
+		Current:    all.Current,
+		NextCursor: all.NextCursor,
+		PrevCursor: all.PrevCursor,
+		NumPages:   all.NumPages,
+	}, nil
+}
+
 // GetByID is a function that returns a menu by id
 func (s *Service) GetByID(ctx context.Context, id int64) (*menuDomain.Menu, error) {
 	return s.MenuRepository.GetByID(ctx, id)
 }
 
-// GetByTopCount is a function that returns a menu by top counts
-func (s *Service) GetByTopCount(ctx context.Context, count int) ([]menuDomain.Menu, error) {
-	return s.MenuRepository.GetByTopCount(ctx, count)
+// GetByIDs is a function that returns many menus at once by their ids
+func (s *Service) GetByIDs(ctx context.Context, ids []int64) ([]menuDomain.Menu, error) {
+	return s.MenuRepository.GetByIDs(ctx, ids)
 }
 
-// Create is a function that creates a menu
+// GroupByCategory ranks the top menus within each category
+const GroupByCategory = "category"
+
+// GetByTopCount is a function that returns the top menus by quantity ordered or revenue earned
+func (s *Service) GetByTopCount(ctx context.Context, top *TopMenus) ([]menuDomain.Menu, error) {
+	if top.Count <= 0 {
+		return nil, domainErrors.NewAppError(errors.New("count must be a positive number"), domainErrors.ValidationError)
+	}
+	if top.RankBy != "" && top.RankBy != menuDomain.RankByQuantity && top.RankBy != menuDomain.RankByRevenue {
+		return nil, domainErrors.NewAppError(fmt.Errorf("rank_by must be one of %s, %s", menuDomain.RankByQuantity, menuDomain.RankByRevenue), domainErrors.ValidationError)
+	}
+	if top.GroupBy != "" && top.GroupBy != GroupByCategory {
+		return nil, domainErrors.NewAppError(fmt.Errorf("group_by must be %s", GroupByCategory), domainErrors.ValidationError)
+	}
+	if top.From != nil && top.To != nil && !top.From.Before(*top.To) {
+		return nil, domainErrors.NewAppError(errors.New("from must be before to"), domainErrors.ValidationError)
+	}
+
+	return s.MenuRepository.GetByTopCount(ctx, top.toRepositoryMapper())
+}
+
+// Create is a function that creates a menu, checked against the same rules as the imported menus
 func (s *Service) Create(ctx context.Context, menu *NewMenu) (*menuDomain.Menu, error) {
+	if invalid := menu.validate(0); len(invalid) > 0 {
+		fields := make([]domainErrors.FieldError, len(invalid))
+		for i, importError := range invalid {
+			fields[i] = domainErrors.FieldError{Field: importError.Field, Message: importError.Message}
+		}
+		return nil, domainErrors.NewValidationError(fields...)
+	}
 	menuModel := menu.toDomainMapper()
-	return s.MenuRepository.Create(ctx, menuModel)
+	var created *menuDomain.Menu
+	err := s.Events.Publish(ctx, webhookDomain.EventMenuCreated, func(ctx context.Context) (data interface{}, err error) {
+		created, err = s.MenuRepository.Create(ctx, menuModel)
+		return created, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// Import formats read by Import
+const (
+	ImportFormatCSV  = "csv"
+	ImportFormatJSON = "json"
+)
+
+// MaxImportRows is the largest number of menus imported at once
+var MaxImportRows = 1000
+
+// Import is a function that creates or updates, matching by name, the menus of a CSV file with a name, description,
+// category and price header or of a JSON array of menus. The menus are written all or nothing, and only when no
+// row is invalid; a dry run reports what the import would do without writing anything.
+func (s *Service) Import(ctx context.Context, r io.Reader, format string, dryRun bool) (*ImportResult, error) {
+	var menus []NewMenu
+	var importErrors []ImportError
+	var err error
+	switch format {
+	case ImportFormatCSV:
+		menus, importErrors, err = decodeCSV(r)
+	case ImportFormatJSON:
+		err = json.NewDecoder(r).Decode(&menus)
+	default:
+		err = fmt.Errorf("format must be one of %s, %s", ImportFormatCSV, ImportFormatJSON)
+	}
+	if err != nil {
+		return nil, domainErrors.NewAppError(err, domainErrors.ValidationError)
+	}
+	if len(menus) == 0 {
+		return nil, domainErrors.NewAppError(errors.New("the import has no menus"), domainErrors.ValidationError)
+	}
+	if len(menus) > MaxImportRows {
+		return nil, domainErrors.NewAppError(fmt.Errorf("the import has more than %d menus", MaxImportRows), domainErrors.ValidationError)
+	}
+
+	result := &ImportResult{DryRun: dryRun, Rows: len(menus)}
+	menuModels := make([]menuDomain.Menu, 0, len(menus))
+	rows := map[string]int{}
+	for i := range menus {
+		row := i + 1
+		for _, rowError := range menus[i].validate(row) {
+			if !hasImportError(importErrors, row, rowError.Field) {
+				importErrors = append(importErrors, rowError)
+			}
+		}
+		menuModel := menus[i].toDomainMapper()
+		if first, ok := rows[strings.ToLower(menuModel.Name)]; ok && menuModel.Name != "" {
+			importErrors = append(importErrors, ImportError{Row: row, Field: "name", Message: fmt.Sprintf("name is repeated from row %d", first)})
+		} else {
+			rows[strings.ToLower(menuModel.Name)] = row
+		}
+		menuModels = append(menuModels, *menuModel)
+	}
+	sort.SliceStable(importErrors, func(i, j int) bool { return importErrors[i].Row < importErrors[j].Row })
+	result.Errors = importErrors
+
+	if dryRun || len(result.Errors) > 0 {
+		names := make([]string, len(menuModels))
+		for i := range menuModels {
+			names[i] = menuModels[i].Name
+		}
+		existing, err := s.MenuRepository.GetByNames(ctx, names)
+		if err != nil {
+			return nil, err
+		}
+		result.Created, result.Updated, result.Unchanged = compareImport(menuModels, existing)
+		return result, nil
+	}
+
+	// every menu created or updated is told about in the transaction of the upsert
+	var upserted *repository.UpsertResultMenu
+	err = s.Events.PublishAll(ctx, func(ctx context.Context) ([]webhookUseCase.Event, error) {
+		result, err := s.MenuRepository.Upsert(ctx, menuModels)
+		if err != nil {
+			return nil, err
+		}
+		upserted = result
+		events := make([]webhookUseCase.Event, 0, len(upserted.CreatedMenus)+len(upserted.UpdatedMenus))
+		for i := range upserted.CreatedMenus {
+			events = append(events, webhookUseCase.Event{Name: webhookDomain.EventMenuCreated, Data: &upserted.CreatedMenus[i]})
+		}
+		for i := range upserted.UpdatedMenus {
+			events = append(events, webhookUseCase.Event{Name: webhookDomain.EventMenuUpdated, Data: &upserted.UpdatedMenus[i]})
+		}
+		return events, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	result.Applied = true
+	result.Created, result.Updated, result.Unchanged = upserted.Created, upserted.Updated, upserted.Unchanged
+	return result, nil
+}
+
+// Export is a function that passes every menu sorted by name to fn, one at a time so that the menus are never all
+// held in memory
+func (s *Service) Export(ctx context.Context, fn func(menu *menuDomain.Menu) error) error {
+	return s.MenuRepository.Iterate(ctx, fn)
 }
 
 // Delete is a function that deletes a menu by id
 func (s *Service) Delete(ctx context.Context, id int64) error {
-	return s.MenuRepository.Delete(ctx, id)
+	return s.Events.Publish(ctx, webhookDomain.EventMenuDeleted, func(ctx context.Context) (interface{}, error) {
+		return map[string]int64{"id": id}, s.MenuRepository.Delete(ctx, id)
+	})
+}
+
+// languageTag matches the language tags the translations are stored under, like fr or pt-br
+var languageTag = regexp.MustCompile(`^[a-z]{2,3}(-[a-z0-9]{2,8})*$`)
+
+// Localise is a function that replaces the name and description of the menus by their translation in the first of
+// languages, sorted by preference, that they are translated in. A language falls back to its base language, as
+// fr-ca to fr, and the menus without a translation stay in the default language. The languages after the default
+// language are never looked up.
+func (s *Service) Localise(ctx context.Context, menus []menuDomain.Menu, languages []string) error {
+	candidates := languageCandidates(languages)
+	for i := range menus {
+		menus[i].Language = menuDomain.DefaultLanguage
+	}
+	if s.TranslationRepository == nil || len(candidates) == 0 || len(menus) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, len(menus))
+	for i := range menus {
+		ids[i] = menus[i].ID
+	}
+	translations, err := s.TranslationRepository.GetByMenuIDs(ctx, ids, candidates)
+	if err != nil {
+		return err
+	}
+	byMenu := map[int64]map[string]menuDomain.Translation{}
+	for _, translation := range translations {
+		if byMenu[translation.MenuID] == nil {
+			byMenu[translation.MenuID] = map[string]menuDomain.Translation{}
+		}
+		byMenu[translation.MenuID][translation.Language] = translation
+	}
+
+	for i := range menus {
+		for _, language := range candidates {
+			if translation, ok := byMenu[menus[i].ID][language]; ok {
+				menus[i].Name = translation.Name
+				if translation.Description != "" {
+					menus[i].Description = translation.Description
+				}
+				menus[i].Language = language
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// languageCandidates lists the languages to look the translations up in, each language followed by its base language,
+// up to the default language
+func languageCandidates(languages []string) []string {
+	var candidates []string
+	seen := map[string]bool{}
+	for _, language := range languages {
+		language = strings.ToLower(language)
+		for _, candidate := range []string{language, strings.SplitN(language, "-", 2)[0]} {
+			if candidate == menuDomain.DefaultLanguage {
+				return candidates
+			}
+			if !seen[candidate] && languageTag.MatchString(candidate) {
+				seen[candidate] = true
+				candidates = append(candidates, candidate)
+			}
+		}
+	}
+	return candidates
+}
+
+// GetTranslations is a function that returns every translation of a menu
+func (s *Service) GetTranslations(ctx context.Context, menuID int64) ([]menuDomain.Translation, error) {
+	if _, err := s.MenuRepository.GetByID(ctx, menuID); err != nil {
+		return nil, err
+	}
+	if s.TranslationRepository == nil {
+		return []menuDomain.Translation{}, nil
+	}
+	return s.TranslationRepository.GetByMenuID(ctx, menuID)
+}
+
+// PutTranslation is a function that creates or replaces the translation of a menu in a language other than the
+// default language
+func (s *Service) PutTranslation(ctx context.Context, translation *NewTranslation) (*menuDomain.Translation, error) {
+	translationModel := translation.toDomainMapper()
+	if !languageTag.MatchString(translationModel.Language) {
+		return nil, domainErrors.NewAppError(fmt.Errorf("language %s is not a language tag like fr or pt-br", translation.Language), domainErrors.ValidationError)
+	}
+	if translationModel.Language == menuDomain.DefaultLanguage {
+		return nil, domainErrors.NewAppError(fmt.Errorf("language %s is the language of the menu itself", menuDomain.DefaultLanguage), domainErrors.ValidationError)
+	}
+	if s.TranslationRepository == nil {
+		return nil, errors.New("menu translations are not set up")
+	}
+	if _, err := s.MenuRepository.GetByID(ctx, translationModel.MenuID); err != nil {
+		return nil, err
+	}
+	return s.TranslationRepository.Upsert(ctx, translationModel)
+}
+
+// DeleteTranslation is a function that deletes the translation of a menu in a language
+func (s *Service) DeleteTranslation(ctx context.Context, menuID int64, language string) error {
+	if s.TranslationRepository == nil {
+		return domainErrors.NewAppErrorWithType(domainErrors.NotFound)
+	}
+	return s.TranslationRepository.Delete(ctx, menuID, strings.ToLower(language))
 }
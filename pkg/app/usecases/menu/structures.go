@@ -2,6 +2,8 @@
 package menu
 
 import (
+	"time"
+
 	domainMenu "github.com/Raj63/golang-rest-api/pkg/domain/menu"
 )
 
@@ -9,16 +11,67 @@ import (
 type NewMenu struct {
 	Name        string  `json:"name" example:"Paracetamol"`
 	Description string  `json:"description" example:"Some Description"`
+	Category    string  `json:"category" example:"briyani"`
 	Price       float64 `json:"price" example:"200.50"`
 }
 
+// NewTranslation is a struct that contains the name and description of a menu in a language
+type NewTranslation struct {
+	MenuID      int64
+	Language    string
+	Name        string
+	Description string
+}
+
+// TopMenus is a struct that contains the criteria for ranking the top menus
+type TopMenus struct {
+	Count   int
+	From    *time.Time
+	To      *time.Time
+	RankBy  string
+	GroupBy string
+}
+
+// PageQuery is a struct that contains the page requested, the keyset page of Cursor, the page number Page, or the
+// first keyset page when neither is given
+type PageQuery struct {
+	// Cursor is the opaque NextCursor or PrevCursor of a previous page
+	Cursor string
+	Page   int64
+	Limit  int64
+	// Sort is a comma separated list of SortFields, each descending when prefixed by a minus
+	Sort string
+	// WithTotal counts the total of a keyset page, page numbers always come with it
+	WithTotal bool
+}
+
 // PaginationResultMenu is a struct that contains the pagination result for menu
 type PaginationResultMenu struct {
 	Data       *[]domainMenu.Menu
 	Total      int64
 	Limit      int64
 	Current    int64
-	NextCursor uint
-	PrevCursor uint
+	NextCursor string
+	PrevCursor string
 	NumPages   int64
 }
+
+// ImportResult is a struct that contains the outcome of a menu import. The menus are only written when the import is
+// not a dry run and every row is valid, otherwise Created, Updated and Unchanged tell what the import would do.
+type ImportResult struct {
+	DryRun    bool          `json:"dry_run"`
+	Applied   bool          `json:"applied"`
+	Rows      int           `json:"rows"`
+	Created   int           `json:"created"`
+	Updated   int           `json:"updated"`
+	Unchanged int           `json:"unchanged"`
+	Errors    []ImportError `json:"errors,omitempty"`
+}
+
+// ImportError is a struct that contains a validation error of a row of a menu import, the rows counted from 1 after
+// the CSV header
+type ImportError struct {
+	Row     int    `json:"row" example:"3"`
+	Field   string `json:"field,omitempty" example:"price"`
+	Message string `json:"message" example:"price must be a positive amount with at most 2 decimals"`
+}
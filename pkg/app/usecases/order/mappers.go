@@ -2,6 +2,9 @@
 package order
 
 import (
+	"fmt"
+
+	domainErrors "github.com/Raj63/golang-rest-api/pkg/domain/errors"
 	domainOrder "github.com/Raj63/golang-rest-api/pkg/domain/order"
 )
 
@@ -12,3 +15,21 @@ func (n *NewOrder) toDomainMapper() *domainOrder.Request {
 		Quantity: n.Quantity,
 	}
 }
+
+// validate returns the validation errors of a new order
+func (n *NewOrder) validate() []domainErrors.FieldError {
+	var fields []domainErrors.FieldError
+	if n.DinnerID <= 0 {
+		fields = append(fields, domainErrors.FieldError{Field: "diner_id", Message: "diner_id is required"})
+	}
+	if n.MenuID <= 0 {
+		fields = append(fields, domainErrors.FieldError{Field: "menu_id", Message: "menu_id is required"})
+	}
+	if n.Quantity < domainOrder.MinQuantity || n.Quantity > domainOrder.MaxQuantity {
+		fields = append(fields, domainErrors.FieldError{
+			Field:   "quantity",
+			Message: fmt.Sprintf("quantity must be between %d and %d", domainOrder.MinQuantity, domainOrder.MaxQuantity),
+		})
+	}
+	return fields
+}
@@ -4,13 +4,18 @@ package order
 import (
 	"context"
 
+	webhookUseCase "github.com/Raj63/golang-rest-api/pkg/app/usecases/webhook"
+	domainErrors "github.com/Raj63/golang-rest-api/pkg/domain/errors"
 	orderDomain "github.com/Raj63/golang-rest-api/pkg/domain/order"
+	webhookDomain "github.com/Raj63/golang-rest-api/pkg/domain/webhook"
 	"github.com/Raj63/golang-rest-api/pkg/infrastructure/repository"
 )
 
 // Service is a struct that contains the repository implementation for order use case
 type Service struct {
 	OrderRepository repository.Orders
+	// Events tells the partners about every order created, served and deleted
+	Events webhookUseCase.Events
 }
 
 // GetByID is a function that returns a order by diner ID
@@ -18,13 +23,48 @@ func (s *Service) GetByID(ctx context.Context, dinerID int64) ([]orderDomain.Res
 	return s.OrderRepository.GetByID(ctx, dinerID)
 }
 
-// Create is a function that creates a order
+// GetByDinerID is a function that returns the orders of the open session of a diner, or of every session
+// of the diner when history is requested
+func (s *Service) GetByDinerID(ctx context.Context, dinerID int64, history bool) ([]orderDomain.Response, error) {
+	if history {
+		return s.OrderRepository.GetByID(ctx, dinerID)
+	}
+	return s.OrderRepository.GetByCurrentSession(ctx, dinerID)
+}
+
+// GetByDinerIDs is a function that returns the orders of many diners at once, of their open sessions or of every
+// session when history is requested
+func (s *Service) GetByDinerIDs(ctx context.Context, dinerIDs []int64, history bool) ([]orderDomain.Response, error) {
+	return s.OrderRepository.GetByDinerIDs(ctx, dinerIDs, history)
+}
+
+// Create is a function that creates a order, of a quantity within the quantities a menu can be ordered in
 func (s *Service) Create(ctx context.Context, order *NewOrder) (*orderDomain.Request, error) {
+	if invalid := order.validate(); len(invalid) > 0 {
+		return nil, domainErrors.NewValidationError(invalid...)
+	}
 	orderModel := order.toDomainMapper()
-	return s.OrderRepository.Create(ctx, orderModel)
+	var created *orderDomain.Request
+	err := s.Events.Publish(ctx, webhookDomain.EventOrderCreated, func(ctx context.Context) (data interface{}, err error) {
+		created, err = s.OrderRepository.Create(ctx, orderModel)
+		return created, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// Serve is a function that records that an order has been served
+func (s *Service) Serve(ctx context.Context, id int64) error {
+	return s.Events.Publish(ctx, webhookDomain.EventOrderServed, func(ctx context.Context) (interface{}, error) {
+		return map[string]int64{"id": id}, s.OrderRepository.Serve(ctx, id)
+	})
 }
 
 // Delete is a function that deletes a order by id
 func (s *Service) Delete(ctx context.Context, id int) error {
-	return s.OrderRepository.Delete(ctx, id)
+	return s.Events.Publish(ctx, webhookDomain.EventOrderDeleted, func(ctx context.Context) (interface{}, error) {
+		return map[string]int{"id": id}, s.OrderRepository.Delete(ctx, id)
+	})
 }
@@ -0,0 +1,16 @@
+// Package privacy provides the use case for the personal data requests of diners
+package privacy
+
+import (
+	domainPrivacy "github.com/Raj63/golang-rest-api/pkg/domain/privacy"
+)
+
+func (n *NewRequest) toDomainMapper(dinerID int64, kind string) *domainPrivacy.Request {
+	return &domainPrivacy.Request{
+		DinerID:     dinerID,
+		Kind:        kind,
+		RequestedBy: n.RequestedBy,
+		Reason:      n.Reason,
+		Channel:     n.Channel,
+	}
+}
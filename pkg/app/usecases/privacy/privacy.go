@@ -0,0 +1,105 @@
+// Package privacy provides the use case for the personal data requests of diners
+package privacy
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	domainErrors "github.com/Raj63/golang-rest-api/pkg/domain/errors"
+	privacyDomain "github.com/Raj63/golang-rest-api/pkg/domain/privacy"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/repository"
+)
+
+// Service is a struct that contains the repository implementation for privacy use case
+type Service struct {
+	PrivacyRepository  repository.PrivacyRequests
+	DinerRepository    repository.Diners
+	OrderRepository    repository.Orders
+	CustomerRepository repository.Customers
+}
+
+// Export is a function that gathers everything held about a diner into an archive and records the request
+func (s *Service) Export(ctx context.Context, dinerID int64, request *NewRequest) (*privacyDomain.Archive, error) {
+	if err := validate(request); err != nil {
+		return nil, err
+	}
+
+	diner, err := s.DinerRepository.GetByID(ctx, dinerID)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions, err := s.DinerRepository.GetSessions(ctx, dinerID)
+	if err != nil {
+		return nil, err
+	}
+
+	orders, err := s.OrderRepository.GetByID(ctx, dinerID)
+	if err != nil {
+		return nil, err
+	}
+
+	payments, err := s.PrivacyRepository.GetPayments(ctx, dinerID)
+	if err != nil {
+		return nil, err
+	}
+
+	customerIDs, err := s.PrivacyRepository.GetCustomerIDs(ctx, dinerID)
+	if err != nil {
+		return nil, err
+	}
+
+	customers := make([]privacyDomain.Customer, 0, len(customerIDs))
+	for _, customerID := range customerIDs {
+		customer, err := s.CustomerRepository.GetByID(ctx, customerID)
+		if err != nil {
+			return nil, err
+		}
+		ledger, err := s.CustomerRepository.GetLedger(ctx, customerID)
+		if err != nil {
+			return nil, err
+		}
+		customers = append(customers, privacyDomain.Customer{Customer: *customer, Ledger: ledger})
+	}
+
+	if _, err := s.PrivacyRepository.Create(ctx, request.toDomainMapper(dinerID, privacyDomain.KindExport)); err != nil {
+		return nil, err
+	}
+
+	return &privacyDomain.Archive{
+		ExportedAt: time.Now(),
+		Diner:      *diner,
+		Sessions:   sessions,
+		Orders:     orders,
+		Payments:   payments,
+		Customers:  customers,
+	}, nil
+}
+
+// Anonymise is a function that erases the personal data of a diner, keeping their order and payment history, and records the request
+func (s *Service) Anonymise(ctx context.Context, dinerID int64, request *NewRequest) (*privacyDomain.Request, error) {
+	if err := validate(request); err != nil {
+		return nil, err
+	}
+
+	return s.PrivacyRepository.Anonymise(ctx, dinerID, request.toDomainMapper(dinerID, privacyDomain.KindAnonymise))
+}
+
+// GetRequests is a function that returns the audit trail of the privacy requests made about a diner
+func (s *Service) GetRequests(ctx context.Context, dinerID int64) ([]privacyDomain.Request, error) {
+	return s.PrivacyRepository.GetByDinerID(ctx, dinerID)
+}
+
+func validate(request *NewRequest) error {
+	request.RequestedBy = strings.TrimSpace(request.RequestedBy)
+	if request.RequestedBy == "" {
+		return domainErrors.NewAppError(errors.New("requested_by is required for the audit record"), domainErrors.ValidationError)
+	}
+	if request.Channel == "" {
+		request.Channel = privacyDomain.ChannelAPI
+	}
+
+	return nil
+}
@@ -0,0 +1,9 @@
+// Package privacy provides the use case for the personal data requests of diners
+package privacy
+
+// NewRequest is a struct that contains who asked for a personal data request and why
+type NewRequest struct {
+	RequestedBy string `json:"requested_by" example:"privacy-officer@example.com"`
+	Reason      string `json:"reason" example:"subject access request #42"`
+	Channel     string `json:"channel" example:"api"`
+}
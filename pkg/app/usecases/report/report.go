@@ -0,0 +1,182 @@
+// Package report provides the use case for the management reports
+package report
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	domainErrors "github.com/Raj63/golang-rest-api/pkg/domain/errors"
+	domainReport "github.com/Raj63/golang-rest-api/pkg/domain/report"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/repository"
+)
+
+// MaxRangeDays is the longest date range a report can cover
+var MaxRangeDays = 366
+
+// InitialRefreshDays is how many days back the first refresh of the aggregates looks for orders,
+// older days are aggregated by a backfill
+var InitialRefreshDays = 7
+
+const dateLayout = "2006-01-02"
+
+// Service is a struct that contains the repository implementation for the report use case
+type Service struct {
+	ReportRepository repository.Reports
+}
+
+// GetSales is a function that returns the sales within a date range grouped by day, hour, weekday or menu. Whole past
+// days that are all aggregated are read from the daily aggregates, anything else from the orders.
+func (s *Service) GetSales(ctx context.Context, query *SalesQuery) (*domainReport.Sales, error) {
+	if query.GroupBy == "" {
+		query.GroupBy = domainReport.GroupByDay
+	}
+	switch query.GroupBy {
+	case domainReport.GroupByDay, domainReport.GroupByHour, domainReport.GroupByWeekday, domainReport.GroupByMenu, domainReport.GroupByTable:
+	default:
+		return nil, domainErrors.NewAppError(fmt.Errorf("group_by must be one of %s, %s, %s, %s, %s",
+			domainReport.GroupByDay, domainReport.GroupByHour, domainReport.GroupByWeekday, domainReport.GroupByMenu, domainReport.GroupByTable), domainErrors.ValidationError)
+	}
+	if err := validateRange(query.From, query.To); err != nil {
+		return nil, err
+	}
+
+	aggregated, err := s.isAggregated(ctx, query.From, query.To)
+	if err != nil {
+		return nil, err
+	}
+	var rows []domainReport.SalesRow
+	if aggregated {
+		rows, err = s.ReportRepository.GetAggregatedSales(ctx, query.From, query.To, query.GroupBy)
+	} else {
+		rows, err = s.ReportRepository.GetSales(ctx, query.From, query.To, query.GroupBy)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &domainReport.Sales{
+		From:    query.From,
+		To:      query.To,
+		GroupBy: query.GroupBy,
+		Rows:    rows,
+	}, nil
+}
+
+// GetServiceTimes is a function that returns the average seat-to-first-order, order-to-serve and occupancy times
+// of the dining sessions checked in within a date range, grouped by table, section, daypart or weekday
+func (s *Service) GetServiceTimes(ctx context.Context, query *ServiceTimesQuery) (*domainReport.ServiceTimes, error) {
+	if query.GroupBy == "" {
+		query.GroupBy = domainReport.GroupByTable
+	}
+	switch query.GroupBy {
+	case domainReport.GroupByTable, domainReport.GroupBySection, domainReport.GroupByDaypart, domainReport.GroupByWeekday:
+	default:
+		return nil, domainErrors.NewAppError(fmt.Errorf("group_by must be one of %s, %s, %s, %s",
+			domainReport.GroupByTable, domainReport.GroupBySection, domainReport.GroupByDaypart, domainReport.GroupByWeekday), domainErrors.ValidationError)
+	}
+	if err := validateRange(query.From, query.To); err != nil {
+		return nil, err
+	}
+
+	rows, err := s.ReportRepository.GetServiceTimes(ctx, query.From, query.To, query.GroupBy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domainReport.ServiceTimes{
+		From:    query.From,
+		To:      query.To,
+		GroupBy: query.GroupBy,
+		Rows:    rows,
+	}, nil
+}
+
+// RefreshAggregates is a function that recomputes the sales aggregates of today and of the days whose orders
+// were placed, changed or deleted since the last refresh. It returns the number of days refreshed.
+func (s *Service) RefreshAggregates(ctx context.Context) (int, error) {
+	now := time.Now()
+	since, err := s.ReportRepository.GetWatermark(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if since.IsZero() {
+		since = now.AddDate(0, 0, -InitialRefreshDays)
+	}
+
+	changed, err := s.ReportRepository.GetChangedDays(ctx, since)
+	if err != nil {
+		return 0, err
+	}
+
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+	days := map[string]time.Time{today.Format(dateLayout): today}
+	for _, day := range changed {
+		days[day.Format(dateLayout)] = day
+	}
+	for _, day := range days {
+		if err := s.ReportRepository.RefreshDay(ctx, day); err != nil {
+			return 0, err
+		}
+	}
+
+	// orders changed while refreshing are picked up by the next refresh as the watermark is taken before reading
+	if err := s.ReportRepository.SetWatermark(ctx, now); err != nil {
+		return 0, err
+	}
+	return len(days), nil
+}
+
+// Backfill is a function that recomputes the sales aggregates of every day from one day to another, both included
+func (s *Service) Backfill(ctx context.Context, from time.Time, to time.Time) (int, error) {
+	if to.Before(from) {
+		return 0, domainErrors.NewAppError(errors.New("from must not be after to"), domainErrors.ValidationError)
+	}
+
+	days := 0
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		if err := s.ReportRepository.RefreshDay(ctx, day); err != nil {
+			return days, err
+		}
+		days++
+	}
+	return days, nil
+}
+
+// isAggregated tells whether the range covers whole days that are over and that all have been aggregated since their
+// last order was deleted
+func (s *Service) isAggregated(ctx context.Context, from time.Time, to time.Time) (bool, error) {
+	if !isMidnight(from) || !isMidnight(to) {
+		return false, nil
+	}
+	now := time.Now()
+	if to.After(time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, to.Location())) {
+		return false, nil
+	}
+
+	days := 0
+	for day := from; day.Before(to); day = day.AddDate(0, 0, 1) {
+		days++
+	}
+
+	aggregatedDays, err := s.ReportRepository.CountAggregatedDays(ctx, from, to)
+	if err != nil {
+		return false, err
+	}
+	return aggregatedDays == days, nil
+}
+
+func isMidnight(t time.Time) bool {
+	return t.Hour() == 0 && t.Minute() == 0 && t.Second() == 0 && t.Nanosecond() == 0
+}
+
+func validateRange(from time.Time, to time.Time) error {
+	if !from.Before(to) {
+		return domainErrors.NewAppError(errors.New("from must not be after to"), domainErrors.ValidationError)
+	}
+	if to.After(from.AddDate(0, 0, MaxRangeDays)) {
+		return domainErrors.NewAppError(fmt.Errorf("a report can cover at most %d days", MaxRangeDays), domainErrors.ValidationError)
+	}
+	return nil
+}
@@ -0,0 +1,237 @@
+package report
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domainErrors "github.com/Raj63/golang-rest-api/pkg/domain/errors"
+	mockRepository "github.com/Raj63/golang-rest-api/pkg/infrastructure/mocks/repository"
+	"github.com/golang/mock/gomock"
+)
+
+// day returns the midnight of the day the given number of days away from today
+func day(offset int) time.Time {
+	now := time.Now()
+	return time.Date(now.Year(), now.Month(), now.Day()+offset, 0, 0, 0, 0, time.Local)
+}
+
+func TestRefreshAggregates(t *testing.T) {
+	errRepository := errors.New("repository failed")
+
+	tests := []struct {
+		name       string
+		mockrepoFn func(t *testing.T) *mockRepository.MockReports
+		outputDays int
+		outputErr  bool
+	}{
+		{
+			name: "First refresh looks back the initial days and refreshes today",
+			mockrepoFn: func(t *testing.T) *mockRepository.MockReports {
+				mRepository := mockRepository.NewMockReports(gomock.NewController(t))
+				mRepository.EXPECT().GetWatermark(gomock.Any()).Return(time.Time{}, nil)
+				mRepository.EXPECT().GetChangedDays(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, since time.Time) ([]time.Time, error) {
+					if since.After(time.Now().AddDate(0, 0, -InitialRefreshDays)) {
+						t.Errorf("Changed days fetched since %v, expected %d days back", since, InitialRefreshDays)
+					}
+					return []time.Time{day(-2)}, nil
+				})
+				mRepository.EXPECT().RefreshDay(gomock.Any(), day(0)).Return(nil)
+				mRepository.EXPECT().RefreshDay(gomock.Any(), day(-2)).Return(nil)
+				mRepository.EXPECT().SetWatermark(gomock.Any(), gomock.Any()).Return(nil)
+				return mRepository
+			},
+			outputDays: 2,
+		},
+		{
+			name: "Refresh the days of the changed and deleted orders once each",
+			mockrepoFn: func(t *testing.T) *mockRepository.MockReports {
+				watermark := time.Now().Add(-time.Hour)
+				mRepository := mockRepository.NewMockReports(gomock.NewController(t))
+				mRepository.EXPECT().GetWatermark(gomock.Any()).Return(watermark, nil)
+				// the day of an order deleted a month ago comes along with today and yesterday
+				mRepository.EXPECT().GetChangedDays(gomock.Any(), watermark).Return([]time.Time{day(-30), day(-1), day(0)}, nil)
+				mRepository.EXPECT().RefreshDay(gomock.Any(), day(0)).Return(nil)
+				mRepository.EXPECT().RefreshDay(gomock.Any(), day(-1)).Return(nil)
+				mRepository.EXPECT().RefreshDay(gomock.Any(), day(-30)).Return(nil)
+				mRepository.EXPECT().SetWatermark(gomock.Any(), gomock.Any()).Return(nil)
+				return mRepository
+			},
+			outputDays: 3,
+		},
+		{
+			name: "Failed to refresh a day keeps the watermark",
+			mockrepoFn: func(t *testing.T) *mockRepository.MockReports {
+				mRepository := mockRepository.NewMockReports(gomock.NewController(t))
+				mRepository.EXPECT().GetWatermark(gomock.Any()).Return(time.Now().Add(-time.Hour), nil)
+				mRepository.EXPECT().GetChangedDays(gomock.Any(), gomock.Any()).Return(nil, nil)
+				mRepository.EXPECT().RefreshDay(gomock.Any(), day(0)).Return(errRepository)
+				mRepository.EXPECT().SetWatermark(gomock.Any(), gomock.Any()).Times(0)
+				return mRepository
+			},
+			outputErr: true,
+		},
+		{
+			name: "Failed to fetch the changed days",
+			mockrepoFn: func(t *testing.T) *mockRepository.MockReports {
+				mRepository := mockRepository.NewMockReports(gomock.NewController(t))
+				mRepository.EXPECT().GetWatermark(gomock.Any()).Return(time.Now().Add(-time.Hour), nil)
+				mRepository.EXPECT().GetChangedDays(gomock.Any(), gomock.Any()).Return(nil, errRepository)
+				return mRepository
+			},
+			outputErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := Service{ReportRepository: tt.mockrepoFn(t)}
+			days, err := service.RefreshAggregates(context.Background())
+			if (err != nil) != tt.outputErr {
+				t.Fatalf("RefreshAggregates returned error %v, expected one: %v", err, tt.outputErr)
+			}
+			if days != tt.outputDays {
+				t.Errorf("RefreshAggregates refreshed wrong number of days. Expected: %d. Got: %d.", tt.outputDays, days)
+			}
+		})
+	}
+}
+
+func TestBackfill(t *testing.T) {
+	tests := []struct {
+		name       string
+		from, to   time.Time
+		mockrepoFn func(t *testing.T) *mockRepository.MockReports
+		outputDays int
+		outputType string
+	}{
+		{
+			name: "Backfill every day of the range, both included",
+			from: day(-3),
+			to:   day(-1),
+			mockrepoFn: func(t *testing.T) *mockRepository.MockReports {
+				mRepository := mockRepository.NewMockReports(gomock.NewController(t))
+				gomock.InOrder(
+					mRepository.EXPECT().RefreshDay(gomock.Any(), day(-3)).Return(nil),
+					mRepository.EXPECT().RefreshDay(gomock.Any(), day(-2)).Return(nil),
+					mRepository.EXPECT().RefreshDay(gomock.Any(), day(-1)).Return(nil),
+				)
+				return mRepository
+			},
+			outputDays: 3,
+		},
+		{
+			name: "Failed to backfill a day stops with the days done so far",
+			from: day(-3),
+			to:   day(-1),
+			mockrepoFn: func(t *testing.T) *mockRepository.MockReports {
+				mRepository := mockRepository.NewMockReports(gomock.NewController(t))
+				gomock.InOrder(
+					mRepository.EXPECT().RefreshDay(gomock.Any(), day(-3)).Return(nil),
+					mRepository.EXPECT().RefreshDay(gomock.Any(), day(-2)).Return(domainErrors.NewAppErrorWithType(domainErrors.RepositoryError)),
+				)
+				return mRepository
+			},
+			outputDays: 1,
+			outputType: domainErrors.RepositoryError,
+		},
+		{
+			name: "Failed to backfill a range ending before it starts",
+			from: day(-1),
+			to:   day(-3),
+			mockrepoFn: func(t *testing.T) *mockRepository.MockReports {
+				return mockRepository.NewMockReports(gomock.NewController(t))
+			},
+			outputType: domainErrors.ValidationError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := Service{ReportRepository: tt.mockrepoFn(t)}
+			days, err := service.Backfill(context.Background(), tt.from, tt.to)
+			if tt.outputType == "" && err != nil {
+				t.Fatalf("Backfill returned unexpected error %v", err)
+			}
+			if tt.outputType != "" && domainErrors.ErrorType(err) != tt.outputType {
+				t.Fatalf("Backfill returned wrong error. Expected: %s. Got: %v.", tt.outputType, err)
+			}
+			if days != tt.outputDays {
+				t.Errorf("Backfill refreshed wrong number of days. Expected: %d. Got: %d.", tt.outputDays, days)
+			}
+		})
+	}
+}
+
+func TestIsAggregated(t *testing.T) {
+	tests := []struct {
+		name       string
+		from, to   time.Time
+		mockrepoFn func(t *testing.T) *mockRepository.MockReports
+		output     bool
+		outputErr  bool
+	}{
+		{
+			name: "Whole past days all aggregated",
+			from: day(-7),
+			to:   day(0),
+			mockrepoFn: func(t *testing.T) *mockRepository.MockReports {
+				mRepository := mockRepository.NewMockReports(gomock.NewController(t))
+				mRepository.EXPECT().CountAggregatedDays(gomock.Any(), day(-7), day(0)).Return(7, nil)
+				return mRepository
+			},
+			output: true,
+		},
+		{
+			name: "A day with a deleted order is not counted as aggregated",
+			from: day(-7),
+			to:   day(0),
+			mockrepoFn: func(t *testing.T) *mockRepository.MockReports {
+				mRepository := mockRepository.NewMockReports(gomock.NewController(t))
+				mRepository.EXPECT().CountAggregatedDays(gomock.Any(), day(-7), day(0)).Return(6, nil)
+				return mRepository
+			},
+		},
+		{
+			name: "A range including today is read from the orders",
+			from: day(-7),
+			to:   day(1),
+			mockrepoFn: func(t *testing.T) *mockRepository.MockReports {
+				return mockRepository.NewMockReports(gomock.NewController(t))
+			},
+		},
+		{
+			name: "A range not made of whole days is read from the orders",
+			from: day(-7).Add(time.Hour),
+			to:   day(-1),
+			mockrepoFn: func(t *testing.T) *mockRepository.MockReports {
+				return mockRepository.NewMockReports(gomock.NewController(t))
+			},
+		},
+		{
+			name: "Failed to count the aggregated days",
+			from: day(-7),
+			to:   day(0),
+			mockrepoFn: func(t *testing.T) *mockRepository.MockReports {
+				mRepository := mockRepository.NewMockReports(gomock.NewController(t))
+				mRepository.EXPECT().CountAggregatedDays(gomock.Any(), gomock.Any(), gomock.Any()).Return(0, errors.New("repository failed"))
+				return mRepository
+			},
+			outputErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := Service{ReportRepository: tt.mockrepoFn(t)}
+			aggregated, err := service.isAggregated(context.Background(), tt.from, tt.to)
+			if (err != nil) != tt.outputErr {
+				t.Fatalf("isAggregated returned error %v, expected one: %v", err, tt.outputErr)
+			}
+			if aggregated != tt.output {
+				t.Errorf("isAggregated returned wrong result. Expected: %v. Got: %v.", tt.output, aggregated)
+			}
+		})
+	}
+}
@@ -0,0 +1,18 @@
+// Package report provides the use case for the management reports
+package report
+
+import "time"
+
+// SalesQuery is a struct that contains the date range and grouping of a sales report. To is exclusive.
+type SalesQuery struct {
+	From    time.Time
+	To      time.Time
+	GroupBy string
+}
+
+// ServiceTimesQuery is a struct that contains the date range and grouping of a service times report. To is exclusive.
+type ServiceTimesQuery struct {
+	From    time.Time
+	To      time.Time
+	GroupBy string
+}
@@ -0,0 +1,23 @@
+// Package reservation provides the use case for reservation
+package reservation
+
+import (
+	domainReservation "github.com/Raj63/golang-rest-api/pkg/domain/reservation"
+)
+
+func (n *NewReservation) toDomainMapper() *domainReservation.Reservation {
+	durationMinutes := n.DurationMinutes
+	if durationMinutes == 0 {
+		durationMinutes = domainReservation.DefaultDurationMinutes
+	}
+
+	return &domainReservation.Reservation{
+		Name:            n.Name,
+		Contact:         n.Contact,
+		PartySize:       n.PartySize,
+		TableNumber:     n.TableNumber,
+		ReservedAt:      n.ReservedAt,
+		DurationMinutes: durationMinutes,
+		Status:          domainReservation.StatusBooked,
+	}
+}
@@ -0,0 +1,174 @@
+// Package reservation provides the use case for reservation
+package reservation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	domainErrors "github.com/Raj63/golang-rest-api/pkg/domain/errors"
+	reservationDomain "github.com/Raj63/golang-rest-api/pkg/domain/reservation"
+	tableDomain "github.com/Raj63/golang-rest-api/pkg/domain/table"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/repository"
+)
+
+var (
+	// OpeningHour is the hour of the day the first reservation slot starts
+	OpeningHour = 11
+	// ClosingHour is the hour of the day by which every reservation slot must have ended
+	ClosingHour = 23
+	// SlotInterval is the interval between two consecutive reservation slots
+	SlotInterval = 30 * time.Minute
+)
+
+// allowedTransitions lists the statuses a reservation may move to from its current status
+var allowedTransitions = map[string][]string{
+	reservationDomain.StatusBooked: {reservationDomain.StatusSeated, reservationDomain.StatusNoShow, reservationDomain.StatusCancelled},
+}
+
+// Service is a struct that contains the repository implementation for reservation use case
+type Service struct {
+	ReservationRepository repository.Reservations
+	TableRepository       repository.Tables
+}
+
+// GetAll is a function that returns all reservations of the given date
+func (s *Service) GetAll(ctx context.Context, date time.Time) ([]reservationDomain.Reservation, error) {
+	from := startOfDay(date)
+	return s.ReservationRepository.GetAllBetween(ctx, from, from.AddDate(0, 0, 1))
+}
+
+// GetByID is a function that returns a reservation by id
+func (s *Service) GetByID(ctx context.Context, id int64) (*reservationDomain.Reservation, error) {
+	return s.ReservationRepository.GetByID(ctx, id)
+}
+
+// Create is a function that creates a reservation, assigning the smallest free table seating the party
+// when no table is requested
+func (s *Service) Create(ctx context.Context, reservation *NewReservation) (*reservationDomain.Reservation, error) {
+	reservationModel := reservation.toDomainMapper()
+	if reservationModel.PartySize <= 0 {
+		return nil, domainErrors.NewAppError(errors.New("party size must be greater than zero"), domainErrors.ValidationError)
+	}
+	if reservationModel.DurationMinutes < 0 {
+		return nil, domainErrors.NewAppError(errors.New("duration must be greater than zero"), domainErrors.ValidationError)
+	}
+	if !reservationModel.ReservedAt.After(time.Now()) {
+		return nil, domainErrors.NewAppError(errors.New("reservation time must be in the future"), domainErrors.ValidationError)
+	}
+
+	tables, err := s.TableRepository.GetByMinCapacity(ctx, reservationModel.PartySize)
+	if err != nil {
+		return nil, err
+	}
+
+	var tableNumbers []int
+	for _, table := range tables {
+		if reservationModel.TableNumber == 0 || reservationModel.TableNumber == table.TableNumber {
+			tableNumbers = append(tableNumbers, table.TableNumber)
+		}
+	}
+	if len(tableNumbers) == 0 {
+		if reservationModel.TableNumber != 0 {
+			return nil, domainErrors.NewAppError(fmt.Errorf("table %d cannot seat a party of %d", reservationModel.TableNumber, reservationModel.PartySize), domainErrors.ValidationError)
+		}
+		return nil, domainErrors.NewAppError(fmt.Errorf("no table can seat a party of %d", reservationModel.PartySize), domainErrors.ValidationError)
+	}
+
+	return s.ReservationRepository.Create(ctx, reservationModel, tableNumbers)
+}
+
+// UpdateStatus is a function that moves a reservation to the given status
+func (s *Service) UpdateStatus(ctx context.Context, id int64, status string) (*reservationDomain.Reservation, error) {
+	reservation, err := s.ReservationRepository.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isAllowedTransition(reservation.Status, status) {
+		return nil, domainErrors.NewAppError(fmt.Errorf("reservation cannot move from %s to %s", reservation.Status, status), domainErrors.ValidationError)
+	}
+
+	if err := s.ReservationRepository.UpdateStatus(ctx, id, status); err != nil {
+		return nil, err
+	}
+
+	reservation.Status = status
+	return reservation, nil
+}
+
+// Availability is a function that returns the slots of the given date with at least one free table for the party size
+func (s *Service) Availability(ctx context.Context, date time.Time, partySize int) ([]reservationDomain.Slot, error) {
+	if partySize <= 0 {
+		return nil, domainErrors.NewAppError(errors.New("party size must be greater than zero"), domainErrors.ValidationError)
+	}
+
+	tables, err := s.TableRepository.GetByMinCapacity(ctx, partySize)
+	if err != nil {
+		return nil, err
+	}
+
+	day := startOfDay(date)
+	opening := day.Add(time.Duration(OpeningHour) * time.Hour)
+	closing := day.Add(time.Duration(ClosingHour) * time.Hour)
+	duration := time.Duration(reservationDomain.DefaultDurationMinutes) * time.Minute
+
+	occupancies, err := s.ReservationRepository.GetOccupancies(ctx, opening, closing)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	slots := []reservationDomain.Slot{}
+	for startAt := opening; !startAt.Add(duration).After(closing); startAt = startAt.Add(SlotInterval) {
+		if startAt.Before(now) {
+			continue
+		}
+
+		endAt := startAt.Add(duration)
+		freeTables := freeTablesBetween(tables, occupancies, startAt, endAt)
+		if len(freeTables) > 0 {
+			slots = append(slots, reservationDomain.Slot{
+				StartAt: startAt,
+				EndAt:   endAt,
+				Tables:  freeTables,
+			})
+		}
+	}
+
+	return slots, nil
+}
+
+func freeTablesBetween(tables []tableDomain.Table, occupancies []reservationDomain.Occupancy, startAt, endAt time.Time) []tableDomain.Table {
+	freeTables := []tableDomain.Table{}
+	for _, table := range tables {
+		free := true
+		for i := range occupancies {
+			if occupancies[i].TableNumber == table.TableNumber && occupancies[i].Overlaps(startAt, endAt) {
+				free = false
+				break
+			}
+		}
+		if free {
+			freeTables = append(freeTables, table)
+		}
+	}
+
+	return freeTables
+}
+
+func isAllowedTransition(from, to string) bool {
+	for _, status := range allowedTransitions[from] {
+		if status == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+func startOfDay(date time.Time) time.Time {
+	year, month, day := date.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, date.Location())
+}
@@ -0,0 +1,16 @@
+// Package reservation provides the use case for reservation
+package reservation
+
+import (
+	"time"
+)
+
+// NewReservation is a struct that contains the data for a new reservation
+type NewReservation struct {
+	Name            string    `json:"name" example:"Mr. Smith"`
+	Contact         string    `json:"contact" example:"+91 98765 43210"`
+	PartySize       int       `json:"party_size" example:"4"`
+	TableNumber     int       `json:"table_no" example:"101"`
+	ReservedAt      time.Time `json:"reserved_at" example:"2021-02-24T20:00:00Z"`
+	DurationMinutes int       `json:"duration_minutes" example:"90"`
+}
@@ -0,0 +1,15 @@
+// Package waitlist provides the use case for the walk-in waitlist
+package waitlist
+
+import (
+	domainWaitlist "github.com/Raj63/golang-rest-api/pkg/domain/waitlist"
+)
+
+func (n *NewEntry) toDomainMapper() *domainWaitlist.Entry {
+	return &domainWaitlist.Entry{
+		Name:      n.Name,
+		Contact:   n.Contact,
+		PartySize: n.PartySize,
+		Status:    domainWaitlist.StatusWaiting,
+	}
+}
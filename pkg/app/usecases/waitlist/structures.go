@@ -0,0 +1,9 @@
+// Package waitlist provides the use case for the walk-in waitlist
+package waitlist
+
+// NewEntry is a struct that contains the data for a new waitlist entry
+type NewEntry struct {
+	Name      string `json:"name" example:"Mr. Smith"`
+	Contact   string `json:"contact" example:"+91 98765 43210"`
+	PartySize int    `json:"party_size" example:"4"`
+}
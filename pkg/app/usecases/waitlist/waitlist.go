@@ -0,0 +1,283 @@
+// Package waitlist provides the use case for the walk-in waitlist
+package waitlist
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	domainErrors "github.com/Raj63/golang-rest-api/pkg/domain/errors"
+	reservationDomain "github.com/Raj63/golang-rest-api/pkg/domain/reservation"
+	tableDomain "github.com/Raj63/golang-rest-api/pkg/domain/table"
+	waitlistDomain "github.com/Raj63/golang-rest-api/pkg/domain/waitlist"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/repository"
+)
+
+var (
+	// TurnLookback is how far back the table turn history is read for wait time estimates
+	TurnLookback = 30 * 24 * time.Hour
+)
+
+// allowedTransitions lists the statuses a waitlist entry may move to from its current status
+var allowedTransitions = map[string][]string{
+	waitlistDomain.StatusWaiting:  {waitlistDomain.StatusSeated, waitlistDomain.StatusLeft},
+	waitlistDomain.StatusNotified: {waitlistDomain.StatusSeated, waitlistDomain.StatusLeft},
+}
+
+// Service is a struct that contains the repository implementation for waitlist use case
+type Service struct {
+	WaitlistRepository    repository.Waitlist
+	ReservationRepository repository.Reservations
+	TableRepository       repository.Tables
+	Notifier              waitlistDomain.Notifier
+}
+
+// GetAll is a function that returns the active waitlist with queue positions and wait estimates
+func (s *Service) GetAll(ctx context.Context) ([]waitlistDomain.Entry, error) {
+	entries, err := s.WaitlistRepository.GetActive(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.estimate(ctx, entries); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// GetByID is a function that returns a waitlist entry by id with its queue position and wait estimate
+func (s *Service) GetByID(ctx context.Context, id int64) (*waitlistDomain.Entry, error) {
+	entry, err := s.WaitlistRepository.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if entry.Status != waitlistDomain.StatusWaiting {
+		return entry, nil
+	}
+
+	entries, err := s.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range entries {
+		if entries[i].ID == id {
+			return &entries[i], nil
+		}
+	}
+
+	return entry, nil
+}
+
+// Create is a function that adds a party to the end of the waitlist
+func (s *Service) Create(ctx context.Context, entry *NewEntry) (*waitlistDomain.Entry, error) {
+	entryModel := entry.toDomainMapper()
+	if entryModel.PartySize <= 0 {
+		return nil, domainErrors.NewAppError(errors.New("party size must be greater than zero"), domainErrors.ValidationError)
+	}
+
+	tables, err := s.TableRepository.GetByMinCapacity(ctx, entryModel.PartySize)
+	if err != nil {
+		return nil, err
+	}
+	if len(tables) == 0 {
+		return nil, domainErrors.NewAppError(fmt.Errorf("no table can seat a party of %d", entryModel.PartySize), domainErrors.ValidationError)
+	}
+
+	created, err := s.WaitlistRepository.Create(ctx, entryModel)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetByID(ctx, created.ID)
+}
+
+// UpdateStatus is a function that marks a waitlist entry as seated or left
+func (s *Service) UpdateStatus(ctx context.Context, id int64, status string) (*waitlistDomain.Entry, error) {
+	entry, err := s.WaitlistRepository.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isAllowedTransition(entry.Status, status) {
+		return nil, domainErrors.NewAppError(fmt.Errorf("waitlist entry cannot move from %s to %s", entry.Status, status), domainErrors.ValidationError)
+	}
+
+	if err := s.WaitlistRepository.UpdateStatus(ctx, id, status); err != nil {
+		return nil, err
+	}
+
+	entry.Status = status
+	return entry, nil
+}
+
+// TableFreed is a function that notifies the first waiting party that fits the freed table.
+// It returns a nil entry when no waiting party fits the table. The party is claimed for the table before it is
+// notified, so that a party claimed by a concurrent free of another table is skipped rather than notified twice.
+func (s *Service) TableFreed(ctx context.Context, tableNumber int) (*waitlistDomain.Entry, error) {
+	tables, err := s.TableRepository.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	capacity := 0
+	for _, table := range tables {
+		if table.TableNumber == tableNumber {
+			capacity = table.Capacity
+		}
+	}
+	if capacity == 0 {
+		return nil, domainErrors.NewAppError(fmt.Errorf("table %d does not exist", tableNumber), domainErrors.NotFound)
+	}
+
+	entries, err := s.WaitlistRepository.GetActive(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range entries {
+		entry := &entries[i]
+		if entry.Status != waitlistDomain.StatusWaiting || entry.PartySize > capacity {
+			continue
+		}
+
+		err := s.WaitlistRepository.MarkNotified(ctx, entry.ID, tableNumber)
+		if domainErrors.ErrorType(err) == domainErrors.NotFound {
+			// the party is no longer waiting
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		entry.TableNumber = tableNumber
+		if s.Notifier != nil {
+			if err := s.Notifier.Notify(ctx, entry); err != nil {
+				// the party keeps its place in the queue for the next free table
+				_ = s.WaitlistRepository.ReleaseNotified(ctx, entry.ID)
+				return nil, err
+			}
+		}
+
+		now := time.Now()
+		entry.Status = waitlistDomain.StatusNotified
+		entry.NotifiedAt = &now
+		return entry, nil
+	}
+
+	return nil, nil
+}
+
+// estimate fills the queue position and estimated wait of the given entries in queue order. Tables become
+// available once their current occupants have stayed the historical turn duration for the table capacity,
+// and each waiting party takes the earliest available table that seats it.
+func (s *Service) estimate(ctx context.Context, entries []waitlistDomain.Entry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	tables, err := s.TableRepository.GetAll(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	turnDurations, err := s.WaitlistRepository.GetTurnDurations(ctx, now.Add(-TurnLookback))
+	if err != nil {
+		return err
+	}
+
+	occupancies, err := s.ReservationRepository.GetOccupancies(ctx, now, now.Add(time.Minute))
+	if err != nil {
+		return err
+	}
+
+	turnByCapacity := map[int]time.Duration{}
+	for _, turnDuration := range turnDurations {
+		if turnDuration.AverageMinutes > 0 {
+			turnByCapacity[turnDuration.Capacity] = time.Duration(turnDuration.AverageMinutes * float64(time.Minute))
+		}
+	}
+	turnOf := func(table tableDomain.Table) time.Duration {
+		if turn, ok := turnByCapacity[table.Capacity]; ok {
+			return turn
+		}
+		return time.Duration(reservationDomain.DefaultDurationMinutes) * time.Minute
+	}
+
+	availableAt := map[int]time.Time{}
+	for _, table := range tables {
+		availableAt[table.TableNumber] = now
+	}
+	for _, table := range tables {
+		for _, occupancy := range occupancies {
+			if occupancy.TableNumber != table.TableNumber {
+				continue
+			}
+			if freeAt := occupancy.StartAt.Add(turnOf(table)); freeAt.After(availableAt[table.TableNumber]) {
+				availableAt[table.TableNumber] = freeAt
+			}
+		}
+	}
+
+	// notified parties are about to take the table they were told about
+	for _, entry := range entries {
+		if entry.Status == waitlistDomain.StatusNotified {
+			for _, table := range tables {
+				if table.TableNumber == entry.TableNumber {
+					availableAt[table.TableNumber] = maxTime(availableAt[table.TableNumber], now).Add(turnOf(table))
+				}
+			}
+		}
+	}
+
+	position := 0
+	for i := range entries {
+		entry := &entries[i]
+		if entry.Status != waitlistDomain.StatusWaiting {
+			continue
+		}
+		position++
+		entry.Position = position
+
+		var best *tableDomain.Table
+		for j := range tables {
+			table := &tables[j]
+			if table.Capacity < entry.PartySize {
+				continue
+			}
+			if best == nil || availableAt[table.TableNumber].Before(availableAt[best.TableNumber]) ||
+				(availableAt[table.TableNumber].Equal(availableAt[best.TableNumber]) && table.Capacity < best.Capacity) {
+				best = table
+			}
+		}
+		if best == nil {
+			continue
+		}
+
+		seatAt := maxTime(availableAt[best.TableNumber], now)
+		entry.EstimatedWaitMinutes = int(math.Ceil(seatAt.Sub(now).Minutes()))
+		availableAt[best.TableNumber] = seatAt.Add(turnOf(*best))
+	}
+
+	return nil
+}
+
+func isAllowedTransition(from, to string) bool {
+	for _, status := range allowedTransitions[from] {
+		if status == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
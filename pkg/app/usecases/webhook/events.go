@@ -0,0 +1,61 @@
+package webhook
+
+import (
+	"context"
+)
+
+// EventPublisher is a interface that is told about the events the partners subscribe to
+type EventPublisher interface {
+	Publish(ctx context.Context, event string, data interface{}) error
+}
+
+// Transactor is a interface that runs a function with a context carrying a database transaction, that all the queries
+// run with the context are part of, and commits the transaction when the function succeeds
+type Transactor interface {
+	Transaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// Events is a struct that runs the changes the partners are told about and tells the Publisher about their events.
+// Its zero value runs the changes alone.
+type Events struct {
+	// Publisher is optional, when set it is told about the event of every change
+	Publisher EventPublisher
+	// Transactor is optional, when set every change is made in the same transaction as its event is queued in, so that
+	// no event is lost nor told about a change rolled back
+	Transactor Transactor
+}
+
+// Event is a struct that contains an event the Publisher is told about, with its data
+type Event struct {
+	Name string
+	Data interface{}
+}
+
+// Publish is a function that runs change and tells the Publisher about the event of its outcome, with the data change
+// returns
+func (e Events) Publish(ctx context.Context, event string, change func(ctx context.Context) (interface{}, error)) error {
+	return e.PublishAll(ctx, func(ctx context.Context) ([]Event, error) {
+		data, err := change(ctx)
+		return []Event{{Name: event, Data: data}}, err
+	})
+}
+
+// PublishAll is a function that runs change and tells the Publisher about every event it returns, in turn
+func (e Events) PublishAll(ctx context.Context, change func(ctx context.Context) ([]Event, error)) error {
+	run := func(ctx context.Context) error {
+		events, err := change(ctx)
+		if err != nil || e.Publisher == nil {
+			return err
+		}
+		for _, event := range events {
+			if err := e.Publisher.Publish(ctx, event.Name, event.Data); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if e.Transactor == nil {
+		return run(ctx)
+	}
+	return e.Transactor.Transaction(ctx, run)
+}
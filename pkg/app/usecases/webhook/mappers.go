@@ -0,0 +1,14 @@
+// Package webhook provides the use case for the webhooks notifying partners of the order and menu events
+package webhook
+
+import (
+	domainWebhook "github.com/Raj63/golang-rest-api/pkg/domain/webhook"
+)
+
+func (n *NewSubscription) toDomainMapper() *domainWebhook.Subscription {
+	return &domainWebhook.Subscription{
+		URL:    n.URL,
+		Events: n.Events,
+		Secret: n.Secret,
+	}
+}
@@ -0,0 +1,9 @@
+// Package webhook provides the use case for the webhooks notifying partners of the order and menu events
+package webhook
+
+// NewSubscription is a struct that contains the data for a new webhook subscription
+type NewSubscription struct {
+	URL    string   `json:"url" example:"https://partner.example.com/webhooks"`
+	Events []string `json:"events" example:"order.created,order.served"`
+	Secret string   `json:"secret" example:"5f2b8c0e3a9d4e7f"`
+}
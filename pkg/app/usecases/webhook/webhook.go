@@ -0,0 +1,369 @@
+// Package webhook provides the use case for the webhooks notifying partners of the order and menu events
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"syscall"
+	"time"
+
+	domainErrors "github.com/Raj63/golang-rest-api/pkg/domain/errors"
+	webhookDomain "github.com/Raj63/golang-rest-api/pkg/domain/webhook"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/repository"
+)
+
+// Service is a struct that contains the repository implementation for webhook use case
+type Service struct {
+	WebhookRepository repository.Webhooks
+	// Client is optional, when set the deliveries are sent with it rather than with a client timing out after
+	// DeliveryTimeout and refusing to connect to anything but public addresses. Redirects are never followed.
+	Client *http.Client
+	// Resolver is optional, when set the hosts of the subscriptions are resolved with it rather than with
+	// net.DefaultResolver
+	Resolver Resolver
+}
+
+// Resolver looks up the addresses of a host
+type Resolver interface {
+	LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error)
+}
+
+// defaultClient sends the deliveries when the Service is given no Client. It only dials public addresses, checked
+// once the host is resolved for the check to hold whatever the host resolves to at the time.
+var defaultClient = &http.Client{
+	Transport: &http.Transport{
+		DialContext: (&net.Dialer{
+			Timeout: DeliveryTimeout,
+			Control: func(network, address string, _ syscall.RawConn) error {
+				host, _, err := net.SplitHostPort(address)
+				if err != nil {
+					return err
+				}
+				if ip := net.ParseIP(host); ip == nil || !publicIP(ip) {
+					return fmt.Errorf("refusing to connect to %s, which is not a public address", address)
+				}
+				return nil
+			},
+		}).DialContext,
+		MaxIdleConns:        100,
+		IdleConnTimeout:     90 * time.Second,
+		TLSHandshakeTimeout: DeliveryTimeout,
+	},
+}
+
+var (
+	// MaxAttempts is the number of times a delivery is attempted before it is dead
+	MaxAttempts = 8
+	// RetryBackoff is how long after its first failed attempt a delivery is attempted again, doubling on every attempt
+	RetryBackoff = 30 * time.Second
+	// MaxRetryBackoff is the longest a delivery waits between two attempts
+	MaxRetryBackoff = 6 * time.Hour
+	// DeliveryTimeout is how long a partner has to acknowledge a delivery
+	DeliveryTimeout = 10 * time.Second
+	// DueBatchSize is the largest number of due deliveries attempted at once
+	DueBatchSize = 100
+	// MinSecretLength is the shortest secret a subscription can be given
+	MinSecretLength = 16
+)
+
+// maxErrorLength is the longest error of an attempt that is kept
+const maxErrorLength = 1024
+
+// Subscribe is a function that creates a subscription of a partner endpoint to some of the events, with a random
+// secret when none is given. The endpoint must only resolve to public addresses.
+func (s *Service) Subscribe(ctx context.Context, subscription *NewSubscription) (*webhookDomain.Subscription, error) {
+	subscriptionModel := subscription.toDomainMapper()
+	endpoint, err := url.Parse(subscriptionModel.URL)
+	if err != nil || (endpoint.Scheme != "http" && endpoint.Scheme != "https") || endpoint.Hostname() == "" {
+		return nil, domainErrors.NewValidationError(domainErrors.FieldError{Field: "url", Message: "url must be an absolute http or https URL"})
+	}
+	if err := s.checkPublicHost(ctx, endpoint.Hostname()); err != nil {
+		return nil, err
+	}
+
+	if len(subscriptionModel.Events) == 0 {
+		return nil, domainErrors.NewValidationError(domainErrors.FieldError{Field: "events", Message: "events must name at least one event"})
+	}
+	events := make([]string, 0, len(subscriptionModel.Events))
+	for _, event := range subscriptionModel.Events {
+		if !knownEvent(event) {
+			return nil, domainErrors.NewValidationError(domainErrors.FieldError{Field: "events", Message: fmt.Sprintf("event %s is not one of %v", event, webhookDomain.Events)})
+		}
+		if !contains(events, event) {
+			events = append(events, event)
+		}
+	}
+	subscriptionModel.Events = events
+
+	switch {
+	case subscriptionModel.Secret == "":
+		if subscriptionModel.Secret, err = newSecret(); err != nil {
+			return nil, err
+		}
+	case len(subscriptionModel.Secret) < MinSecretLength:
+		return nil, domainErrors.NewValidationError(domainErrors.FieldError{Field: "secret", Message: fmt.Sprintf("secret must be at least %d characters long", MinSecretLength)})
+	}
+
+	return s.WebhookRepository.Create(ctx, subscriptionModel)
+}
+
+// GetAll is a function that returns all subscriptions, without their secret
+func (s *Service) GetAll(ctx context.Context) ([]webhookDomain.Subscription, error) {
+	subscriptions, err := s.WebhookRepository.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range subscriptions {
+		subscriptions[i].Secret = ""
+	}
+	return subscriptions, nil
+}
+
+// GetByID is a function that returns a subscription by id, without its secret
+func (s *Service) GetByID(ctx context.Context, id int64) (*webhookDomain.Subscription, error) {
+	subscription, err := s.WebhookRepository.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	subscription.Secret = ""
+	return subscription, nil
+}
+
+// Delete is a function that deletes a subscription by id, with its deliveries
+func (s *Service) Delete(ctx context.Context, id int64) error {
+	return s.WebhookRepository.Delete(ctx, id)
+}
+
+// GetDeliveries is a function that returns the deliveries of a subscription, the latest first, in any status when
+// status is empty
+func (s *Service) GetDeliveries(ctx context.Context, subscriptionID int64, status string) ([]webhookDomain.Delivery, error) {
+	switch status {
+	case "", webhookDomain.StatusPending, webhookDomain.StatusSucceeded, webhookDomain.StatusDead:
+	default:
+		return nil, domainErrors.NewValidationError(domainErrors.FieldError{Field: "status", Message: "status must be one of pending, succeeded, dead"})
+	}
+	if _, err := s.WebhookRepository.GetByID(ctx, subscriptionID); err != nil {
+		return nil, err
+	}
+	return s.WebhookRepository.GetDeliveries(ctx, subscriptionID, status)
+}
+
+// GetDelivery is a function that returns a delivery by id, with the log of its attempts
+func (s *Service) GetDelivery(ctx context.Context, id int64) (*webhookDomain.Delivery, error) {
+	return s.WebhookRepository.GetDelivery(ctx, id)
+}
+
+// Publish is a function that queues a delivery of the event, with its data, to every subscription to it. The
+// deliveries are sent by DeliverDue, at least once each.
+func (s *Service) Publish(ctx context.Context, event string, data interface{}) error {
+	subscriptions, err := s.WebhookRepository.GetByEvent(ctx, event)
+	if err != nil || len(subscriptions) == 0 {
+		return err
+	}
+
+	now := time.Now()
+	payload, err := json.Marshal(webhookDomain.Notification{Event: event, OccurredAt: now, Data: data})
+	if err != nil {
+		return err
+	}
+	deliveries := make([]webhookDomain.Delivery, len(subscriptions))
+	for i, subscription := range subscriptions {
+		deliveries[i] = webhookDomain.Delivery{
+			SubscriptionID: subscription.ID,
+			Event:          event,
+			Payload:        payload,
+			Status:         webhookDomain.StatusPending,
+			NextAttemptAt:  &now,
+		}
+	}
+	_, err = s.WebhookRepository.CreateDeliveries(ctx, deliveries)
+	return err
+}
+
+// DeliverDue is a function that attempts the pending deliveries whose next attempt is due, returning how many were
+// attempted. The deliveries are claimed for long enough to all be attempted, so that no other worker nor replay
+// sends them meanwhile; the claim of a delivery whose attempt is never recorded expires after that long.
+func (s *Service) DeliverDue(ctx context.Context) (int, error) {
+	now := time.Now()
+	claimedUntil := now.Add(time.Duration(DueBatchSize+1) * DeliveryTimeout)
+	deliveries, err := s.WebhookRepository.ClaimDueDeliveries(ctx, now, claimedUntil, DueBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	subscriptions := map[int64]*webhookDomain.Subscription{}
+	for i := range deliveries {
+		subscription, ok := subscriptions[deliveries[i].SubscriptionID]
+		if !ok {
+			if subscription, err = s.WebhookRepository.GetByID(ctx, deliveries[i].SubscriptionID); err != nil {
+				return i, err
+			}
+			subscriptions[subscription.ID] = subscription
+		}
+		if err := s.deliver(ctx, subscription, &deliveries[i]); err != nil {
+			return i, err
+		}
+	}
+	return len(deliveries), nil
+}
+
+// Replay is a function that sends a delivery again right away, whatever its status, with all its attempts ahead of
+// it when it fails again. A delivery being attempted meanwhile is not replayed. It returns the delivery with the log
+// of its attempts.
+func (s *Service) Replay(ctx context.Context, id int64) (*webhookDomain.Delivery, error) {
+	now := time.Now()
+	delivery, err := s.WebhookRepository.ClaimDelivery(ctx, id, now, now.Add(2*DeliveryTimeout))
+	if err != nil {
+		return nil, err
+	}
+	subscription, err := s.WebhookRepository.GetByID(ctx, delivery.SubscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	delivery.Attempts = 0
+	if err := s.deliver(ctx, subscription, delivery); err != nil {
+		return nil, err
+	}
+	return s.WebhookRepository.GetDelivery(ctx, id)
+}
+
+// Backoff is a function that returns how long a delivery waits after its attempts failed before it is attempted
+// again
+func Backoff(attempts int) time.Duration {
+	backoff := RetryBackoff
+	for i := 1; i < attempts && backoff < MaxRetryBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > MaxRetryBackoff {
+		return MaxRetryBackoff
+	}
+	return backoff
+}
+
+// deliver sends a delivery once, signed with the secret of the subscription, and records the outcome of the attempt:
+// the delivery succeeds on a 2xx status, and is otherwise attempted again after a backoff or dead after its last
+// attempt
+func (s *Service) deliver(ctx context.Context, subscription *webhookDomain.Subscription, delivery *webhookDomain.Delivery) error {
+	attempt := webhookDomain.Attempt{DeliveryID: delivery.ID}
+	start := time.Now()
+	attempt.StatusCode, attempt.Error = s.send(ctx, subscription, delivery)
+	attempt.DurationMs = time.Since(start).Milliseconds()
+	if len(attempt.Error) > maxErrorLength {
+		attempt.Error = attempt.Error[:maxErrorLength]
+	}
+
+	now := time.Now()
+	delivery.Attempts++
+	delivery.LastStatusCode = attempt.StatusCode
+	delivery.LastError = attempt.Error
+	delivery.NextAttemptAt = nil
+	switch {
+	case attempt.Error == "":
+		delivery.Status = webhookDomain.StatusSucceeded
+		delivery.DeliveredAt = &now
+	case delivery.Attempts >= MaxAttempts:
+		delivery.Status = webhookDomain.StatusDead
+	default:
+		next := now.Add(Backoff(delivery.Attempts))
+		delivery.Status = webhookDomain.StatusPending
+		delivery.NextAttemptAt = &next
+	}
+	return s.WebhookRepository.RecordAttempt(ctx, delivery, &attempt)
+}
+
+// send posts the payload of a delivery to the subscription, returning the status it was answered with and the
+// error it failed with, if any
+func (s *Service) send(ctx context.Context, subscription *webhookDomain.Subscription, delivery *webhookDomain.Delivery) (int, string) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, err.Error()
+	}
+	timestamp := time.Now().Unix()
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(webhookDomain.HeaderEvent, delivery.Event)
+	request.Header.Set(webhookDomain.HeaderDelivery, strconv.FormatInt(delivery.ID, 10))
+	request.Header.Set(webhookDomain.HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	request.Header.Set(webhookDomain.HeaderSignature, webhookDomain.Sign(subscription.Secret, timestamp, delivery.Payload))
+
+	client := *defaultClient
+	client.Timeout = DeliveryTimeout
+	if s.Client != nil {
+		client = *s.Client
+	}
+	// a redirect is answered as it is, for the partner not to send the deliveries anywhere else
+	client.CheckRedirect = func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+	response, err := client.Do(request)
+	if err != nil {
+		return 0, err.Error()
+	}
+	defer response.Body.Close()
+	// the body is read for the connection to be reused, whatever the partner answers with
+	_, _ = io.Copy(io.Discard, io.LimitReader(response.Body, 64<<10))
+
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		return response.StatusCode, fmt.Sprintf("unexpected status %d", response.StatusCode)
+	}
+	return response.StatusCode, ""
+}
+
+// checkPublicHost returns a validation error when the host does not resolve or resolves to an address other than a
+// public one, such as a loopback, private or link-local address
+func (s *Service) checkPublicHost(ctx context.Context, host string) error {
+	var resolver Resolver = net.DefaultResolver
+	if s.Resolver != nil {
+		resolver = s.Resolver
+	}
+	addresses := []net.IPAddr{{IP: net.ParseIP(host)}}
+	if addresses[0].IP == nil {
+		var err error
+		if addresses, err = resolver.LookupIPAddr(ctx, host); err != nil || len(addresses) == 0 {
+			return domainErrors.NewValidationError(domainErrors.FieldError{Field: "url", Message: fmt.Sprintf("url host %s could not be resolved", host)})
+		}
+	}
+	for _, address := range addresses {
+		if !publicIP(address.IP) {
+			return domainErrors.NewValidationError(domainErrors.FieldError{Field: "url", Message: fmt.Sprintf("url host %s must resolve to public addresses only", host)})
+		}
+	}
+	return nil
+}
+
+// publicIP tells whether ip is a public unicast address
+func publicIP(ip net.IP) bool {
+	return !ip.IsLoopback() && !ip.IsPrivate() && !ip.IsUnspecified() && !ip.IsLinkLocalUnicast() &&
+		!ip.IsLinkLocalMulticast() && !ip.IsInterfaceLocalMulticast() && !ip.IsMulticast()
+}
+
+func knownEvent(event string) bool {
+	return contains(webhookDomain.Events, event)
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// newSecret returns a random secret of 32 bytes, hex encoded
+func newSecret() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(secret), nil
+}
@@ -0,0 +1,75 @@
+// Package customer contains the business logic for the customer profile and loyalty entities
+package customer
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	// TierBronze is the membership level every customer starts at
+	TierBronze = "bronze"
+	// TierSilver is the membership level of regular customers
+	TierSilver = "silver"
+	// TierGold is the membership level of the most loyal customers
+	TierGold = "gold"
+)
+
+const (
+	// KindAccrual indicates points earned from a paid bill
+	KindAccrual = "accrual"
+	// KindRedemption indicates points spent as a discount on a bill
+	KindRedemption = "redemption"
+)
+
+// Customer is a struct that contains the customer profile information
+type Customer struct {
+	ID          int64     `json:"id" example:"123"`
+	Name        string    `json:"name" example:"Mr. Smith"`
+	Email       string    `json:"email,omitempty" example:"smith@example.com"`
+	Phone       string    `json:"phone,omitempty" example:"+91 98765 43210"`
+	Preferences string    `json:"preferences" example:"window seat, no peanuts"`
+	Tier        string    `json:"tier" example:"silver"`
+	Points      int       `json:"points" example:"340"`
+	CreatedAt   time.Time `json:"created_at,omitempty"`
+	UpdatedAt   time.Time `json:"updated_at,omitempty" example:"2021-02-24 20:19:39"`
+}
+
+// Tier is a struct that contains a membership level with the lifetime points needed to reach it
+type Tier struct {
+	Name          string  `json:"name" example:"silver"`
+	MinimumPoints int     `json:"minimum_points" example:"1000"`
+	Multiplier    float64 `json:"multiplier" example:"1.25"`
+}
+
+// LedgerEntry is a struct that contains a change of the loyalty points of a customer
+type LedgerEntry struct {
+	ID         int64     `json:"id" example:"123"`
+	CustomerID int64     `json:"customer_id" example:"7"`
+	SessionID  int64     `json:"session_id,omitempty" example:"1"`
+	Kind       string    `json:"kind" example:"accrual"`
+	Points     int       `json:"points" example:"48"`
+	Amount     float64   `json:"amount" example:"480.50"`
+	CreatedAt  time.Time `json:"created_at,omitempty"`
+}
+
+// Balance is a struct that contains the loyalty standing of a customer
+type Balance struct {
+	CustomerID       int64  `json:"customer_id" example:"7"`
+	Points           int    `json:"points" example:"340"`
+	LifetimePoints   int    `json:"lifetime_points" example:"1240"`
+	Tier             string `json:"tier" example:"silver"`
+	NextTier         string `json:"next_tier,omitempty" example:"gold"`
+	PointsToNextTier int    `json:"points_to_next_tier,omitempty" example:"3760"`
+}
+
+// Service is a interface that contains the methods for the customer service
+type Service interface {
+	GetByID(context.Context, int64) (*Customer, error)
+	Create(context.Context, *Customer) (*Customer, error)
+	Update(context.Context, *Customer) (*Customer, error)
+	LinkSession(context.Context, int64, int64) error
+	GetBalance(context.Context, int64) (*Balance, error)
+	GetLedger(context.Context, int64) ([]LedgerEntry, error)
+	Redeem(context.Context, int64, int64, int) (*LedgerEntry, error)
+}
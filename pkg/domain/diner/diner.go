@@ -15,10 +15,32 @@ type Diner struct {
 	UpdatedAt   time.Time `json:"updated_at,omitempty" example:"2021-02-24 20:19:39"`
 }
 
+// Session is a struct that contains the information of a diner visit, from check-in until the bill is paid
+type Session struct {
+	ID           int64      `json:"id" example:"123"`
+	DinerID      int64      `json:"diner_id" example:"1"`
+	CustomerID   int64      `json:"customer_id,omitempty" example:"7"`
+	TableNumber  int        `json:"table_no" example:"101"`
+	CheckedInAt  time.Time  `json:"checked_in_at" example:"2021-02-24 20:19:39"`
+	CheckedOutAt *time.Time `json:"checked_out_at,omitempty" example:"2021-02-24 21:49:39"`
+}
+
+// Payment is a struct that contains the paid bill of a diner visit
+type Payment struct {
+	ID        int64     `json:"id" example:"123"`
+	SessionID int64     `json:"session_id" example:"1"`
+	Subtotal  float64   `json:"subtotal" example:"500.50"`
+	Discount  float64   `json:"discount" example:"20.00"`
+	Amount    float64   `json:"amount" example:"480.50"`
+	PaidAt    time.Time `json:"paid_at" example:"2021-02-24 21:49:39"`
+}
+
 // Service is a interface that contains the methods for the diner service
 type Service interface {
 	Get(context.Context, int64) (*Diner, error)
 	GetAll(context.Context, int64, int64) ([]*Diner, error)
 	Create(context.Context, *Diner) error
 	Delete(context.Context, int64) error
+	CheckIn(context.Context, int64, int) (*Session, error)
+	Checkout(context.Context, int64) (*Payment, error)
 }
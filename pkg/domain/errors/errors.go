@@ -1,7 +1,10 @@
 // Package errors defines the domain errors used in the application.
 package errors
 
-import "errors"
+import (
+	"errors"
+	"strings"
+)
 
 const (
 	// InputEmpty error indicates empty input params
@@ -33,6 +36,14 @@ const (
 type AppError struct {
 	Err  error
 	Type string
+	// Fields are the invalid fields of the input of a validation error, when they are known
+	Fields []FieldError
+}
+
+// FieldError describes why the value of a field of the input is invalid
+type FieldError struct {
+	Field   string `json:"field" example:"price"`
+	Message string `json:"message" example:"price must be a positive amount with at most 2 decimals"`
 }
 
 // NewAppError initializes a new domain error using an error and its type.
@@ -43,6 +54,23 @@ func NewAppError(err error, errType string) *AppError {
 	}
 }
 
+// NewValidationError initializes a validation error for the invalid fields of the input, its message joining theirs.
+func NewValidationError(fields ...FieldError) *AppError {
+	messages := make([]string, len(fields))
+	for i, field := range fields {
+		messages[i] = field.Message
+	}
+	if len(messages) == 0 {
+		messages = append(messages, validationErrorMessage)
+	}
+
+	return &AppError{
+		Err:    errors.New(strings.Join(messages, "; ")),
+		Type:   ValidationError,
+		Fields: fields,
+	}
+}
+
 // NewAppErrorWithType initializes a new default error for a given type.
 func NewAppErrorWithType(errType string) *AppError {
 	var err error
@@ -2,19 +2,56 @@
 package menu
 
 import (
+	"math"
 	"time"
 )
 
-// Menu is a struct that contains the menu information
+// DefaultCategory is the category of a menu created without one
+const DefaultCategory = "uncategorised"
+
+// DefaultLanguage is the language of the name and description of a menu itself, its translations are in the others
+const DefaultLanguage = "en"
+
+// PriceRule describes the prices ValidPrice accepts
+const PriceRule = "a positive amount with at most 2 decimals"
+
+// ValidPrice tells whether a menu can be sold at price: a positive amount in whole cents
+func ValidPrice(price float64) bool {
+	cents := price * 100
+	return price > 0 && math.Abs(cents-math.Round(cents)) < 1e-6
+}
+
+// The metrics top menus can be ranked by
+const (
+	RankByQuantity = "quantity"
+	RankByRevenue  = "revenue"
+)
+
+// Menu is a struct that contains the menu information. Language is only set when the menu was localised, as the
+// language of its name and description.
 type Menu struct {
 	ID          int64     `json:"id" example:"123"`
 	Name        string    `json:"name" example:"Hyderabadi Dum Briyani"`
 	Description string    `json:"description" example:"Some Description"`
+	Category    string    `json:"category" example:"briyani"`
 	Price       float64   `json:"price" example:"200.50"`
+	Language    string    `json:"language,omitempty" example:"fr"`
+	Count       int       `json:"count,omitempty" example:"42"`
+	Revenue     float64   `json:"revenue,omitempty" example:"8421.00"`
 	CreatedAt   time.Time `json:"created_at,omitempty" `
 	UpdatedAt   time.Time `json:"updated_at,omitempty" example:"2021-02-24 20:19:39"`
 }
 
+// Translation is a struct that contains the name and description of a menu in another language than the default
+type Translation struct {
+	MenuID      int64     `json:"menu_id" example:"123"`
+	Language    string    `json:"language" example:"fr"`
+	Name        string    `json:"name" example:"Biryani Dum d'Hyderabad"`
+	Description string    `json:"description" example:"Riz parfumé cuit à l'étouffée"`
+	CreatedAt   time.Time `json:"created_at,omitempty"`
+	UpdatedAt   time.Time `json:"updated_at,omitempty" example:"2021-02-24 20:19:39"`
+}
+
 // Service is a interface that contains the methods for the menu service
 type Service interface {
 	Get(int) (*Menu, error)
@@ -5,6 +5,12 @@ import (
 	"time"
 )
 
+// The quantities a menu can be ordered in at once
+const (
+	MinQuantity = 1
+	MaxQuantity = 50
+)
+
 // Request is a struct that contains the request order information
 type Request struct {
 	ID        int64     `json:"id" example:"123"`
@@ -16,13 +22,17 @@ type Request struct {
 
 // Response is a struct that contains the response order information
 type Response struct {
-	ID              int64     `json:"id" example:"123"`
-	DinnerName      string    `json:"diner_name" example:"Mr. Smith"`
-	MenuName        string    `json:"menu_name" example:"HCDB"`
-	MenuDescription string    `json:"menu_description" example:"Hyderabadi Chicken Dum Briyani"`
-	Quantity        int       `json:"quantity" example:"2"`
-	CreatedAt       time.Time `json:"created_at,omitempty" `
-	UpdatedAt       time.Time `json:"updated_at,omitempty" example:"2021-02-24 20:19:39"`
+	ID              int64      `json:"id" example:"123"`
+	SessionID       int64      `json:"session_id" example:"1"`
+	DinerID         int64      `json:"diner_id" example:"1"`
+	MenuID          int64      `json:"menu_id" example:"3"`
+	DinnerName      string     `json:"diner_name" example:"Mr. Smith"`
+	MenuName        string     `json:"menu_name" example:"HCDB"`
+	MenuDescription string     `json:"menu_description" example:"Hyderabadi Chicken Dum Briyani"`
+	Quantity        int        `json:"quantity" example:"2"`
+	ServedAt        *time.Time `json:"served_at,omitempty"`
+	CreatedAt       time.Time  `json:"created_at,omitempty" `
+	UpdatedAt       time.Time  `json:"updated_at,omitempty" example:"2021-02-24 20:19:39"`
 }
 
 // Service is a interface that contains the methods for the order service
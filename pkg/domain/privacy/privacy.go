@@ -0,0 +1,59 @@
+// Package privacy contains the business logic for the personal data requests of diners
+package privacy
+
+import (
+	"context"
+	"time"
+
+	domainCustomer "github.com/Raj63/golang-rest-api/pkg/domain/customer"
+	domainDiner "github.com/Raj63/golang-rest-api/pkg/domain/diner"
+	domainOrder "github.com/Raj63/golang-rest-api/pkg/domain/order"
+)
+
+const (
+	// KindExport indicates a request for a copy of the personal data held about a diner
+	KindExport = "export"
+	// KindAnonymise indicates a request to erase the personal data held about a diner
+	KindAnonymise = "anonymise"
+)
+
+const (
+	// ChannelAPI indicates a request made through the admin endpoints
+	ChannelAPI = "api"
+	// ChannelCLI indicates a request made through the command line
+	ChannelCLI = "cli"
+)
+
+// Request is a struct that contains the audit record of a personal data request
+type Request struct {
+	ID          int64     `json:"id" example:"123"`
+	DinerID     int64     `json:"diner_id" example:"1"`
+	Kind        string    `json:"kind" example:"export"`
+	RequestedBy string    `json:"requested_by" example:"privacy-officer@example.com"`
+	Reason      string    `json:"reason" example:"subject access request #42"`
+	Channel     string    `json:"channel" example:"api"`
+	CreatedAt   time.Time `json:"created_at,omitempty"`
+}
+
+// Customer is a struct that contains a customer profile linked to the diner with its loyalty ledger
+type Customer struct {
+	domainCustomer.Customer
+	Ledger []domainCustomer.LedgerEntry `json:"ledger"`
+}
+
+// Archive is a struct that contains everything held about a diner
+type Archive struct {
+	ExportedAt time.Time              `json:"exported_at"`
+	Diner      domainDiner.Diner      `json:"diner"`
+	Sessions   []domainDiner.Session  `json:"sessions"`
+	Orders     []domainOrder.Response `json:"orders"`
+	Payments   []domainDiner.Payment  `json:"payments"`
+	Customers  []Customer             `json:"customers"`
+}
+
+// Service is a interface that contains the methods for the privacy service
+type Service interface {
+	Export(context.Context, int64, *Request) (*Archive, error)
+	Anonymise(context.Context, int64, *Request) (*Request, error)
+	GetRequests(context.Context, int64) ([]Request, error)
+}
@@ -0,0 +1,83 @@
+// Package report contains the business logic for the management reports
+package report
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	// GroupByDay groups the sales by calendar day
+	GroupByDay = "day"
+	// GroupByHour groups the sales by hour of the day
+	GroupByHour = "hour"
+	// GroupByWeekday groups the sales by day of the week
+	GroupByWeekday = "weekday"
+	// GroupByMenu groups the sales by menu item
+	GroupByMenu = "menu"
+	// GroupByTable groups the sales or the service times by dining table
+	GroupByTable = "table"
+	// GroupBySection groups the service times by section of the restaurant
+	GroupBySection = "section"
+	// GroupByDaypart groups the service times by part of the day
+	GroupByDaypart = "daypart"
+)
+
+// Daypart is a struct that contains a part of the day, which lasts until the next one starts
+type Daypart struct {
+	Name      string `json:"name" example:"lunch"`
+	StartHour int    `json:"start_hour" example:"11"`
+}
+
+// Dayparts are the parts of the day in order, the hours before the first one belong to the last one
+var Dayparts = []Daypart{
+	{Name: "breakfast", StartHour: 6},
+	{Name: "lunch", StartHour: 11},
+	{Name: "afternoon", StartHour: 15},
+	{Name: "dinner", StartHour: 18},
+	{Name: "late", StartHour: 22},
+}
+
+// SalesRow is a struct that contains the sales of one group of a sales report
+type SalesRow struct {
+	Group         string  `json:"group" example:"2026-10-19"`
+	Revenue       float64 `json:"revenue" example:"1250.50"`
+	Orders        int     `json:"orders" example:"14"`
+	AverageTicket float64 `json:"average_ticket" example:"89.32"`
+	ItemsSold     int     `json:"items_sold" example:"37"`
+}
+
+// Sales is a struct that contains a sales report over the orders placed from From until, excluding, To.
+// Orders counts the tickets, i.e. the dining sessions that ordered, and the average ticket is the revenue per ticket.
+type Sales struct {
+	From    time.Time  `json:"from"`
+	To      time.Time  `json:"to"`
+	GroupBy string     `json:"group_by" example:"day"`
+	Rows    []SalesRow `json:"rows"`
+}
+
+// ServiceTimeRow is a struct that contains the average service times, in minutes, of one group of dining sessions.
+// Occupancy only covers the sessions that are checked out, and the total occupancy sums them.
+type ServiceTimeRow struct {
+	Group            string  `json:"group" example:"12"`
+	Sessions         int     `json:"sessions" example:"31"`
+	SeatToFirstOrder float64 `json:"seat_to_first_order" example:"7.5"`
+	OrderToServe     float64 `json:"order_to_serve" example:"18.2"`
+	Occupancy        float64 `json:"occupancy" example:"64.8"`
+	TotalOccupancy   float64 `json:"total_occupancy" example:"1944"`
+}
+
+// ServiceTimes is a struct that contains a service times report over the dining sessions checked in from From
+// until, excluding, To
+type ServiceTimes struct {
+	From    time.Time        `json:"from"`
+	To      time.Time        `json:"to"`
+	GroupBy string           `json:"group_by" example:"table"`
+	Rows    []ServiceTimeRow `json:"rows"`
+}
+
+// Service is a interface that contains the methods for the report service
+type Service interface {
+	GetSales(ctx context.Context, from, to time.Time, groupBy string) (*Sales, error)
+	GetServiceTimes(ctx context.Context, from, to time.Time, groupBy string) (*ServiceTimes, error)
+}
@@ -0,0 +1,69 @@
+// Package reservation contains the business logic for the reservation entity
+package reservation
+
+import (
+	"context"
+	"time"
+
+	domainTable "github.com/Raj63/golang-rest-api/pkg/domain/table"
+)
+
+const (
+	// StatusBooked indicates a reservation waiting for the party to arrive
+	StatusBooked = "booked"
+	// StatusSeated indicates the party has arrived and occupies the table
+	StatusSeated = "seated"
+	// StatusNoShow indicates the party never arrived
+	StatusNoShow = "no-show"
+	// StatusCancelled indicates the reservation was cancelled
+	StatusCancelled = "cancelled"
+
+	// DefaultDurationMinutes is the time a party is expected to occupy a table
+	DefaultDurationMinutes = 90
+)
+
+// Reservation is a struct that contains the reservation information
+type Reservation struct {
+	ID              int64     `json:"id" example:"123"`
+	Name            string    `json:"name" example:"Mr. Smith"`
+	Contact         string    `json:"contact" example:"+91 98765 43210"`
+	PartySize       int       `json:"party_size" example:"4"`
+	TableNumber     int       `json:"table_no" example:"101"`
+	ReservedAt      time.Time `json:"reserved_at" example:"2021-02-24T20:00:00Z"`
+	DurationMinutes int       `json:"duration_minutes" example:"90"`
+	Status          string    `json:"status" example:"booked"`
+	CreatedAt       time.Time `json:"created_at,omitempty"`
+	UpdatedAt       time.Time `json:"updated_at,omitempty" example:"2021-02-24 20:19:39"`
+}
+
+// EndAt returns the time the reservation is expected to free the table
+func (r *Reservation) EndAt() time.Time {
+	return r.ReservedAt.Add(time.Duration(r.DurationMinutes) * time.Minute)
+}
+
+// Occupancy is a struct that contains the interval a table is held by a reservation or a seated diner
+type Occupancy struct {
+	TableNumber int
+	StartAt     time.Time
+	EndAt       time.Time
+}
+
+// Overlaps reports whether the occupancy intersects the given interval
+func (o *Occupancy) Overlaps(startAt, endAt time.Time) bool {
+	return o.StartAt.Before(endAt) && o.EndAt.After(startAt)
+}
+
+// Slot is a struct that contains the tables available for a time slot
+type Slot struct {
+	StartAt time.Time           `json:"start_at" example:"2021-02-24T20:00:00Z"`
+	EndAt   time.Time           `json:"end_at" example:"2021-02-24T21:30:00Z"`
+	Tables  []domainTable.Table `json:"tables"`
+}
+
+// Service is a interface that contains the methods for the reservation service
+type Service interface {
+	Get(context.Context, int64) (*Reservation, error)
+	GetAll(context.Context, time.Time) ([]Reservation, error)
+	Create(context.Context, *Reservation) error
+	UpdateStatus(context.Context, int64, string) error
+}
@@ -0,0 +1,9 @@
+// Package table contains the business logic for the dining table entity
+package table
+
+// Table is a struct that contains the dining table information
+type Table struct {
+	TableNumber int    `json:"table_no" example:"101"`
+	Capacity    int    `json:"capacity" example:"4"`
+	Section     string `json:"section" example:"main"`
+}
@@ -0,0 +1,53 @@
+// Package waitlist contains the business logic for the walk-in waitlist entity
+package waitlist
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	// StatusWaiting indicates a party queued for a table
+	StatusWaiting = "waiting"
+	// StatusNotified indicates a party told that a table is ready
+	StatusNotified = "notified"
+	// StatusSeated indicates a party that has been seated
+	StatusSeated = "seated"
+	// StatusLeft indicates a party that left the queue before being seated
+	StatusLeft = "left"
+)
+
+// Entry is a struct that contains the waitlist entry information
+type Entry struct {
+	ID                   int64      `json:"id" example:"123"`
+	Name                 string     `json:"name" example:"Mr. Smith"`
+	Contact              string     `json:"contact" example:"+91 98765 43210"`
+	PartySize            int        `json:"party_size" example:"4"`
+	Status               string     `json:"status" example:"waiting"`
+	TableNumber          int        `json:"table_no,omitempty" example:"101"`
+	Position             int        `json:"position" example:"2"`
+	EstimatedWaitMinutes int        `json:"estimated_wait_minutes" example:"25"`
+	NotifiedAt           *time.Time `json:"notified_at,omitempty"`
+	CreatedAt            time.Time  `json:"created_at,omitempty"`
+	UpdatedAt            time.Time  `json:"updated_at,omitempty" example:"2021-02-24 20:19:39"`
+}
+
+// TurnDuration is a struct that contains the average time tables of a capacity stay occupied
+type TurnDuration struct {
+	Capacity       int
+	AverageMinutes float64
+}
+
+// Notifier is a interface that contains the methods to tell a party their table is ready
+type Notifier interface {
+	Notify(context.Context, *Entry) error
+}
+
+// Service is a interface that contains the methods for the waitlist service
+type Service interface {
+	Get(context.Context, int64) (*Entry, error)
+	GetAll(context.Context) ([]Entry, error)
+	Create(context.Context, *Entry) error
+	UpdateStatus(context.Context, int64, string) error
+	TableFreed(context.Context, int) (*Entry, error)
+}
@@ -0,0 +1,110 @@
+// Package webhook contains the business logic for the webhooks notifying partners of the order and menu events
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+// The events the partners can subscribe to
+const (
+	// EventOrderCreated is sent with the order placed for a diner
+	EventOrderCreated = "order.created"
+	// EventOrderServed is sent with the id of an order brought to the table
+	EventOrderServed = "order.served"
+	// EventOrderDeleted is sent with the id of a cancelled order
+	EventOrderDeleted = "order.deleted"
+	// EventMenuCreated is sent with a menu added to the card
+	EventMenuCreated = "menu.created"
+	// EventMenuUpdated is sent with a menu whose description, category or price changed
+	EventMenuUpdated = "menu.updated"
+	// EventMenuDeleted is sent with the id of a menu taken off the card
+	EventMenuDeleted = "menu.deleted"
+)
+
+// Events are all the events the partners can subscribe to
+var Events = []string{EventOrderCreated, EventOrderServed, EventOrderDeleted, EventMenuCreated, EventMenuUpdated, EventMenuDeleted}
+
+const (
+	// StatusPending indicates a delivery waiting for its next attempt
+	StatusPending = "pending"
+	// StatusSucceeded indicates a delivery acknowledged by the partner with a 2xx status
+	StatusSucceeded = "succeeded"
+	// StatusDead indicates a delivery given up on after its last attempt failed, until it is replayed
+	StatusDead = "dead"
+)
+
+// The headers of the deliveries, for the partners to check their signature and to recognise the retries
+const (
+	HeaderEvent     = "X-Webhook-Event"
+	HeaderDelivery  = "X-Webhook-Delivery"
+	HeaderTimestamp = "X-Webhook-Timestamp"
+	HeaderSignature = "X-Webhook-Signature"
+)
+
+// Subscription is a struct that contains the endpoint of a partner and the events it is sent. The secret the
+// deliveries are signed with is only shown once, when the subscription is created.
+type Subscription struct {
+	ID        int64     `json:"id" example:"123"`
+	URL       string    `json:"url" example:"https://partner.example.com/webhooks"`
+	Events    []string  `json:"events" example:"order.created,order.served"`
+	Secret    string    `json:"secret,omitempty" example:"5f2b8c0e3a9d4e7f"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	UpdatedAt time.Time `json:"updated_at,omitempty" example:"2021-02-24 20:19:39"`
+}
+
+// Notification is a struct that contains the body of every delivery: the event, when it occurred and its data, as
+// the order or menu it is about
+type Notification struct {
+	Event      string      `json:"event" example:"order.created"`
+	OccurredAt time.Time   `json:"occurred_at"`
+	Data       interface{} `json:"data"`
+}
+
+// Delivery is a struct that contains an event sent to a subscription, with the outcome of its last attempt and,
+// when it is fetched alone, the log of all its attempts
+type Delivery struct {
+	ID             int64           `json:"id" example:"123"`
+	SubscriptionID int64           `json:"subscription_id" example:"7"`
+	Event          string          `json:"event" example:"order.created"`
+	Payload        json.RawMessage `json:"payload" swaggertype:"object"`
+	Status         string          `json:"status" example:"pending"`
+	Attempts       int             `json:"attempts" example:"2"`
+	NextAttemptAt  *time.Time      `json:"next_attempt_at,omitempty"`
+	LastStatusCode int             `json:"last_status_code,omitempty" example:"503"`
+	LastError      string          `json:"last_error,omitempty" example:"unexpected status 503"`
+	DeliveredAt    *time.Time      `json:"delivered_at,omitempty"`
+	CreatedAt      time.Time       `json:"created_at,omitempty"`
+	UpdatedAt      time.Time       `json:"updated_at,omitempty" example:"2021-02-24 20:19:39"`
+	Log            []Attempt       `json:"log,omitempty"`
+}
+
+// Attempt is a struct that contains the outcome of sending a delivery once
+type Attempt struct {
+	ID         int64     `json:"id" example:"123"`
+	DeliveryID int64     `json:"delivery_id" example:"123"`
+	StatusCode int       `json:"status_code,omitempty" example:"503"`
+	Error      string    `json:"error,omitempty" example:"unexpected status 503"`
+	DurationMs int64     `json:"duration_ms" example:"87"`
+	CreatedAt  time.Time `json:"created_at,omitempty"`
+}
+
+// Sign returns the signature of a delivery body sent at timestamp, in unix seconds: the hex HMAC-SHA256 with the
+// secret of the timestamp and the body joined by a dot, prefixed by sha256=. Signing the timestamp lets the partners
+// reject the deliveries replayed by someone else long after they were sent.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify tells whether signature is the signature of a delivery body sent at timestamp with the secret
+func Verify(secret string, timestamp int64, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, timestamp, body)), []byte(signature))
+}
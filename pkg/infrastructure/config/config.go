@@ -5,6 +5,7 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/caarlos0/env/v6"
 )
@@ -26,6 +27,20 @@ type AppConfig struct {
 	// NewRelicLicenseKey is the license key for New relic instrumentations
 	NewRelicLicenseKey string `env:"NEWRELIC_LICENSE_KEY"`
 
+	// ContractValidation checks the requests and responses of the v1 API against its OpenAPI document, logging the
+	// mismatches with "log" or rejecting them with "reject". It is meant for development, and off when empty.
+	ContractValidation string `env:"CONTRACT_VALIDATION"`
+
+	// JWTAccessSecret is the HMAC key the tokens of the authenticated routes are signed with. Those routes reject every
+	// request while it is empty.
+	JWTAccessSecret string `env:"JWT_ACCESS_SECRET"`
+
+	// AggregationInterval is how often the sales aggregates are refreshed while serving, 0 disables the refresh.
+	AggregationInterval time.Duration `env:"AGGREGATION_INTERVAL" envDefault:"5m"`
+
+	// WebhookInterval is how often the due webhook deliveries are sent while serving, 0 disables the sending.
+	WebhookInterval time.Duration `env:"WEBHOOK_INTERVAL" envDefault:"10s"`
+
 	HTTPConfig struct {
 		// Address is the HTTP server's address.
 		Address string `env:"HTTP_ADDRESS"`
@@ -41,6 +56,14 @@ type AppConfig struct {
 		// Enabled is the feature flag
 		Enabled bool `env:"HTTPS_ENABLED"`
 	}
+
+	GRPCConfig struct {
+		// Address is the gRPC server's address.
+		Address string `env:"GRPC_ADDRESS"`
+
+		// Enabled is the feature flag
+		Enabled bool `env:"GRPC_ENABLED"`
+	}
 }
 
 // NewConfig loads <APP_ENV> into Config struct.
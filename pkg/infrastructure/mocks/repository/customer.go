@@ -0,0 +1,169 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/infrastructure/repository/customer.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	customer "github.com/Raj63/golang-rest-api/pkg/domain/customer"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCustomers is a mock of Customers interface.
+type MockCustomers struct {
+	ctrl     *gomock.Controller
+	recorder *MockCustomersMockRecorder
+}
+
+// MockCustomersMockRecorder is the mock recorder for MockCustomers.
+type MockCustomersMockRecorder struct {
+	mock *MockCustomers
+}
+
+// NewMockCustomers creates a new mock instance.
+func NewMockCustomers(ctrl *gomock.Controller) *MockCustomers {
+	mock := &MockCustomers{ctrl: ctrl}
+	mock.recorder = &MockCustomersMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCustomers) EXPECT() *MockCustomersMockRecorder {
+	return m.recorder
+}
+
+// Accrue mocks base method.
+func (m *MockCustomers) Accrue(ctx context.Context, entry *customer.LedgerEntry) (*customer.LedgerEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Accrue", ctx, entry)
+	ret0, _ := ret[0].(*customer.LedgerEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Accrue indicates an expected call of Accrue.
+func (mr *MockCustomersMockRecorder) Accrue(ctx, entry interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Accrue", reflect.TypeOf((*MockCustomers)(nil).Accrue), ctx, entry)
+}
+
+// Create mocks base method.
+func (m *MockCustomers) Create(ctx context.Context, newCustomer *customer.Customer) (*customer.Customer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, newCustomer)
+	ret0, _ := ret[0].(*customer.Customer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCustomersMockRecorder) Create(ctx, newCustomer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCustomers)(nil).Create), ctx, newCustomer)
+}
+
+// GetBalance mocks base method.
+func (m *MockCustomers) GetBalance(ctx context.Context, customerID int64) (*customer.Balance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBalance", ctx, customerID)
+	ret0, _ := ret[0].(*customer.Balance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBalance indicates an expected call of GetBalance.
+func (mr *MockCustomersMockRecorder) GetBalance(ctx, customerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalance", reflect.TypeOf((*MockCustomers)(nil).GetBalance), ctx, customerID)
+}
+
+// GetByID mocks base method.
+func (m *MockCustomers) GetByID(ctx context.Context, id int64) (*customer.Customer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*customer.Customer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockCustomersMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockCustomers)(nil).GetByID), ctx, id)
+}
+
+// GetBySessionID mocks base method.
+func (m *MockCustomers) GetBySessionID(ctx context.Context, sessionID int64) (*customer.Customer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBySessionID", ctx, sessionID)
+	ret0, _ := ret[0].(*customer.Customer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBySessionID indicates an expected call of GetBySessionID.
+func (mr *MockCustomersMockRecorder) GetBySessionID(ctx, sessionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBySessionID", reflect.TypeOf((*MockCustomers)(nil).GetBySessionID), ctx, sessionID)
+}
+
+// GetLedger mocks base method.
+func (m *MockCustomers) GetLedger(ctx context.Context, customerID int64) ([]customer.LedgerEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLedger", ctx, customerID)
+	ret0, _ := ret[0].([]customer.LedgerEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLedger indicates an expected call of GetLedger.
+func (mr *MockCustomersMockRecorder) GetLedger(ctx, customerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLedger", reflect.TypeOf((*MockCustomers)(nil).GetLedger), ctx, customerID)
+}
+
+// LinkSession mocks base method.
+func (m *MockCustomers) LinkSession(ctx context.Context, customerID, dinerID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LinkSession", ctx, customerID, dinerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LinkSession indicates an expected call of LinkSession.
+func (mr *MockCustomersMockRecorder) LinkSession(ctx, customerID, dinerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LinkSession", reflect.TypeOf((*MockCustomers)(nil).LinkSession), ctx, customerID, dinerID)
+}
+
+// Redeem mocks base method.
+func (m *MockCustomers) Redeem(ctx context.Context, entry *customer.LedgerEntry, dinerID int64) (*customer.LedgerEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Redeem", ctx, entry, dinerID)
+	ret0, _ := ret[0].(*customer.LedgerEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Redeem indicates an expected call of Redeem.
+func (mr *MockCustomersMockRecorder) Redeem(ctx, entry, dinerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Redeem", reflect.TypeOf((*MockCustomers)(nil).Redeem), ctx, entry, dinerID)
+}
+
+// Update mocks base method.
+func (m *MockCustomers) Update(ctx context.Context, customer *customer.Customer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, customer)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockCustomersMockRecorder) Update(ctx, customer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockCustomers)(nil).Update), ctx, customer)
+}
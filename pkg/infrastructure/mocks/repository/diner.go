@@ -36,6 +36,37 @@ func (m *MockDiners) EXPECT() *MockDinersMockRecorder {
 	return m.recorder
 }
 
+// CheckIn mocks base method.
+func (m *MockDiners) CheckIn(ctx context.Context, dinerID int64, tableNumber int) (*diner.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckIn", ctx, dinerID, tableNumber)
+	ret0, _ := ret[0].(*diner.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckIn indicates an expected call of CheckIn.
+func (mr *MockDinersMockRecorder) CheckIn(ctx, dinerID, tableNumber interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckIn", reflect.TypeOf((*MockDiners)(nil).CheckIn), ctx, dinerID, tableNumber)
+}
+
+// Checkout mocks base method.
+func (m *MockDiners) Checkout(ctx context.Context, dinerID int64) (*diner.Session, *diner.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Checkout", ctx, dinerID)
+	ret0, _ := ret[0].(*diner.Session)
+	ret1, _ := ret[1].(*diner.Payment)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Checkout indicates an expected call of Checkout.
+func (mr *MockDinersMockRecorder) Checkout(ctx, dinerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Checkout", reflect.TypeOf((*MockDiners)(nil).Checkout), ctx, dinerID)
+}
+
 // Create mocks base method.
 func (m *MockDiners) Create(ctx context.Context, newDiner *diner.Diner) (*diner.Diner, error) {
 	m.ctrl.T.Helper()
@@ -95,6 +126,36 @@ func (mr *MockDinersMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockDiners)(nil).GetByID), ctx, id)
 }
 
+// GetByIDs mocks base method.
+func (m *MockDiners) GetByIDs(ctx context.Context, ids []int64) ([]diner.Diner, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIDs", ctx, ids)
+	ret0, _ := ret[0].([]diner.Diner)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIDs indicates an expected call of GetByIDs.
+func (mr *MockDinersMockRecorder) GetByIDs(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDs", reflect.TypeOf((*MockDiners)(nil).GetByIDs), ctx, ids)
+}
+
+// GetSessions mocks base method.
+func (m *MockDiners) GetSessions(ctx context.Context, dinerID int64) ([]diner.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessions", ctx, dinerID)
+	ret0, _ := ret[0].([]diner.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSessions indicates an expected call of GetSessions.
+func (mr *MockDinersMockRecorder) GetSessions(ctx, dinerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessions", reflect.TypeOf((*MockDiners)(nil).GetSessions), ctx, dinerID)
+}
+
 // GetTotalCount mocks base method.
 func (m *MockDiners) GetTotalCount(ctx context.Context) (int64, error) {
 	m.ctrl.T.Helper()
@@ -109,3 +170,48 @@ func (mr *MockDinersMockRecorder) GetTotalCount(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalCount", reflect.TypeOf((*MockDiners)(nil).GetTotalCount), ctx)
 }
+
+// Search mocks base method.
+func (m *MockDiners) Search(ctx context.Context, filter *repository.DinerFilter, page, limit int64) (*repository.PaginationResultDiner, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, filter, page, limit)
+	ret0, _ := ret[0].(*repository.PaginationResultDiner)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockDinersMockRecorder) Search(ctx, filter, page, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockDiners)(nil).Search), ctx, filter, page, limit)
+}
+
+// SearchPage mocks base method.
+func (m *MockDiners) SearchPage(ctx context.Context, filter *repository.DinerFilter, page *repository.PageRequest) (*repository.PaginationResultDiner, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchPage", ctx, filter, page)
+	ret0, _ := ret[0].(*repository.PaginationResultDiner)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchPage indicates an expected call of SearchPage.
+func (mr *MockDinersMockRecorder) SearchPage(ctx, filter, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchPage", reflect.TypeOf((*MockDiners)(nil).SearchPage), ctx, filter, page)
+}
+
+// Update mocks base method.
+func (m *MockDiners) Update(ctx context.Context, updated *diner.Diner) (*diner.Diner, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, updated)
+	ret0, _ := ret[0].(*diner.Diner)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockDinersMockRecorder) Update(ctx, updated interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockDiners)(nil).Update), ctx, updated)
+}
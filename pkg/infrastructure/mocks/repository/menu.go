@@ -95,19 +95,64 @@ func (mr *MockMenusMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockMenus)(nil).GetByID), ctx, id)
 }
 
+// GetByIDs mocks base method.
+func (m *MockMenus) GetByIDs(ctx context.Context, ids []int64) ([]menu.Menu, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIDs", ctx, ids)
+	ret0, _ := ret[0].([]menu.Menu)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIDs indicates an expected call of GetByIDs.
+func (mr *MockMenusMockRecorder) GetByIDs(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDs", reflect.TypeOf((*MockMenus)(nil).GetByIDs), ctx, ids)
+}
+
+// GetByNames mocks base method.
+func (m *MockMenus) GetByNames(ctx context.Context, names []string) ([]menu.Menu, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByNames", ctx, names)
+	ret0, _ := ret[0].([]menu.Menu)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByNames indicates an expected call of GetByNames.
+func (mr *MockMenusMockRecorder) GetByNames(ctx, names interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByNames", reflect.TypeOf((*MockMenus)(nil).GetByNames), ctx, names)
+}
+
 // GetByTopCount mocks base method.
-func (m *MockMenus) GetByTopCount(ctx context.Context, count int) ([]menu.Menu, error) {
+func (m *MockMenus) GetByTopCount(ctx context.Context, filter *repository.TopMenuFilter) ([]menu.Menu, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByTopCount", ctx, count)
+	ret := m.ctrl.Call(m, "GetByTopCount", ctx, filter)
 	ret0, _ := ret[0].([]menu.Menu)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetByTopCount indicates an expected call of GetByTopCount.
-func (mr *MockMenusMockRecorder) GetByTopCount(ctx, count interface{}) *gomock.Call {
+func (mr *MockMenusMockRecorder) GetByTopCount(ctx, filter interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByTopCount", reflect.TypeOf((*MockMenus)(nil).GetByTopCount), ctx, count)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByTopCount", reflect.TypeOf((*MockMenus)(nil).GetByTopCount), ctx, filter)
+}
+
+// GetPage mocks base method.
+func (m *MockMenus) GetPage(ctx context.Context, page *repository.PageRequest) (*repository.PaginationResultMenu, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPage", ctx, page)
+	ret0, _ := ret[0].(*repository.PaginationResultMenu)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPage indicates an expected call of GetPage.
+func (mr *MockMenusMockRecorder) GetPage(ctx, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPage", reflect.TypeOf((*MockMenus)(nil).GetPage), ctx, page)
 }
 
 // GetTotalCount mocks base method.
@@ -124,3 +169,114 @@ func (mr *MockMenusMockRecorder) GetTotalCount(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalCount", reflect.TypeOf((*MockMenus)(nil).GetTotalCount), ctx)
 }
+
+// Iterate mocks base method.
+func (m *MockMenus) Iterate(ctx context.Context, fn func(*menu.Menu) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Iterate", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Iterate indicates an expected call of Iterate.
+func (mr *MockMenusMockRecorder) Iterate(ctx, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Iterate", reflect.TypeOf((*MockMenus)(nil).Iterate), ctx, fn)
+}
+
+// Upsert mocks base method.
+func (m *MockMenus) Upsert(ctx context.Context, menus []menu.Menu) (*repository.UpsertResultMenu, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", ctx, menus)
+	ret0, _ := ret[0].(*repository.UpsertResultMenu)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockMenusMockRecorder) Upsert(ctx, menus interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockMenus)(nil).Upsert), ctx, menus)
+}
+
+// MockMenuTranslations is a mock of MenuTranslations interface.
+type MockMenuTranslations struct {
+	ctrl     *gomock.Controller
+	recorder *MockMenuTranslationsMockRecorder
+}
+
+// MockMenuTranslationsMockRecorder is the mock recorder for MockMenuTranslations.
+type MockMenuTranslationsMockRecorder struct {
+	mock *MockMenuTranslations
+}
+
+// NewMockMenuTranslations creates a new mock instance.
+func NewMockMenuTranslations(ctrl *gomock.Controller) *MockMenuTranslations {
+	mock := &MockMenuTranslations{ctrl: ctrl}
+	mock.recorder = &MockMenuTranslationsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMenuTranslations) EXPECT() *MockMenuTranslationsMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockMenuTranslations) Delete(ctx context.Context, menuID int64, language string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, menuID, language)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockMenuTranslationsMockRecorder) Delete(ctx, menuID, language interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockMenuTranslations)(nil).Delete), ctx, menuID, language)
+}
+
+// GetByMenuID mocks base method.
+func (m *MockMenuTranslations) GetByMenuID(ctx context.Context, menuID int64) ([]menu.Translation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByMenuID", ctx, menuID)
+	ret0, _ := ret[0].([]menu.Translation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByMenuID indicates an expected call of GetByMenuID.
+func (mr *MockMenuTranslationsMockRecorder) GetByMenuID(ctx, menuID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByMenuID", reflect.TypeOf((*MockMenuTranslations)(nil).GetByMenuID), ctx, menuID)
+}
+
+// GetByMenuIDs mocks base method.
+func (m *MockMenuTranslations) GetByMenuIDs(ctx context.Context, menuIDs []int64, languages []string) ([]menu.Translation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByMenuIDs", ctx, menuIDs, languages)
+	ret0, _ := ret[0].([]menu.Translation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByMenuIDs indicates an expected call of GetByMenuIDs.
+func (mr *MockMenuTranslationsMockRecorder) GetByMenuIDs(ctx, menuIDs, languages interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByMenuIDs", reflect.TypeOf((*MockMenuTranslations)(nil).GetByMenuIDs), ctx, menuIDs, languages)
+}
+
+// Upsert mocks base method.
+func (m *MockMenuTranslations) Upsert(ctx context.Context, translation *menu.Translation) (*menu.Translation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", ctx, translation)
+	ret0, _ := ret[0].(*menu.Translation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockMenuTranslationsMockRecorder) Upsert(ctx, translation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockMenuTranslations)(nil).Upsert), ctx, translation)
+}
@@ -64,6 +64,36 @@ func (mr *MockOrdersMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockOrders)(nil).Delete), ctx, id)
 }
 
+// GetByCurrentSession mocks base method.
+func (m *MockOrders) GetByCurrentSession(ctx context.Context, dinerID int64) ([]order.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByCurrentSession", ctx, dinerID)
+	ret0, _ := ret[0].([]order.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByCurrentSession indicates an expected call of GetByCurrentSession.
+func (mr *MockOrdersMockRecorder) GetByCurrentSession(ctx, dinerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByCurrentSession", reflect.TypeOf((*MockOrders)(nil).GetByCurrentSession), ctx, dinerID)
+}
+
+// GetByDinerIDs mocks base method.
+func (m *MockOrders) GetByDinerIDs(ctx context.Context, dinerIDs []int64, history bool) ([]order.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByDinerIDs", ctx, dinerIDs, history)
+	ret0, _ := ret[0].([]order.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByDinerIDs indicates an expected call of GetByDinerIDs.
+func (mr *MockOrdersMockRecorder) GetByDinerIDs(ctx, dinerIDs, history interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByDinerIDs", reflect.TypeOf((*MockOrders)(nil).GetByDinerIDs), ctx, dinerIDs, history)
+}
+
 // GetByID mocks base method.
 func (m *MockOrders) GetByID(ctx context.Context, dinerID int64) ([]order.Response, error) {
 	m.ctrl.T.Helper()
@@ -78,3 +108,17 @@ func (mr *MockOrdersMockRecorder) GetByID(ctx, dinerID interface{}) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockOrders)(nil).GetByID), ctx, dinerID)
 }
+
+// Serve mocks base method.
+func (m *MockOrders) Serve(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Serve", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Serve indicates an expected call of Serve.
+func (mr *MockOrdersMockRecorder) Serve(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Serve", reflect.TypeOf((*MockOrders)(nil).Serve), ctx, id)
+}
@@ -0,0 +1,112 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/infrastructure/repository/privacy.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	diner "github.com/Raj63/golang-rest-api/pkg/domain/diner"
+	privacy "github.com/Raj63/golang-rest-api/pkg/domain/privacy"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPrivacyRequests is a mock of PrivacyRequests interface.
+type MockPrivacyRequests struct {
+	ctrl     *gomock.Controller
+	recorder *MockPrivacyRequestsMockRecorder
+}
+
+// MockPrivacyRequestsMockRecorder is the mock recorder for MockPrivacyRequests.
+type MockPrivacyRequestsMockRecorder struct {
+	mock *MockPrivacyRequests
+}
+
+// NewMockPrivacyRequests creates a new mock instance.
+func NewMockPrivacyRequests(ctrl *gomock.Controller) *MockPrivacyRequests {
+	mock := &MockPrivacyRequests{ctrl: ctrl}
+	mock.recorder = &MockPrivacyRequestsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPrivacyRequests) EXPECT() *MockPrivacyRequestsMockRecorder {
+	return m.recorder
+}
+
+// Anonymise mocks base method.
+func (m *MockPrivacyRequests) Anonymise(ctx context.Context, dinerID int64, newRequest *privacy.Request) (*privacy.Request, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Anonymise", ctx, dinerID, newRequest)
+	ret0, _ := ret[0].(*privacy.Request)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Anonymise indicates an expected call of Anonymise.
+func (mr *MockPrivacyRequestsMockRecorder) Anonymise(ctx, dinerID, newRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Anonymise", reflect.TypeOf((*MockPrivacyRequests)(nil).Anonymise), ctx, dinerID, newRequest)
+}
+
+// Create mocks base method.
+func (m *MockPrivacyRequests) Create(ctx context.Context, newRequest *privacy.Request) (*privacy.Request, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, newRequest)
+	ret0, _ := ret[0].(*privacy.Request)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPrivacyRequestsMockRecorder) Create(ctx, newRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPrivacyRequests)(nil).Create), ctx, newRequest)
+}
+
+// GetByDinerID mocks base method.
+func (m *MockPrivacyRequests) GetByDinerID(ctx context.Context, dinerID int64) ([]privacy.Request, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByDinerID", ctx, dinerID)
+	ret0, _ := ret[0].([]privacy.Request)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByDinerID indicates an expected call of GetByDinerID.
+func (mr *MockPrivacyRequestsMockRecorder) GetByDinerID(ctx, dinerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByDinerID", reflect.TypeOf((*MockPrivacyRequests)(nil).GetByDinerID), ctx, dinerID)
+}
+
+// GetCustomerIDs mocks base method.
+func (m *MockPrivacyRequests) GetCustomerIDs(ctx context.Context, dinerID int64) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCustomerIDs", ctx, dinerID)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCustomerIDs indicates an expected call of GetCustomerIDs.
+func (mr *MockPrivacyRequestsMockRecorder) GetCustomerIDs(ctx, dinerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomerIDs", reflect.TypeOf((*MockPrivacyRequests)(nil).GetCustomerIDs), ctx, dinerID)
+}
+
+// GetPayments mocks base method.
+func (m *MockPrivacyRequests) GetPayments(ctx context.Context, dinerID int64) ([]diner.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPayments", ctx, dinerID)
+	ret0, _ := ret[0].([]diner.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPayments indicates an expected call of GetPayments.
+func (mr *MockPrivacyRequestsMockRecorder) GetPayments(ctx, dinerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPayments", reflect.TypeOf((*MockPrivacyRequests)(nil).GetPayments), ctx, dinerID)
+}
@@ -0,0 +1,155 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/infrastructure/repository/report.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	report "github.com/Raj63/golang-rest-api/pkg/domain/report"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockReports is a mock of Reports interface.
+type MockReports struct {
+	ctrl     *gomock.Controller
+	recorder *MockReportsMockRecorder
+}
+
+// MockReportsMockRecorder is the mock recorder for MockReports.
+type MockReportsMockRecorder struct {
+	mock *MockReports
+}
+
+// NewMockReports creates a new mock instance.
+func NewMockReports(ctrl *gomock.Controller) *MockReports {
+	mock := &MockReports{ctrl: ctrl}
+	mock.recorder = &MockReportsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReports) EXPECT() *MockReportsMockRecorder {
+	return m.recorder
+}
+
+// CountAggregatedDays mocks base method.
+func (m *MockReports) CountAggregatedDays(ctx context.Context, from, to time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountAggregatedDays", ctx, from, to)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountAggregatedDays indicates an expected call of CountAggregatedDays.
+func (mr *MockReportsMockRecorder) CountAggregatedDays(ctx, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAggregatedDays", reflect.TypeOf((*MockReports)(nil).CountAggregatedDays), ctx, from, to)
+}
+
+// GetAggregatedSales mocks base method.
+func (m *MockReports) GetAggregatedSales(ctx context.Context, from, to time.Time, groupBy string) ([]report.SalesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAggregatedSales", ctx, from, to, groupBy)
+	ret0, _ := ret[0].([]report.SalesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAggregatedSales indicates an expected call of GetAggregatedSales.
+func (mr *MockReportsMockRecorder) GetAggregatedSales(ctx, from, to, groupBy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAggregatedSales", reflect.TypeOf((*MockReports)(nil).GetAggregatedSales), ctx, from, to, groupBy)
+}
+
+// GetChangedDays mocks base method.
+func (m *MockReports) GetChangedDays(ctx context.Context, since time.Time) ([]time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChangedDays", ctx, since)
+	ret0, _ := ret[0].([]time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChangedDays indicates an expected call of GetChangedDays.
+func (mr *MockReportsMockRecorder) GetChangedDays(ctx, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChangedDays", reflect.TypeOf((*MockReports)(nil).GetChangedDays), ctx, since)
+}
+
+// GetSales mocks base method.
+func (m *MockReports) GetSales(ctx context.Context, from, to time.Time, groupBy string) ([]report.SalesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSales", ctx, from, to, groupBy)
+	ret0, _ := ret[0].([]report.SalesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSales indicates an expected call of GetSales.
+func (mr *MockReportsMockRecorder) GetSales(ctx, from, to, groupBy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSales", reflect.TypeOf((*MockReports)(nil).GetSales), ctx, from, to, groupBy)
+}
+
+// GetServiceTimes mocks base method.
+func (m *MockReports) GetServiceTimes(ctx context.Context, from, to time.Time, groupBy string) ([]report.ServiceTimeRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServiceTimes", ctx, from, to, groupBy)
+	ret0, _ := ret[0].([]report.ServiceTimeRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServiceTimes indicates an expected call of GetServiceTimes.
+func (mr *MockReportsMockRecorder) GetServiceTimes(ctx, from, to, groupBy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceTimes", reflect.TypeOf((*MockReports)(nil).GetServiceTimes), ctx, from, to, groupBy)
+}
+
+// GetWatermark mocks base method.
+func (m *MockReports) GetWatermark(ctx context.Context) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWatermark", ctx)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWatermark indicates an expected call of GetWatermark.
+func (mr *MockReportsMockRecorder) GetWatermark(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWatermark", reflect.TypeOf((*MockReports)(nil).GetWatermark), ctx)
+}
+
+// RefreshDay mocks base method.
+func (m *MockReports) RefreshDay(ctx context.Context, day time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshDay", ctx, day)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefreshDay indicates an expected call of RefreshDay.
+func (mr *MockReportsMockRecorder) RefreshDay(ctx, day interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshDay", reflect.TypeOf((*MockReports)(nil).RefreshDay), ctx, day)
+}
+
+// SetWatermark mocks base method.
+func (m *MockReports) SetWatermark(ctx context.Context, watermark time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetWatermark", ctx, watermark)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetWatermark indicates an expected call of SetWatermark.
+func (mr *MockReportsMockRecorder) SetWatermark(ctx, watermark interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWatermark", reflect.TypeOf((*MockReports)(nil).SetWatermark), ctx, watermark)
+}
@@ -0,0 +1,111 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/infrastructure/repository/reservation.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	reservation "github.com/Raj63/golang-rest-api/pkg/domain/reservation"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockReservations is a mock of Reservations interface.
+type MockReservations struct {
+	ctrl     *gomock.Controller
+	recorder *MockReservationsMockRecorder
+}
+
+// MockReservationsMockRecorder is the mock recorder for MockReservations.
+type MockReservationsMockRecorder struct {
+	mock *MockReservations
+}
+
+// NewMockReservations creates a new mock instance.
+func NewMockReservations(ctrl *gomock.Controller) *MockReservations {
+	mock := &MockReservations{ctrl: ctrl}
+	mock.recorder = &MockReservationsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReservations) EXPECT() *MockReservationsMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockReservations) Create(ctx context.Context, newReservation *reservation.Reservation, tableNumbers []int) (*reservation.Reservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, newReservation, tableNumbers)
+	ret0, _ := ret[0].(*reservation.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockReservationsMockRecorder) Create(ctx, newReservation, tableNumbers interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockReservations)(nil).Create), ctx, newReservation, tableNumbers)
+}
+
+// GetAllBetween mocks base method.
+func (m *MockReservations) GetAllBetween(ctx context.Context, from, to time.Time) ([]reservation.Reservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllBetween", ctx, from, to)
+	ret0, _ := ret[0].([]reservation.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllBetween indicates an expected call of GetAllBetween.
+func (mr *MockReservationsMockRecorder) GetAllBetween(ctx, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllBetween", reflect.TypeOf((*MockReservations)(nil).GetAllBetween), ctx, from, to)
+}
+
+// GetByID mocks base method.
+func (m *MockReservations) GetByID(ctx context.Context, id int64) (*reservation.Reservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*reservation.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockReservationsMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockReservations)(nil).GetByID), ctx, id)
+}
+
+// GetOccupancies mocks base method.
+func (m *MockReservations) GetOccupancies(ctx context.Context, from, to time.Time) ([]reservation.Occupancy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOccupancies", ctx, from, to)
+	ret0, _ := ret[0].([]reservation.Occupancy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOccupancies indicates an expected call of GetOccupancies.
+func (mr *MockReservationsMockRecorder) GetOccupancies(ctx, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOccupancies", reflect.TypeOf((*MockReservations)(nil).GetOccupancies), ctx, from, to)
+}
+
+// UpdateStatus mocks base method.
+func (m *MockReservations) UpdateStatus(ctx context.Context, id int64, status string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", ctx, id, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockReservationsMockRecorder) UpdateStatus(ctx, id, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockReservations)(nil).UpdateStatus), ctx, id, status)
+}
@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/infrastructure/repository/table.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	table "github.com/Raj63/golang-rest-api/pkg/domain/table"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockTables is a mock of Tables interface.
+type MockTables struct {
+	ctrl     *gomock.Controller
+	recorder *MockTablesMockRecorder
+}
+
+// MockTablesMockRecorder is the mock recorder for MockTables.
+type MockTablesMockRecorder struct {
+	mock *MockTables
+}
+
+// NewMockTables creates a new mock instance.
+func NewMockTables(ctrl *gomock.Controller) *MockTables {
+	mock := &MockTables{ctrl: ctrl}
+	mock.recorder = &MockTablesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTables) EXPECT() *MockTablesMockRecorder {
+	return m.recorder
+}
+
+// GetAll mocks base method.
+func (m *MockTables) GetAll(ctx context.Context) ([]table.Table, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx)
+	ret0, _ := ret[0].([]table.Table)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockTablesMockRecorder) GetAll(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockTables)(nil).GetAll), ctx)
+}
+
+// GetByMinCapacity mocks base method.
+func (m *MockTables) GetByMinCapacity(ctx context.Context, capacity int) ([]table.Table, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByMinCapacity", ctx, capacity)
+	ret0, _ := ret[0].([]table.Table)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByMinCapacity indicates an expected call of GetByMinCapacity.
+func (mr *MockTablesMockRecorder) GetByMinCapacity(ctx, capacity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByMinCapacity", reflect.TypeOf((*MockTables)(nil).GetByMinCapacity), ctx, capacity)
+}
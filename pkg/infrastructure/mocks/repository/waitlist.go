@@ -0,0 +1,139 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/infrastructure/repository/waitlist.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	waitlist "github.com/Raj63/golang-rest-api/pkg/domain/waitlist"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockWaitlist is a mock of Waitlist interface.
+type MockWaitlist struct {
+	ctrl     *gomock.Controller
+	recorder *MockWaitlistMockRecorder
+}
+
+// MockWaitlistMockRecorder is the mock recorder for MockWaitlist.
+type MockWaitlistMockRecorder struct {
+	mock *MockWaitlist
+}
+
+// NewMockWaitlist creates a new mock instance.
+func NewMockWaitlist(ctrl *gomock.Controller) *MockWaitlist {
+	mock := &MockWaitlist{ctrl: ctrl}
+	mock.recorder = &MockWaitlistMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWaitlist) EXPECT() *MockWaitlistMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockWaitlist) Create(ctx context.Context, newEntry *waitlist.Entry) (*waitlist.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, newEntry)
+	ret0, _ := ret[0].(*waitlist.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockWaitlistMockRecorder) Create(ctx, newEntry interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWaitlist)(nil).Create), ctx, newEntry)
+}
+
+// GetActive mocks base method.
+func (m *MockWaitlist) GetActive(ctx context.Context) ([]waitlist.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActive", ctx)
+	ret0, _ := ret[0].([]waitlist.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActive indicates an expected call of GetActive.
+func (mr *MockWaitlistMockRecorder) GetActive(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActive", reflect.TypeOf((*MockWaitlist)(nil).GetActive), ctx)
+}
+
+// GetByID mocks base method.
+func (m *MockWaitlist) GetByID(ctx context.Context, id int64) (*waitlist.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*waitlist.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockWaitlistMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockWaitlist)(nil).GetByID), ctx, id)
+}
+
+// GetTurnDurations mocks base method.
+func (m *MockWaitlist) GetTurnDurations(ctx context.Context, since time.Time) ([]waitlist.TurnDuration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTurnDurations", ctx, since)
+	ret0, _ := ret[0].([]waitlist.TurnDuration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTurnDurations indicates an expected call of GetTurnDurations.
+func (mr *MockWaitlistMockRecorder) GetTurnDurations(ctx, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTurnDurations", reflect.TypeOf((*MockWaitlist)(nil).GetTurnDurations), ctx, since)
+}
+
+// MarkNotified mocks base method.
+func (m *MockWaitlist) MarkNotified(ctx context.Context, id int64, tableNumber int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkNotified", ctx, id, tableNumber)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkNotified indicates an expected call of MarkNotified.
+func (mr *MockWaitlistMockRecorder) MarkNotified(ctx, id, tableNumber interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkNotified", reflect.TypeOf((*MockWaitlist)(nil).MarkNotified), ctx, id, tableNumber)
+}
+
+// ReleaseNotified mocks base method.
+func (m *MockWaitlist) ReleaseNotified(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseNotified", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseNotified indicates an expected call of ReleaseNotified.
+func (mr *MockWaitlistMockRecorder) ReleaseNotified(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseNotified", reflect.TypeOf((*MockWaitlist)(nil).ReleaseNotified), ctx, id)
+}
+
+// UpdateStatus mocks base method.
+func (m *MockWaitlist) UpdateStatus(ctx context.Context, id int64, status string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", ctx, id, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockWaitlistMockRecorder) UpdateStatus(ctx, id, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockWaitlist)(nil).UpdateStatus), ctx, id, status)
+}
@@ -0,0 +1,21 @@
+// Package notifier contains the implementations used to notify guests
+package notifier
+
+import (
+	"context"
+
+	domainWaitlist "github.com/Raj63/golang-rest-api/pkg/domain/waitlist"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/logger"
+)
+
+// LogNotifier is a notifier that writes the guest notifications to the application log
+type LogNotifier struct {
+	Logger *logger.Logger
+}
+
+// Notify logs that the table of the waitlist entry is ready
+func (n *LogNotifier) Notify(ctx context.Context, entry *domainWaitlist.Entry) error {
+	n.Logger.InfofContext(ctx, "notifying %s (%s): table %d is ready for a party of %d",
+		entry.Name, entry.Contact, entry.TableNumber, entry.PartySize)
+	return nil
+}
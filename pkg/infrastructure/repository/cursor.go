@@ -0,0 +1,200 @@
+package repository
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidCursor is returned when a cursor cannot be decoded
+var ErrInvalidCursor = errors.New("cursor is invalid")
+
+// CursorTimeLayout is the layout of the time cursor keys, which MySQL compares to DATETIME columns
+const CursorTimeLayout = "2006-01-02 15:04:05"
+
+// SortField is a field to sort a list by, the repositories map the field to their column
+type SortField struct {
+	Field string
+	Desc  bool
+}
+
+// ParseSort parses the `-price,name` form of the sort query param, a leading minus sorting the field descending,
+// accepting only the allowed fields
+func ParseSort(sort string, allowed []string) ([]SortField, error) {
+	if strings.TrimSpace(sort) == "" {
+		return nil, nil
+	}
+
+	var fields []SortField
+	seen := map[string]bool{}
+	for _, part := range strings.Split(sort, ",") {
+		field := SortField{Field: strings.TrimSpace(part)}
+		if strings.HasPrefix(field.Field, "-") {
+			field.Field, field.Desc = field.Field[1:], true
+		}
+		if !contains(allowed, field.Field) {
+			return nil, fmt.Errorf("sort field %q is unknown, use any of %s", field.Field, strings.Join(allowed, ", "))
+		}
+		if seen[field.Field] {
+			return nil, fmt.Errorf("sort field %q is repeated", field.Field)
+		}
+		seen[field.Field] = true
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// SortString returns the sort fields in the `-price,name` form of the sort query param
+func SortString(sort []SortField) string {
+	fields := make([]string, len(sort))
+	for i, s := range sort {
+		fields[i] = s.Field
+		if s.Desc {
+			fields[i] = "-" + s.Field
+		}
+	}
+	return strings.Join(fields, ",")
+}
+
+// Cursor is the keyset position of a row in a sorted list: the sort keys and the id of the row, the id breaking ties
+// between rows with the same sort keys. Sort is the sort the cursor was made for, and backward cursors fetch the rows
+// before the position instead of after it.
+type Cursor struct {
+	Sort     string   `json:"s,omitempty"`
+	Keys     []string `json:"k"`
+	ID       int64    `json:"i"`
+	Backward bool     `json:"b,omitempty"`
+}
+
+// PageRequest is a struct that contains the page to fetch, the keyset page after or before Cursor, or the page at
+// Offset when there is no cursor
+type PageRequest struct {
+	Cursor *Cursor
+	Offset int64
+	Limit  int64
+	// Sort defaults to the name when empty
+	Sort []SortField
+	// WithTotal counts every matching row, which is skipped otherwise as it costs a full scan
+	WithTotal bool
+}
+
+// Encode returns the cursor as an opaque URL safe string
+func (c Cursor) Encode() string {
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// DecodeCursor parses a cursor encoded by Cursor.Encode
+func DecodeCursor(encoded string) (*Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	var cursor Cursor
+	if err := json.Unmarshal(raw, &cursor); err != nil || cursor.ID <= 0 || len(cursor.Keys) == 0 {
+		return nil, ErrInvalidCursor
+	}
+	return &cursor, nil
+}
+
+// KeysetClause builds the condition and ordering of a keyset query over the given sort columns and id, fetching
+// limit+1 rows so that the caller can tell whether there are more. Backward pages come out in reverse order. The
+// columns must be trusted column names, never user input.
+func KeysetClause(columns []string, sort []SortField, page *PageRequest) (where string, orderBy string, args []interface{}) {
+	backward := page.Cursor != nil && page.Cursor.Backward
+	descending := func(i int) bool {
+		if i == len(sort) {
+			// the id tie-break
+			return backward
+		}
+		return sort[i].Desc != backward
+	}
+
+	order := make([]string, 0, len(columns)+1)
+	for i, column := range columns {
+		order = append(order, column+direction(descending(i)))
+	}
+	order = append(order, "id"+direction(descending(len(columns))))
+	orderBy = "\nORDER BY\n\t" + strings.Join(order, ", ") + "\nLIMIT ?"
+
+	if page.Cursor == nil {
+		if page.Offset > 0 {
+			return "", orderBy + " OFFSET ?", []interface{}{page.Limit + 1, page.Offset}
+		}
+		return "", orderBy, []interface{}{page.Limit + 1}
+	}
+
+	// (c1 > k1) OR (c1 = k1 AND c2 > k2) OR ... OR (c1 = k1 AND ... AND id > i), with < for the descending columns
+	values := make([]interface{}, 0, len(columns)+1)
+	for _, key := range page.Cursor.Keys {
+		values = append(values, key)
+	}
+	values = append(values, page.Cursor.ID)
+	columns = append(columns[:len(columns):len(columns)], "id")
+
+	var alternatives []string
+	for i := range columns {
+		var terms []string
+		for j := 0; j < i; j++ {
+			terms = append(terms, columns[j]+" = ?")
+			args = append(args, values[j])
+		}
+		if descending(i) {
+			terms = append(terms, columns[i]+" < ?")
+		} else {
+			terms = append(terms, columns[i]+" > ?")
+		}
+		args = append(args, values[i])
+		alternatives = append(alternatives, "("+strings.Join(terms, " AND ")+")")
+	}
+	where = "(" + strings.Join(alternatives, " OR ") + ")"
+	return where, orderBy, append(args, page.Limit+1)
+}
+
+func direction(desc bool) string {
+	if desc {
+		return " DESC"
+	}
+	return " ASC"
+}
+
+// KeysetPage trims the extra row fetched by a keyset query, restores the order of a backward page and returns the
+// cursors of the next and previous pages, empty when there is no such page
+func KeysetPage[T any](rows []T, page *PageRequest, key func(row T) ([]string, int64)) ([]T, string, string) {
+	more := int64(len(rows)) > page.Limit
+	if more {
+		rows = rows[:page.Limit]
+	}
+	backward := page.Cursor != nil && page.Cursor.Backward
+	if backward {
+		for i, j := 0, len(rows)-1; i < j; i, j = i+1, j-1 {
+			rows[i], rows[j] = rows[j], rows[i]
+		}
+	}
+	if len(rows) == 0 {
+		return rows, "", ""
+	}
+
+	sort := SortString(page.Sort)
+	var next, prev string
+	if more || backward {
+		keys, id := key(rows[len(rows)-1])
+		next = Cursor{Sort: sort, Keys: keys, ID: id}.Encode()
+	}
+	if (backward && more) || (!backward && (page.Cursor != nil || page.Offset > 0)) {
+		keys, id := key(rows[0])
+		prev = Cursor{Sort: sort, Keys: keys, ID: id, Backward: true}.Encode()
+	}
+	return rows, next, prev
+}
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+
+	domainCustomer "github.com/Raj63/golang-rest-api/pkg/domain/customer"
+)
+
+// Customers specifies the repository contracts
+type Customers interface {
+	Create(ctx context.Context, newCustomer *domainCustomer.Customer) (*domainCustomer.Customer, error)
+	GetByID(ctx context.Context, id int64) (*domainCustomer.Customer, error)
+	GetBySessionID(ctx context.Context, sessionID int64) (*domainCustomer.Customer, error)
+	Update(ctx context.Context, customer *domainCustomer.Customer) (err error)
+	LinkSession(ctx context.Context, customerID int64, dinerID int64) (err error)
+	GetBalance(ctx context.Context, customerID int64) (*domainCustomer.Balance, error)
+	GetLedger(ctx context.Context, customerID int64) ([]domainCustomer.LedgerEntry, error)
+	Accrue(ctx context.Context, entry *domainCustomer.LedgerEntry) (*domainCustomer.LedgerEntry, error)
+	Redeem(ctx context.Context, entry *domainCustomer.LedgerEntry, dinerID int64) (*domainCustomer.LedgerEntry, error)
+}
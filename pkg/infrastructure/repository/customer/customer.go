@@ -0,0 +1,298 @@
+// Package customer contains the repository implementation for the customer and loyalty ledger entities
+package customer
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	domainCustomer "github.com/Raj63/golang-rest-api/pkg/domain/customer"
+	appErr "github.com/Raj63/golang-rest-api/pkg/domain/errors"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/logger"
+	sdksql "github.com/Raj63/golang-rest-api/pkg/infrastructure/sql"
+	"github.com/go-sql-driver/mysql"
+)
+
+// Repository is a struct that contains the database implementation for customer entity
+type Repository struct {
+	Store  *sdksql.DB
+	Logger *logger.Logger
+}
+
+// Create ... Insert New data
+func (r *Repository) Create(ctx context.Context, newCustomer *domainCustomer.Customer) (*domainCustomer.Customer, error) {
+	customer := fromDomainMapper(newCustomer)
+	// store into DB
+	tx, err := r.Store.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result, err := tx.NamedExecContext(ctx, `
+	INSERT INTO customers (name, email, phone, preferences, created_at, updated_at)
+	VALUES (:name, :email, :phone, :preferences, NOW(), NOW());`, customer)
+	if err != nil {
+		_ = tx.Rollback()
+		var mysqlErr *mysql.MySQLError
+		if errors.As(err, &mysqlErr) && mysqlErr.Number == 1062 {
+			return nil, appErr.NewAppError(errors.New("a customer with the same email or phone already exists"), appErr.ResourceAlreadyExists)
+		}
+		return nil, err
+	}
+
+	customer.ID, err = result.LastInsertId()
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		_ = tx.Rollback()
+		r.Logger.ErrorfContext(ctx, "Commit failed: %v", err)
+		return nil, err
+	}
+	customer.CreatedAt = time.Now()
+	customer.UpdatedAt = customer.CreatedAt
+	return customer.toDomainMapper(), nil
+}
+
+// GetByID ... Fetch only one customer by Id
+func (r *Repository) GetByID(ctx context.Context, id int64) (*domainCustomer.Customer, error) {
+	var customer Customer
+
+	err := r.Store.Querier(ctx).GetContext(ctx, &customer, `
+	SELECT
+		id, name, email, phone, preferences, created_at, updated_at
+	FROM customers
+	WHERE id = ?;`, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, appErr.NewAppErrorWithType(appErr.NotFound)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return customer.toDomainMapper(), nil
+}
+
+// GetBySessionID ... Fetch the customer linked to a diner session
+func (r *Repository) GetBySessionID(ctx context.Context, sessionID int64) (*domainCustomer.Customer, error) {
+	var customer Customer
+
+	err := r.Store.Querier(ctx).GetContext(ctx, &customer, `
+	SELECT
+		c.id, c.name, c.email, c.phone, c.preferences, c.created_at, c.updated_at
+	FROM customers c
+	INNER JOIN diner_sessions s
+		ON s.customer_id = c.id
+	WHERE s.id = ?;`, sessionID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, appErr.NewAppErrorWithType(appErr.NotFound)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return customer.toDomainMapper(), nil
+}
+
+// Update ... Update the profile of a customer
+func (r *Repository) Update(ctx context.Context, updated *domainCustomer.Customer) (err error) {
+	customer := fromDomainMapper(updated)
+	result, err := r.Store.Querier(ctx).NamedExecContext(ctx, `
+	UPDATE customers
+	SET name = :name, email = :email, phone = :phone, preferences = :preferences, updated_at = NOW()
+	WHERE id = :id;
+	`, customer)
+	if err != nil {
+		var mysqlErr *mysql.MySQLError
+		if errors.As(err, &mysqlErr) && mysqlErr.Number == 1062 {
+			return appErr.NewAppError(errors.New("a customer with the same email or phone already exists"), appErr.ResourceAlreadyExists)
+		}
+		r.Logger.ErrorfContext(ctx, "error when executing query. error: %+v, param: %+v\n", err, customer.ID)
+		return err
+	}
+
+	rowAffected, errGetAffectedRow := result.RowsAffected()
+	if errGetAffectedRow != nil || rowAffected == 0 {
+		r.Logger.Errorf("error when get affected row. error: %+v", errGetAffectedRow)
+		return appErr.NewAppErrorWithType(appErr.NotFound)
+	}
+
+	return nil
+}
+
+// LinkSession ... Link the open session of a diner to a customer
+func (r *Repository) LinkSession(ctx context.Context, customerID int64, dinerID int64) (err error) {
+	result, err := r.Store.Querier(ctx).ExecContext(ctx, `
+	UPDATE diner_sessions
+	SET customer_id = ?, updated_at = NOW()
+	WHERE diner_id = ? AND checked_out_at IS NULL;
+	`, customerID, dinerID)
+	if err != nil {
+		r.Logger.ErrorfContext(ctx, "error when executing query. error: %+v, param: %+v\n", err, dinerID)
+		return err
+	}
+
+	rowAffected, errGetAffectedRow := result.RowsAffected()
+	if errGetAffectedRow != nil || rowAffected == 0 {
+		r.Logger.Errorf("error when get affected row. error: %+v", errGetAffectedRow)
+		return appErr.NewAppError(errors.New("diner has no open dining session"), appErr.NotFound)
+	}
+
+	return nil
+}
+
+// GetBalance ... Fetch the current and lifetime earned points of a customer
+func (r *Repository) GetBalance(ctx context.Context, customerID int64) (*domainCustomer.Balance, error) {
+	var balance Balance
+
+	err := r.Store.Querier(ctx).GetContext(ctx, &balance, `
+	SELECT
+		COALESCE(SUM(points), 0) AS points,
+		COALESCE(SUM(CASE WHEN kind = ? THEN points ELSE 0 END), 0) AS lifetime_points
+	FROM loyalty_ledger
+	WHERE customer_id = ?;`, domainCustomer.KindAccrual, customerID)
+	if err != nil {
+		r.Logger.ErrorfContext(ctx, "error fetching loyalty balance: %v", err)
+		return nil, err
+	}
+
+	return &domainCustomer.Balance{
+		CustomerID:     customerID,
+		Points:         balance.Points,
+		LifetimePoints: balance.LifetimePoints,
+	}, nil
+}
+
+// GetLedger ... Fetch the loyalty ledger of a customer, latest first
+func (r *Repository) GetLedger(ctx context.Context, customerID int64) ([]domainCustomer.LedgerEntry, error) {
+	var entries []LedgerEntry
+
+	err := r.Store.Querier(ctx).SelectContext(ctx, &entries, `
+	SELECT
+		id, customer_id, session_id, kind, points, amount, created_at, updated_at
+	FROM loyalty_ledger
+	WHERE customer_id = ?
+	ORDER BY
+		created_at DESC, id DESC;`, customerID)
+	if err != nil {
+		r.Logger.ErrorfContext(ctx, "error fetching loyalty ledger: %v", err)
+		return nil, err
+	}
+
+	return ledgerToDomainMapper(&entries), nil
+}
+
+// Accrue ... Insert the points earned from a paid bill. A bill earns points only once.
+func (r *Repository) Accrue(ctx context.Context, newEntry *domainCustomer.LedgerEntry) (*domainCustomer.LedgerEntry, error) {
+	entry := ledgerEntryFromDomainMapper(newEntry)
+	result, err := r.Store.Querier(ctx).NamedExecContext(ctx, `
+	INSERT INTO loyalty_ledger (customer_id, session_id, kind, points, amount, created_at, updated_at)
+	VALUES (:customer_id, :session_id, :kind, :points, :amount, NOW(), NOW());`, entry)
+	if err != nil {
+		var mysqlErr *mysql.MySQLError
+		if errors.As(err, &mysqlErr) && mysqlErr.Number == 1062 {
+			return nil, appErr.NewAppError(errors.New("points were already earned for this bill"), appErr.ResourceAlreadyExists)
+		}
+		return nil, err
+	}
+
+	entry.ID, err = result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+
+	entry.CreatedAt = time.Now()
+	return entry.toDomainMapper(), nil
+}
+
+// Redeem ... Insert the points spent as a discount on the bill of the open session of a diner linked to the customer.
+// The customer and the session are locked so that the balance and the bill cannot change while the points are spent.
+func (r *Repository) Redeem(ctx context.Context, newEntry *domainCustomer.LedgerEntry, dinerID int64) (*domainCustomer.LedgerEntry, error) {
+	entry := ledgerEntryFromDomainMapper(newEntry)
+
+	tx, err := r.Store.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var customerID int64
+	err = tx.GetContext(ctx, &customerID, `SELECT id FROM customers WHERE id = ? FOR UPDATE;`, entry.CustomerID)
+	if err != nil {
+		_ = tx.Rollback()
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, appErr.NewAppErrorWithType(appErr.NotFound)
+		}
+		return nil, err
+	}
+
+	var sessionID int64
+	err = tx.GetContext(ctx, &sessionID, `
+	SELECT id FROM diner_sessions
+	WHERE diner_id = ? AND customer_id = ? AND checked_out_at IS NULL
+	FOR UPDATE;`, dinerID, entry.CustomerID)
+	if err != nil {
+		_ = tx.Rollback()
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, appErr.NewAppError(errors.New("diner has no open dining session linked to the customer"), appErr.ValidationError)
+		}
+		return nil, err
+	}
+	entry.SessionID = &sessionID
+
+	var balance int
+	err = tx.GetContext(ctx, &balance, `SELECT COALESCE(SUM(points), 0) FROM loyalty_ledger WHERE customer_id = ?;`, entry.CustomerID)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	if balance+entry.Points < 0 {
+		_ = tx.Rollback()
+		return nil, appErr.NewAppError(errors.New("customer does not have enough loyalty points"), appErr.ValidationError)
+	}
+
+	var bill int
+	err = tx.GetContext(ctx, &bill, `
+	SELECT
+		COALESCE(SUM(o.quantity * m.price), 0)
+	FROM orders o
+	INNER JOIN menus m
+		ON o.menu_id = m.id
+	WHERE o.session_id = ?;`, sessionID)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	if entry.Amount > bill {
+		_ = tx.Rollback()
+		return nil, appErr.NewAppError(errors.New("redeemed points are worth more than the bill"), appErr.ValidationError)
+	}
+
+	result, err := tx.NamedExecContext(ctx, `
+	INSERT INTO loyalty_ledger (customer_id, session_id, kind, points, amount, created_at, updated_at)
+	VALUES (:customer_id, :session_id, :kind, :points, :amount, NOW(), NOW());`, entry)
+	if err != nil {
+		_ = tx.Rollback()
+		var mysqlErr *mysql.MySQLError
+		if errors.As(err, &mysqlErr) && mysqlErr.Number == 1062 {
+			return nil, appErr.NewAppError(errors.New("points were already redeemed against this bill"), appErr.ResourceAlreadyExists)
+		}
+		return nil, err
+	}
+
+	entry.ID, err = result.LastInsertId()
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		_ = tx.Rollback()
+		r.Logger.ErrorfContext(ctx, "Commit failed: %v", err)
+		return nil, err
+	}
+
+	entry.CreatedAt = time.Now()
+	return entry.toDomainMapper(), nil
+}
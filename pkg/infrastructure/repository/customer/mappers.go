@@ -0,0 +1,89 @@
+// Package customer contains the repository implementation for the customer and loyalty ledger entities
+package customer
+
+import (
+	"math"
+
+	domainCustomer "github.com/Raj63/golang-rest-api/pkg/domain/customer"
+)
+
+func (customer *Customer) toDomainMapper() *domainCustomer.Customer {
+	return &domainCustomer.Customer{
+		ID:          customer.ID,
+		Name:        customer.Name,
+		Email:       valueOf(customer.Email),
+		Phone:       valueOf(customer.Phone),
+		Preferences: customer.Preferences,
+		CreatedAt:   customer.CreatedAt,
+		UpdatedAt:   customer.UpdatedAt,
+	}
+}
+
+func fromDomainMapper(customer *domainCustomer.Customer) *Customer {
+	return &Customer{
+		ID:          customer.ID,
+		Name:        customer.Name,
+		Email:       nullable(customer.Email),
+		Phone:       nullable(customer.Phone),
+		Preferences: customer.Preferences,
+		CreatedAt:   customer.CreatedAt,
+	}
+}
+
+func (entry *LedgerEntry) toDomainMapper() *domainCustomer.LedgerEntry {
+	var sessionID int64
+	if entry.SessionID != nil {
+		sessionID = *entry.SessionID
+	}
+
+	return &domainCustomer.LedgerEntry{
+		ID:         entry.ID,
+		CustomerID: entry.CustomerID,
+		SessionID:  sessionID,
+		Kind:       entry.Kind,
+		Points:     entry.Points,
+		Amount:     float64(entry.Amount) / 100, // We stored amount as numeric value in MYSQL database hence to divide with 100 to get the actual decial points
+		CreatedAt:  entry.CreatedAt,
+	}
+}
+
+func ledgerEntryFromDomainMapper(entry *domainCustomer.LedgerEntry) *LedgerEntry {
+	var sessionID *int64
+	if entry.SessionID != 0 {
+		sessionID = &entry.SessionID
+	}
+
+	return &LedgerEntry{
+		ID:         entry.ID,
+		CustomerID: entry.CustomerID,
+		SessionID:  sessionID,
+		Kind:       entry.Kind,
+		Points:     entry.Points,
+		Amount:     int(math.Round(entry.Amount * 100)), // We store amount as numeric value in MYSQL database hence to multiply with 100 to keep the decimal points
+		CreatedAt:  entry.CreatedAt,
+	}
+}
+
+func ledgerToDomainMapper(entries *[]LedgerEntry) []domainCustomer.LedgerEntry {
+	entriesDomain := make([]domainCustomer.LedgerEntry, len(*entries))
+	for i, entry := range *entries {
+		entriesDomain[i] = *entry.toDomainMapper()
+	}
+
+	return entriesDomain
+}
+
+func valueOf(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// nullable stores empty contact details as NULL so that they do not collide on the unique indexes
+func nullable(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
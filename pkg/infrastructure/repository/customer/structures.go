@@ -0,0 +1,45 @@
+// Package customer contains the repository implementation for the customer and loyalty ledger entities
+package customer
+
+import (
+	"time"
+)
+
+// Customer is a struct that contains the customer model
+type Customer struct {
+	ID          int64     `db:"id" example:"123"`
+	Name        string    `db:"name" example:"Mr. Smith"`
+	Email       *string   `db:"email" example:"smith@example.com"`
+	Phone       *string   `db:"phone" example:"+91 98765 43210"`
+	Preferences string    `db:"preferences" example:"window seat, no peanuts"`
+	CreatedAt   time.Time `db:"created_at" example:"2021-02-24 20:19:39"`
+	UpdatedAt   time.Time `db:"updated_at" example:"2021-02-24 20:19:39"`
+}
+
+// TableName overrides the table name used by Customer to `customers`
+func (*Customer) TableName() string {
+	return "customers"
+}
+
+// LedgerEntry is a struct that contains the loyalty ledger entry model
+type LedgerEntry struct {
+	ID         int64     `db:"id" example:"123"`
+	CustomerID int64     `db:"customer_id" example:"7"`
+	SessionID  *int64    `db:"session_id" example:"1"`
+	Kind       string    `db:"kind" example:"accrual"`
+	Points     int       `db:"points" example:"48"`
+	Amount     int       `db:"amount" example:"48050"`
+	CreatedAt  time.Time `db:"created_at" example:"2021-02-24 20:19:39"`
+	UpdatedAt  time.Time `db:"updated_at" example:"2021-02-24 20:19:39"`
+}
+
+// TableName overrides the table name used by LedgerEntry to `loyalty_ledger`
+func (*LedgerEntry) TableName() string {
+	return "loyalty_ledger"
+}
+
+// Balance is a struct that contains the points totals of a customer
+type Balance struct {
+	Points         int `db:"points" example:"340"`
+	LifetimePoints int `db:"lifetime_points" example:"1240"`
+}
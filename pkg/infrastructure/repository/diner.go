@@ -10,18 +10,34 @@ import (
 type Diners interface {
 	GetTotalCount(ctx context.Context) (int64, error)
 	GetAll(ctx context.Context, page int64, limit int64) (*PaginationResultDiner, error)
+	Search(ctx context.Context, filter *DinerFilter, page int64, limit int64) (*PaginationResultDiner, error)
+	SearchPage(ctx context.Context, filter *DinerFilter, page *PageRequest) (*PaginationResultDiner, error)
 	Create(ctx context.Context, newDiner *domainDiner.Diner) (*domainDiner.Diner, error)
+	Update(ctx context.Context, updated *domainDiner.Diner) (*domainDiner.Diner, error)
 	GetByID(ctx context.Context, id int64) (*domainDiner.Diner, error)
+	GetByIDs(ctx context.Context, ids []int64) ([]domainDiner.Diner, error)
 	Delete(ctx context.Context, id int64) (err error)
+	CheckIn(ctx context.Context, dinerID int64, tableNumber int) (*domainDiner.Session, error)
+	GetSessions(ctx context.Context, dinerID int64) ([]domainDiner.Session, error)
+	Checkout(ctx context.Context, dinerID int64) (*domainDiner.Session, *domainDiner.Payment, error)
 }
 
-// PaginationResultDiner is a struct that contains the pagination result for diner
+// DinerFilter is a struct that contains the criteria to search diners by, zero values match every diner
+type DinerFilter struct {
+	// Name matches diners whose name contains it, ignoring case
+	Name        string
+	TableNumber int
+}
+
+// PaginationResultDiner is a struct that contains the pagination result for diner. The cursors are opaque keyset
+// cursors, empty when there is no next or previous page. Total and NumPages are
+// not counted for keyset pages unless requested.
 type PaginationResultDiner struct {
 	Data       *[]domainDiner.Diner
 	Total      int64
 	Limit      int64
 	Current    int64
-	NextCursor uint
-	PrevCursor uint
+	NextCursor string
+	PrevCursor string
 	NumPages   int64
 }
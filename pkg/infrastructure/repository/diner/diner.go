@@ -5,13 +5,17 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
+	"strings"
+	"time"
 
+	domainCustomer "github.com/Raj63/golang-rest-api/pkg/domain/customer"
 	domainDiner "github.com/Raj63/golang-rest-api/pkg/domain/diner"
 	appErr "github.com/Raj63/golang-rest-api/pkg/domain/errors"
 	"github.com/Raj63/golang-rest-api/pkg/infrastructure/logger"
 	"github.com/Raj63/golang-rest-api/pkg/infrastructure/repository"
 	sdksql "github.com/Raj63/golang-rest-api/pkg/infrastructure/sql"
-	"github.com/go-sql-driver/mysql"
+	"github.com/jmoiron/sqlx"
 )
 
 // Repository is a struct that contains the database implementation for diner entity
@@ -23,7 +27,7 @@ type Repository struct {
 // GetTotalCount Fetch total count of diners
 func (r *Repository) GetTotalCount(ctx context.Context) (int64, error) {
 	var total int64
-	err := r.Store.DB().Get(&total, `SELECT count(id) FROM diners`)
+	err := r.Store.Querier(ctx).Get(&total, `SELECT count(id) FROM diners`)
 	if err != nil {
 		r.Logger.ErrorfContext(ctx, "error fetching total count: %v", err)
 		return 0, err
@@ -41,12 +45,12 @@ func (r *Repository) GetAll(ctx context.Context, page int64, limit int64) (*repo
 	offset := (page - 1) * limit
 
 	// Read diner from DB based on limit and offset
-	rows, err := r.Store.DB().Queryx(`
+	rows, err := r.Store.Querier(ctx).Queryx(`
 SELECT
 	id, name, table_no, created_at, updated_at 
 FROM diners
 ORDER BY
-	name ASC
+	name ASC, id ASC
 LIMIT ? OFFSET ?;`, limit, offset)
 	if err != nil {
 		return nil, err
@@ -63,14 +67,47 @@ LIMIT ? OFFSET ?;`, limit, offset)
 	}
 
 	numPages := (total + limit - 1) / limit
-	var nextCursor, prevCursor uint
-	if page < numPages {
-		nextCursor = uint(page + 1)
+	nextCursor, prevCursor := offsetCursors(diners, page, numPages)
+
+	return &repository.PaginationResultDiner{
+		Data:       arrayToDomainMapper(&diners),
+		Total:      total,
+		Limit:      limit,
+		Current:    page,
+		NextCursor: nextCursor,
+		PrevCursor: prevCursor,
+		NumPages:   numPages,
+	}, nil
+}
+
+// Search Fetch the diners matching the filter
+func (r *Repository) Search(ctx context.Context, filter *repository.DinerFilter, page int64, limit int64) (*repository.PaginationResultDiner, error) {
+	where, args := filterClause(filter)
+
+	var total int64
+	err := r.Store.Querier(ctx).GetContext(ctx, &total, `SELECT count(id) FROM diners`+where, args...)
+	if err != nil {
+		r.Logger.ErrorfContext(ctx, "error fetching total count: %v", err)
+		return nil, err
 	}
-	if page > 1 {
-		prevCursor = uint(page - 1)
+	offset := (page - 1) * limit
+
+	var diners []Diner
+	err = r.Store.Querier(ctx).SelectContext(ctx, &diners, `
+SELECT
+	id, name, table_no, created_at, updated_at
+FROM diners`+where+`
+ORDER BY
+	name ASC, id ASC
+LIMIT ? OFFSET ?;`, append(args, limit, offset)...)
+	if err != nil {
+		r.Logger.ErrorfContext(ctx, "error searching diners: %v", err)
+		return nil, err
 	}
 
+	numPages := (total + limit - 1) / limit
+	nextCursor, prevCursor := offsetCursors(diners, page, numPages)
+
 	return &repository.PaginationResultDiner{
 		Data:       arrayToDomainMapper(&diners),
 		Total:      total,
@@ -82,22 +119,24 @@ LIMIT ? OFFSET ?;`, limit, offset)
 	}, nil
 }
 
-// Create ... Insert New data
+// Create ... Insert New data and check the diner in to a new session at their table
 func (r *Repository) Create(ctx context.Context, newDiner *domainDiner.Diner) (*domainDiner.Diner, error) {
 	diner := fromDomainMapper(newDiner)
 	// store into DB
-	tx, err := r.Store.DB().Beginx()
+	tx, err := r.Store.Begin(ctx)
 	if err != nil {
 		return nil, err
 	}
+
+	if err := r.checkSeatConflict(ctx, tx, diner.Name, diner.TableNumber, 0); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
 	// Named queries can use structs, so if you have an existing struct (i.e. person := &Person{}) that you have populated, you can pass it in as &person
 	result, err := tx.NamedExecContext(ctx, "INSERT INTO diners (name, table_no, created_at, updated_at) VALUES (:name, :table_no, NOW(), NOW());", diner)
 	if err != nil {
 		_ = tx.Rollback()
-		var mysqlErr *mysql.MySQLError
-		if errors.As(err, &mysqlErr) && mysqlErr.Number == 1062 {
-			return nil, appErr.NewAppErrorWithType(appErr.ResourceAlreadyExists)
-		}
 		return nil, err
 	}
 
@@ -111,6 +150,20 @@ func (r *Repository) Create(ctx context.Context, newDiner *domainDiner.Diner) (*
 		_ = tx.Rollback()
 		return nil, sql.ErrNoRows
 	}
+
+	diner.ID, err = result.LastInsertId()
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+	INSERT INTO diner_sessions (diner_id, table_no, checked_in_at, created_at, updated_at)
+	VALUES (?, ?, NOW(), NOW(), NOW());`, diner.ID, diner.TableNumber); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
 	if err := tx.Commit(); err != nil {
 		_ = tx.Rollback()
 		r.Logger.ErrorfContext(ctx, "Commit failed: %v", err)
@@ -123,7 +176,10 @@ func (r *Repository) Create(ctx context.Context, newDiner *domainDiner.Diner) (*
 func (r *Repository) GetByID(ctx context.Context, id int64) (*domainDiner.Diner, error) {
 	var diner Diner
 
-	err := r.Store.DB().Get(&diner, `SELECT id, name, table_no, created_at, updated_at FROM diners WHERE id=?;`, id)
+	err := r.Store.Querier(ctx).Get(&diner, `SELECT id, name, table_no, created_at, updated_at FROM diners WHERE id=?;`, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, appErr.NewAppErrorWithType(appErr.NotFound)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -131,23 +187,428 @@ func (r *Repository) GetByID(ctx context.Context, id int64) (*domainDiner.Diner,
 	return diner.toDomainMapper(), nil
 }
 
-// Delete ... Delete diner
+// GetByIDs ... Fetch many diners at once by their ids, the ids not found are left out
+func (r *Repository) GetByIDs(ctx context.Context, ids []int64) ([]domainDiner.Diner, error) {
+	var diners []Diner
+	if len(ids) == 0 {
+		return *arrayToDomainMapper(&diners), nil
+	}
+
+	query, args, err := sqlx.In(`SELECT id, name, table_no, created_at, updated_at FROM diners WHERE id IN (?);`, ids)
+	if err != nil {
+		return nil, err
+	}
+	err = r.Store.Querier(ctx).SelectContext(ctx, &diners, r.Store.Querier(ctx).Rebind(query), args...)
+	if err != nil {
+		r.Logger.ErrorfContext(ctx, "error fetching diners: %v", err)
+		return nil, err
+	}
+
+	return *arrayToDomainMapper(&diners), nil
+}
+
+// Update ... Rename a diner or move them to another table, along with their open session
+func (r *Repository) Update(ctx context.Context, updated *domainDiner.Diner) (*domainDiner.Diner, error) {
+	diner := fromDomainMapper(updated)
+
+	tx, err := r.Store.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// lock the diner so that concurrent updates and check-ins of the same diner are serialised
+	var current Diner
+	err = tx.GetContext(ctx, &current, `SELECT id, name, table_no, created_at, updated_at FROM diners WHERE id = ? FOR UPDATE;`, diner.ID)
+	if err != nil {
+		_ = tx.Rollback()
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, appErr.NewAppErrorWithType(appErr.NotFound)
+		}
+		return nil, err
+	}
+
+	if err := r.checkSeatConflict(ctx, tx, diner.Name, diner.TableNumber, diner.ID); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if _, err := tx.NamedExecContext(ctx, `UPDATE diners SET name = :name, table_no = :table_no, updated_at = NOW() WHERE id = :id;`, diner); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+	UPDATE diner_sessions
+	SET table_no = ?, updated_at = NOW()
+	WHERE diner_id = ? AND checked_out_at IS NULL;`, diner.TableNumber, diner.ID); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		_ = tx.Rollback()
+		r.Logger.ErrorfContext(ctx, "Commit failed: %v", err)
+		return nil, err
+	}
+
+	diner.CreatedAt = current.CreatedAt
+	diner.UpdatedAt = time.Now()
+	return diner.toDomainMapper(), nil
+}
+
+// Delete ... Delete diner, marking the days of their orders for the sales aggregates to be refreshed
 func (r *Repository) Delete(ctx context.Context, id int64) (err error) {
-	result, err := r.Store.DB().ExecContext(ctx, `
+	tx, err := r.Store.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	// the orders are gone once deleted, so their days are marked beforehand
+	if _, err := tx.ExecContext(ctx, `
+	INSERT INTO sales_dirty_days (day, marked_at)
+	SELECT DISTINCT DATE(created_at), NOW() FROM orders WHERE diner_id = ?
+	ON DUPLICATE KEY UPDATE marked_at = NOW();`, id); err != nil {
+		_ = tx.Rollback()
+		r.Logger.ErrorfContext(ctx, "error marking the days of the deleted orders. error: %+v, param: %+v\n", err, id)
+		return err
+	}
+
+	result, err := tx.ExecContext(ctx, `
 	DELETE FROM
 		diners
 	WHERE id = ?;
 	`, id)
 	if err != nil {
+		_ = tx.Rollback()
 		r.Logger.ErrorfContext(ctx, "error when executing query. error: %+v, param: %+v\n", err, id)
 		return err
 	}
 
 	rowAffected, errGetAffectedRow := result.RowsAffected()
 	if errGetAffectedRow != nil || rowAffected == 0 {
+		_ = tx.Rollback()
 		r.Logger.Errorf("error when get affected row. error: %+v", errGetAffectedRow)
 		return appErr.NewAppErrorWithType(appErr.NotFound)
 	}
 
+	if err := tx.Commit(); err != nil {
+		_ = tx.Rollback()
+		r.Logger.ErrorfContext(ctx, "Commit failed: %v", err)
+		return err
+	}
+	return nil
+}
+
+// CheckIn ... Open a new session for a returning diner at the given table
+func (r *Repository) CheckIn(ctx context.Context, dinerID int64, tableNumber int) (*domainDiner.Session, error) {
+	tx, err := r.Store.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// lock the diner so that concurrent check-ins of the same diner are serialised
+	var diner Diner
+	err = tx.GetContext(ctx, &diner, `SELECT id, name, table_no, created_at, updated_at FROM diners WHERE id = ? FOR UPDATE;`, dinerID)
+	if err != nil {
+		_ = tx.Rollback()
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, appErr.NewAppErrorWithType(appErr.NotFound)
+		}
+		return nil, err
+	}
+
+	var openSessions int
+	err = tx.GetContext(ctx, &openSessions, `SELECT COUNT(id) FROM diner_sessions WHERE diner_id = ? AND checked_out_at IS NULL;`, dinerID)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	if openSessions > 0 {
+		_ = tx.Rollback()
+		return nil, appErr.NewAppError(errors.New("diner already has an open dining session"), appErr.ResourceAlreadyExists)
+	}
+
+	if err := r.checkSeatConflict(ctx, tx, diner.Name, tableNumber, dinerID); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	now := time.Now()
+	result, err := tx.ExecContext(ctx, `
+	INSERT INTO diner_sessions (diner_id, table_no, checked_in_at, created_at, updated_at)
+	VALUES (?, ?, ?, NOW(), NOW());`, dinerID, tableNumber, now)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	sessionID, err := result.LastInsertId()
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if _, err := tx.ExecContext(ctx, `UPDATE diners SET table_no = ?, updated_at = NOW() WHERE id = ?;`, tableNumber, dinerID); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		_ = tx.Rollback()
+		r.Logger.ErrorfContext(ctx, "Commit failed: %v", err)
+		return nil, err
+	}
+
+	return &domainDiner.Session{
+		ID:          sessionID,
+		DinerID:     dinerID,
+		TableNumber: tableNumber,
+		CheckedInAt: now,
+	}, nil
+}
+
+// GetSessions ... Fetch the sessions of a diner, latest first
+func (r *Repository) GetSessions(ctx context.Context, dinerID int64) ([]domainDiner.Session, error) {
+	var sessions []Session
+
+	err := r.Store.Querier(ctx).SelectContext(ctx, &sessions, `
+	SELECT
+		id, diner_id, COALESCE(customer_id, 0) AS customer_id, table_no, checked_in_at, checked_out_at, created_at, updated_at
+	FROM diner_sessions
+	WHERE diner_id = ?
+	ORDER BY
+		checked_in_at DESC, id DESC;`, dinerID)
+	if err != nil {
+		r.Logger.ErrorfContext(ctx, "error fetching diner sessions: %v", err)
+		return nil, err
+	}
+
+	return sessionsToDomainMapper(&sessions), nil
+}
+
+// Checkout ... Record the payment of the bill of the open session of a diner and close the session
+func (r *Repository) Checkout(ctx context.Context, dinerID int64) (*domainDiner.Session, *domainDiner.Payment, error) {
+	tx, err := r.Store.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var session Session
+	err = tx.GetContext(ctx, &session, `
+	SELECT
+		id, diner_id, COALESCE(customer_id, 0) AS customer_id, table_no, checked_in_at, checked_out_at, created_at, updated_at
+	FROM diner_sessions
+	WHERE diner_id = ? AND checked_out_at IS NULL
+	FOR UPDATE;`, dinerID)
+	if err != nil {
+		_ = tx.Rollback()
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil, appErr.NewAppError(errors.New("diner has no open dining session"), appErr.NotFound)
+		}
+		return nil, nil, err
+	}
+
+	var subtotal int
+	err = tx.GetContext(ctx, &subtotal, `
+	SELECT
+		COALESCE(SUM(o.quantity * m.price), 0)
+	FROM orders o
+	INNER JOIN menus m
+		ON o.menu_id = m.id
+	WHERE o.session_id = ?;`, session.ID)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, nil, err
+	}
+
+	// loyalty points redeemed against the bill are taken off the amount to pay
+	var discount int
+	err = tx.GetContext(ctx, &discount, `
+	SELECT
+		COALESCE(SUM(amount), 0)
+	FROM loyalty_ledger
+	WHERE session_id = ? AND kind = ?;`, session.ID, domainCustomer.KindRedemption)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, nil, err
+	}
+	if discount > subtotal {
+		discount = subtotal
+	}
+
+	now := time.Now()
+	payment := Payment{SessionID: session.ID, Subtotal: subtotal, Discount: discount, Amount: subtotal - discount, PaidAt: now}
+	result, err := tx.NamedExecContext(ctx, `
+	INSERT INTO payments (session_id, subtotal, discount, amount, paid_at, created_at, updated_at)
+	VALUES (:session_id, :subtotal, :discount, :amount, :paid_at, NOW(), NOW());`, payment)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, nil, err
+	}
+
+	payment.ID, err = result.LastInsertId()
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, nil, err
+	}
+
+	if _, err := tx.ExecContext(ctx, `UPDATE diner_sessions SET checked_out_at = ?, updated_at = NOW() WHERE id = ?;`, now, session.ID); err != nil {
+		_ = tx.Rollback()
+		return nil, nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		_ = tx.Rollback()
+		r.Logger.ErrorfContext(ctx, "Commit failed: %v", err)
+		return nil, nil, err
+	}
+
+	session.CheckedOutAt = &now
+	return session.toDomainMapper(), payment.toDomainMapper(), nil
+}
+
+// checkSeatConflict rejects seating a diner at a table where a diner with the same name is already seated. The table
+// is locked until tx ends, so that the diners seated at the same table at once are checked one after the other.
+func (r *Repository) checkSeatConflict(ctx context.Context, tx sdksql.Tx, name string, tableNumber int, excludeDinerID int64) error {
+	var tableID int64
+	err := tx.GetContext(ctx, &tableID, `SELECT id FROM dining_tables WHERE table_no = ? FOR UPDATE;`, tableNumber)
+	if errors.Is(err, sql.ErrNoRows) {
+		return appErr.NewValidationError(appErr.FieldError{Field: "table_no", Message: fmt.Sprintf("table_no %d does not exist", tableNumber)})
+	}
+	if err != nil {
+		r.Logger.ErrorfContext(ctx, "error locking table %d: %v", tableNumber, err)
+		return err
+	}
+
+	var seated int
+	err = tx.GetContext(ctx, &seated, `
+	SELECT
+		COUNT(s.id)
+	FROM diner_sessions s
+	INNER JOIN diners d
+		ON s.diner_id = d.id
+	WHERE s.table_no = ? AND d.name = ? AND s.checked_out_at IS NULL AND d.id <> ?;`, tableNumber, name, excludeDinerID)
+	if err != nil {
+		r.Logger.ErrorfContext(ctx, "error checking seat conflicts: %v", err)
+		return err
+	}
+	if seated > 0 {
+		return appErr.NewAppErrorWithType(appErr.ResourceAlreadyExists)
+	}
+
 	return nil
 }
+
+// SearchPage Fetch a keyset page of the sorted diners matching the filter, which stays stable when diners are added
+// between the pages
+func (r *Repository) SearchPage(ctx context.Context, filter *repository.DinerFilter, page *repository.PageRequest) (*repository.PaginationResultDiner, error) {
+	sort := page.Sort
+	if len(sort) == 0 {
+		sort = defaultSort
+	}
+	columns := make([]string, len(sort))
+	for i, s := range sort {
+		column, ok := sortColumns[s.Field]
+		if !ok {
+			return nil, appErr.NewAppError(fmt.Errorf("diners cannot be sorted by %s", s.Field), appErr.ValidationError)
+		}
+		columns[i] = column
+	}
+	if page.Cursor != nil && len(page.Cursor.Keys) != len(sort) {
+		return nil, appErr.NewAppError(repository.ErrInvalidCursor, appErr.ValidationError)
+	}
+
+	where, args := filterClause(filter)
+	keyset, orderBy, keysetArgs := repository.KeysetClause(columns, sort, page)
+	if keyset != "" {
+		if where == "" {
+			where = "\nWHERE " + keyset
+		} else {
+			where += " AND " + keyset
+		}
+	}
+
+	var diners []Diner
+	err := r.Store.Querier(ctx).SelectContext(ctx, &diners, `
+SELECT
+	id, name, table_no, created_at, updated_at
+FROM diners`+where+orderBy, append(args, keysetArgs...)...)
+	if err != nil {
+		r.Logger.ErrorfContext(ctx, "error fetching diners page: %v", err)
+		return nil, err
+	}
+	diners, nextCursor, prevCursor := repository.KeysetPage(diners, page, func(diner Diner) ([]string, int64) {
+		return diner.sortKeys(sort), diner.ID
+	})
+
+	result := &repository.PaginationResultDiner{
+		Data:       arrayToDomainMapper(&diners),
+		Limit:      page.Limit,
+		NextCursor: nextCursor,
+		PrevCursor: prevCursor,
+	}
+	if page.WithTotal {
+		countWhere, countArgs := filterClause(filter)
+		err = r.Store.Querier(ctx).GetContext(ctx, &result.Total, `SELECT count(id) FROM diners`+countWhere, countArgs...)
+		if err != nil {
+			r.Logger.ErrorfContext(ctx, "error fetching total count: %v", err)
+			return nil, err
+		}
+		result.NumPages = (result.Total + page.Limit - 1) / page.Limit
+	}
+	if page.Cursor == nil {
+		result.Current = page.Offset/page.Limit + 1
+	}
+	return result, nil
+}
+
+// defaultSort is the sort of the diners when none is requested
+var defaultSort = []repository.SortField{{Field: "name"}}
+
+// sortColumns maps the sortable fields to their columns, keeping the sort query param out of the SQL
+var sortColumns = map[string]string{
+	"id":         "id",
+	"name":       "name",
+	"table_no":   "table_no",
+	"created_at": "created_at",
+}
+
+// offsetCursors returns the keyset cursors around a page fetched by page number, so that the client can carry on
+// with keyset pages from there
+func offsetCursors(diners []Diner, page, numPages int64) (string, string) {
+	if len(diners) == 0 {
+		return "", ""
+	}
+	var nextCursor, prevCursor string
+	if page < numPages {
+		last := diners[len(diners)-1]
+		nextCursor = repository.Cursor{Keys: []string{last.Name}, ID: last.ID}.Encode()
+	}
+	if page > 1 {
+		prevCursor = repository.Cursor{Keys: []string{diners[0].Name}, ID: diners[0].ID, Backward: true}.Encode()
+	}
+	return nextCursor, prevCursor
+}
+
+// filterClause builds the WHERE clause and its arguments matching the given diner filter
+func filterClause(filter *repository.DinerFilter) (string, []interface{}) {
+	var conditions []string
+	var args []interface{}
+
+	if filter.Name != "" {
+		conditions = append(conditions, `LOWER(name) LIKE ?`)
+		args = append(args, "%"+likeEscaper.Replace(strings.ToLower(filter.Name))+"%")
+	}
+	if filter.TableNumber != 0 {
+		conditions = append(conditions, `table_no = ?`)
+		args = append(args, filter.TableNumber)
+	}
+
+	if len(conditions) == 0 {
+		return "", args
+	}
+	return "\nWHERE " + strings.Join(conditions, " AND "), args
+}
+
+// likeEscaper escapes the LIKE wildcards of a search term with the default backslash escape so that they are matched literally
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
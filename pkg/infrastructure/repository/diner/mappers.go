@@ -1,7 +1,12 @@
 // Package diner contains the repository implementation for the diner entity
 package diner
 
-import domainDiner "github.com/Raj63/golang-rest-api/pkg/domain/diner"
+import (
+	"strconv"
+
+	domainDiner "github.com/Raj63/golang-rest-api/pkg/domain/diner"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/repository"
+)
 
 func (diner *Diner) toDomainMapper() *domainDiner.Diner {
 	return &domainDiner.Diner{
@@ -30,3 +35,52 @@ func arrayToDomainMapper(diners *[]Diner) *[]domainDiner.Diner {
 
 	return &dinersDomain
 }
+
+func (session *Session) toDomainMapper() *domainDiner.Session {
+	return &domainDiner.Session{
+		ID:           session.ID,
+		DinerID:      session.DinerID,
+		CustomerID:   session.CustomerID,
+		TableNumber:  session.TableNumber,
+		CheckedInAt:  session.CheckedInAt,
+		CheckedOutAt: session.CheckedOutAt,
+	}
+}
+
+func sessionsToDomainMapper(sessions *[]Session) []domainDiner.Session {
+	sessionsDomain := make([]domainDiner.Session, len(*sessions))
+	for i, session := range *sessions {
+		sessionsDomain[i] = *session.toDomainMapper()
+	}
+
+	return sessionsDomain
+}
+
+func (payment *Payment) toDomainMapper() *domainDiner.Payment {
+	return &domainDiner.Payment{
+		ID:        payment.ID,
+		SessionID: payment.SessionID,
+		Subtotal:  float64(payment.Subtotal) / 100,
+		Discount:  float64(payment.Discount) / 100,
+		Amount:    float64(payment.Amount) / 100, // We stored amount as numeric value in MYSQL database hence to divide with 100 to get the actual decial points
+		PaidAt:    payment.PaidAt,
+	}
+}
+
+// sortKeys returns the values of the sort columns of the diner as cursor keys
+func (diner *Diner) sortKeys(sort []repository.SortField) []string {
+	keys := make([]string, len(sort))
+	for i, s := range sort {
+		switch s.Field {
+		case "id":
+			keys[i] = strconv.FormatInt(diner.ID, 10)
+		case "name":
+			keys[i] = diner.Name
+		case "table_no":
+			keys[i] = strconv.Itoa(diner.TableNumber)
+		case "created_at":
+			keys[i] = diner.CreatedAt.Format(repository.CursorTimeLayout)
+		}
+	}
+	return keys
+}
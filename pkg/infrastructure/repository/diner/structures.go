@@ -18,3 +18,35 @@ type Diner struct {
 func (*Diner) TableName() string {
 	return "diners"
 }
+
+// Session is a struct that contains the diner session model
+type Session struct {
+	ID           int64      `db:"id" example:"123"`
+	DinerID      int64      `db:"diner_id" example:"1"`
+	CustomerID   int64      `db:"customer_id" example:"7"`
+	TableNumber  int        `db:"table_no" example:"101"`
+	CheckedInAt  time.Time  `db:"checked_in_at" example:"2021-02-24 20:19:39"`
+	CheckedOutAt *time.Time `db:"checked_out_at" example:"2021-02-24 21:49:39"`
+	CreatedAt    time.Time  `db:"created_at" example:"2021-02-24 20:19:39"`
+	UpdatedAt    time.Time  `db:"updated_at" example:"2021-02-24 20:19:39"`
+}
+
+// TableName overrides the table name used by Session to `diner_sessions`
+func (*Session) TableName() string {
+	return "diner_sessions"
+}
+
+// Payment is a struct that contains the payment model
+type Payment struct {
+	ID        int64     `db:"id" example:"123"`
+	SessionID int64     `db:"session_id" example:"1"`
+	Subtotal  int       `db:"subtotal" example:"50050"`
+	Discount  int       `db:"discount" example:"2000"`
+	Amount    int       `db:"amount" example:"48050"`
+	PaidAt    time.Time `db:"paid_at" example:"2021-02-24 21:49:39"`
+}
+
+// TableName overrides the table name used by Payment to `payments`
+func (*Payment) TableName() string {
+	return "payments"
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	domainMenu "github.com/Raj63/golang-rest-api/pkg/domain/menu"
 )
@@ -10,19 +11,53 @@ import (
 type Menus interface {
 	GetTotalCount(ctx context.Context) (int64, error)
 	GetAll(ctx context.Context, page int64, limit int64) (*PaginationResultMenu, error)
+	GetPage(ctx context.Context, page *PageRequest) (*PaginationResultMenu, error)
 	Create(ctx context.Context, newMenu *domainMenu.Menu) (*domainMenu.Menu, error)
 	GetByID(ctx context.Context, id int64) (*domainMenu.Menu, error)
-	GetByTopCount(ctx context.Context, count int) ([]domainMenu.Menu, error)
+	GetByIDs(ctx context.Context, ids []int64) ([]domainMenu.Menu, error)
+	GetByTopCount(ctx context.Context, filter *TopMenuFilter) ([]domainMenu.Menu, error)
+	GetByNames(ctx context.Context, names []string) ([]domainMenu.Menu, error)
+	Upsert(ctx context.Context, menus []domainMenu.Menu) (*UpsertResultMenu, error)
+	Iterate(ctx context.Context, fn func(menu *domainMenu.Menu) error) error
 	Delete(ctx context.Context, id int64) (err error)
 }
 
-// PaginationResultMenu is a struct that contains the pagination result for menu
+// MenuTranslations specifies the repository contracts of the translations of the menus
+type MenuTranslations interface {
+	GetByMenuID(ctx context.Context, menuID int64) ([]domainMenu.Translation, error)
+	GetByMenuIDs(ctx context.Context, menuIDs []int64, languages []string) ([]domainMenu.Translation, error)
+	Upsert(ctx context.Context, translation *domainMenu.Translation) (*domainMenu.Translation, error)
+	Delete(ctx context.Context, menuID int64, language string) error
+}
+
+// UpsertResultMenu is a struct that contains how many menus an upsert created, updated or left unchanged, and the
+// menus created and updated with their id
+type UpsertResultMenu struct {
+	Created      int
+	Updated      int
+	Unchanged    int
+	CreatedMenus []domainMenu.Menu
+	UpdatedMenus []domainMenu.Menu
+}
+
+// PaginationResultMenu is a struct that contains the pagination result for menu. The cursors are opaque keyset
+// cursors, empty when there is no next or previous page. Total and NumPages are
+// not counted for keyset pages unless requested.
 type PaginationResultMenu struct {
 	Data       *[]domainMenu.Menu
 	Total      int64
 	Limit      int64
 	Current    int64
-	NextCursor uint
-	PrevCursor uint
+	NextCursor string
+	PrevCursor string
 	NumPages   int64
 }
+
+// TopMenuFilter is a struct that contains the criteria for ranking the top menus
+type TopMenuFilter struct {
+	Count           int
+	From            *time.Time
+	To              *time.Time
+	RankBy          string
+	GroupByCategory bool
+}
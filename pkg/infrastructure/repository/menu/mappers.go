@@ -1,14 +1,22 @@
 // Package menu contains the repository implementation for the menu entity
 package menu
 
-import domainMenu "github.com/Raj63/golang-rest-api/pkg/domain/menu"
+import (
+	"strconv"
+
+	domainMenu "github.com/Raj63/golang-rest-api/pkg/domain/menu"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/repository"
+)
 
 func (menu *Menu) toDomainMapper() *domainMenu.Menu {
 	return &domainMenu.Menu{
 		ID:          menu.ID,
 		Name:        menu.Name,
 		Description: menu.Description,
+		Category:    menu.Category,
 		Price:       float64(menu.Price) / 100, // We stored price as numeric value in MYSQL database hence to divide with 100 to get the actual decial points
+		Count:       menu.Count,
+		Revenue:     float64(menu.Revenue) / 100,
 		CreatedAt:   menu.CreatedAt,
 		UpdatedAt:   menu.UpdatedAt,
 	}
@@ -19,6 +27,7 @@ func fromDomainMapper(menu *domainMenu.Menu) *Menu {
 		ID:          menu.ID,
 		Name:        menu.Name,
 		Description: menu.Description,
+		Category:    menu.Category,
 		Price:       int(menu.Price * 100), // We store price as numeric value in MYSQL database hence to multiply with 100 to keep the decimal points
 		CreatedAt:   menu.CreatedAt,
 	}
@@ -32,3 +41,51 @@ func arrayToDomainMapper(menus *[]Menu) *[]domainMenu.Menu {
 
 	return &menusDomain
 }
+
+// sortKeys returns the values of the sort columns of the menu as cursor keys
+func (menu *Menu) sortKeys(sort []repository.SortField) []string {
+	keys := make([]string, len(sort))
+	for i, s := range sort {
+		switch s.Field {
+		case "id":
+			keys[i] = strconv.FormatInt(menu.ID, 10)
+		case "name":
+			keys[i] = menu.Name
+		case "category":
+			keys[i] = menu.Category
+		case "price":
+			keys[i] = strconv.Itoa(menu.Price)
+		case "created_at":
+			keys[i] = menu.CreatedAt.Format(repository.CursorTimeLayout)
+		}
+	}
+	return keys
+}
+
+func (translation *Translation) toDomainMapper() *domainMenu.Translation {
+	return &domainMenu.Translation{
+		MenuID:      translation.MenuID,
+		Language:    translation.Language,
+		Name:        translation.Name,
+		Description: translation.Description,
+		CreatedAt:   translation.CreatedAt,
+		UpdatedAt:   translation.UpdatedAt,
+	}
+}
+
+func fromDomainTranslationMapper(translation *domainMenu.Translation) *Translation {
+	return &Translation{
+		MenuID:      translation.MenuID,
+		Language:    translation.Language,
+		Name:        translation.Name,
+		Description: translation.Description,
+	}
+}
+
+func translationsToDomainMapper(translations []Translation) []domainMenu.Translation {
+	translationsDomain := make([]domainMenu.Translation, len(translations))
+	for i := range translations {
+		translationsDomain[i] = *translations[i].toDomainMapper()
+	}
+	return translationsDomain
+}
@@ -5,6 +5,8 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
+	"strings"
 
 	appErr "github.com/Raj63/golang-rest-api/pkg/domain/errors"
 	domainMenu "github.com/Raj63/golang-rest-api/pkg/domain/menu"
@@ -12,6 +14,7 @@ import (
 	"github.com/Raj63/golang-rest-api/pkg/infrastructure/repository"
 	sdksql "github.com/Raj63/golang-rest-api/pkg/infrastructure/sql"
 	"github.com/go-sql-driver/mysql"
+	"github.com/jmoiron/sqlx"
 )
 
 // Repository is a struct that contains the database implementation for menu entity
@@ -23,7 +26,7 @@ type Repository struct {
 // GetTotalCount Fetch total menu count
 func (r *Repository) GetTotalCount(ctx context.Context) (int64, error) {
 	var total int64
-	err := r.Store.DB().Get(&total, `SELECT count(id) FROM menus`)
+	err := r.Store.Querier(ctx).Get(&total, `SELECT count(id) FROM menus`)
 	if err != nil {
 		r.Logger.ErrorfContext(ctx, "error fetching total count: %v", err)
 		return 0, err
@@ -41,12 +44,12 @@ func (r *Repository) GetAll(ctx context.Context, page int64, limit int64) (*repo
 	offset := (page - 1) * limit
 
 	// Read menu from DB based on limit and offset
-	rows, err := r.Store.DB().Queryx(`
+	rows, err := r.Store.Querier(ctx).Queryx(`
 SELECT
-	id, name, description, price, created_at, updated_at 
+	id, name, description, category, price, created_at, updated_at 
 FROM menus
 ORDER BY
-	name ASC
+	name ASC, id ASC
 LIMIT ? OFFSET ?;`, limit, offset)
 	if err != nil {
 		return nil, err
@@ -63,12 +66,14 @@ LIMIT ? OFFSET ?;`, limit, offset)
 	}
 
 	numPages := (total + limit - 1) / limit
-	var nextCursor, prevCursor uint
-	if page < numPages {
-		nextCursor = uint(page + 1)
+	// the cursors let the client carry on with keyset pages from here
+	var nextCursor, prevCursor string
+	if len(menus) > 0 && page < numPages {
+		last := menus[len(menus)-1]
+		nextCursor = repository.Cursor{Keys: []string{last.Name}, ID: last.ID}.Encode()
 	}
-	if page > 1 {
-		prevCursor = uint(page - 1)
+	if len(menus) > 0 && page > 1 {
+		prevCursor = repository.Cursor{Keys: []string{menus[0].Name}, ID: menus[0].ID, Backward: true}.Encode()
 	}
 
 	return &repository.PaginationResultMenu{
@@ -82,16 +87,83 @@ LIMIT ? OFFSET ?;`, limit, offset)
 	}, nil
 }
 
+// GetPage Fetch a keyset page of sorted menus, which stays stable when menus are added between the pages
+func (r *Repository) GetPage(ctx context.Context, page *repository.PageRequest) (*repository.PaginationResultMenu, error) {
+	sort := page.Sort
+	if len(sort) == 0 {
+		sort = defaultSort
+	}
+	columns := make([]string, len(sort))
+	for i, s := range sort {
+		column, ok := sortColumns[s.Field]
+		if !ok {
+			return nil, appErr.NewAppError(fmt.Errorf("menus cannot be sorted by %s", s.Field), appErr.ValidationError)
+		}
+		columns[i] = column
+	}
+	if page.Cursor != nil && len(page.Cursor.Keys) != len(sort) {
+		return nil, appErr.NewAppError(repository.ErrInvalidCursor, appErr.ValidationError)
+	}
+
+	where, orderBy, args := repository.KeysetClause(columns, sort, page)
+	if where != "" {
+		where = "\nWHERE " + where
+	}
+
+	var menus []Menu
+	err := r.Store.Querier(ctx).SelectContext(ctx, &menus, `
+SELECT
+	id, name, description, category, price, created_at, updated_at
+FROM menus`+where+orderBy, args...)
+	if err != nil {
+		r.Logger.ErrorfContext(ctx, "error fetching menus page: %v", err)
+		return nil, err
+	}
+	menus, nextCursor, prevCursor := repository.KeysetPage(menus, page, func(menu Menu) ([]string, int64) {
+		return menu.sortKeys(sort), menu.ID
+	})
+
+	result := &repository.PaginationResultMenu{
+		Data:       arrayToDomainMapper(&menus),
+		Limit:      page.Limit,
+		NextCursor: nextCursor,
+		PrevCursor: prevCursor,
+	}
+	if page.WithTotal {
+		result.Total, err = r.GetTotalCount(ctx)
+		if err != nil {
+			return nil, err
+		}
+		result.NumPages = (result.Total + page.Limit - 1) / page.Limit
+	}
+	if page.Cursor == nil {
+		result.Current = page.Offset/page.Limit + 1
+	}
+	return result, nil
+}
+
+// defaultSort is the sort of the menus when none is requested
+var defaultSort = []repository.SortField{{Field: "name"}}
+
+// sortColumns maps the sortable fields to their columns, keeping the sort query param out of the SQL
+var sortColumns = map[string]string{
+	"id":         "id",
+	"name":       "name",
+	"category":   "category",
+	"price":      "price",
+	"created_at": "created_at",
+}
+
 // Create ... Insert New data
 func (r *Repository) Create(ctx context.Context, newMenu *domainMenu.Menu) (*domainMenu.Menu, error) {
 	menu := fromDomainMapper(newMenu)
 	// store into DB
-	tx, err := r.Store.DB().Beginx()
+	tx, err := r.Store.Begin(ctx)
 	if err != nil {
 		return nil, err
 	}
 	// Named queries can use structs, so if you have an existing struct (i.e. person := &Person{}) that you have populated, you can pass it in as &person
-	result, err := tx.NamedExecContext(ctx, "INSERT INTO menus (name, description, price, created_at, updated_at) VALUES (:name, :description, :price, NOW(), NOW());", menu)
+	result, err := tx.NamedExecContext(ctx, "INSERT INTO menus (name, description, category, price, created_at, updated_at) VALUES (:name, :description, :category, :price, NOW(), NOW());", menu)
 	if err != nil {
 		_ = tx.Rollback()
 		var mysqlErr *mysql.MySQLError
@@ -123,7 +195,7 @@ func (r *Repository) Create(ctx context.Context, newMenu *domainMenu.Menu) (*dom
 func (r *Repository) GetByID(ctx context.Context, id int64) (*domainMenu.Menu, error) {
 	var menu Menu
 
-	err := r.Store.DB().Get(&menu, `SELECT id, name, description, price, created_at, updated_at FROM menus WHERE id = ?;`, id)
+	err := r.Store.Querier(ctx).Get(&menu, `SELECT id, name, description, category, price, created_at, updated_at FROM menus WHERE id = ?;`, id)
 	if err != nil {
 		return nil, err
 	}
@@ -131,40 +203,284 @@ func (r *Repository) GetByID(ctx context.Context, id int64) (*domainMenu.Menu, e
 	return menu.toDomainMapper(), nil
 }
 
-// GetByTopCount ... Fetch only top menus by count
-func (r *Repository) GetByTopCount(ctx context.Context, count int) ([]domainMenu.Menu, error) {
+// GetByIDs ... Fetch many menus at once by their ids, the ids not found are left out
+func (r *Repository) GetByIDs(ctx context.Context, ids []int64) ([]domainMenu.Menu, error) {
 	var menus []Menu
+	if len(ids) == 0 {
+		return *arrayToDomainMapper(&menus), nil
+	}
 
-	err := r.Store.DB().Select(&menus, `SELECT m.id, m.name, m.description, m.price, SUM(o.quantity) AS count
+	query, args, err := sqlx.In(`SELECT id, name, description, category, price, created_at, updated_at FROM menus WHERE id IN (?);`, ids)
+	if err != nil {
+		return nil, err
+	}
+	err = r.Store.Querier(ctx).SelectContext(ctx, &menus, r.Store.Querier(ctx).Rebind(query), args...)
+	if err != nil {
+		r.Logger.ErrorfContext(ctx, "error fetching menus: %v", err)
+		return nil, err
+	}
+
+	return *arrayToDomainMapper(&menus), nil
+}
+
+// GetByNames ... Fetch many menus at once by their names, compared case-insensitively, the names not found are left out
+func (r *Repository) GetByNames(ctx context.Context, names []string) ([]domainMenu.Menu, error) {
+	var menus []Menu
+	if len(names) == 0 {
+		return *arrayToDomainMapper(&menus), nil
+	}
+
+	query, args, err := sqlx.In(`SELECT id, name, description, category, price, created_at, updated_at FROM menus WHERE name IN (?);`, names)
+	if err != nil {
+		return nil, err
+	}
+	err = r.Store.Querier(ctx).SelectContext(ctx, &menus, r.Store.Querier(ctx).Rebind(query), args...)
+	if err != nil {
+		r.Logger.ErrorfContext(ctx, "error fetching menus: %v", err)
+		return nil, err
+	}
+
+	return *arrayToDomainMapper(&menus), nil
+}
+
+// Upsert ... Insert the new menus and update the description, category and price of the menus whose name exists,
+// all in one transaction so that nothing is written when any menu fails. The menus created and updated are returned
+// with their id, and the days of the orders of the menus whose price changed are marked for the sales aggregates to be
+// refreshed.
+func (r *Repository) Upsert(ctx context.Context, menus []domainMenu.Menu) (*repository.UpsertResultMenu, error) {
+	tx, err := r.Store.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// the prices are locked until the days are marked, so that no order is aggregated on a price being replaced
+	prices := make(map[string]int, len(menus))
+	if len(menus) > 0 {
+		names := make([]string, len(menus))
+		for i := range menus {
+			names[i] = menus[i].Name
+		}
+		query, args, err := sqlx.In(`SELECT name, price FROM menus WHERE name IN (?) FOR UPDATE;`, names)
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+		var existing []Menu
+		if err := tx.SelectContext(ctx, &existing, tx.Rebind(query), args...); err != nil {
+			_ = tx.Rollback()
+			r.Logger.ErrorfContext(ctx, "error fetching the prices of the menus: %v", err)
+			return nil, err
+		}
+		for _, menu := range existing {
+			prices[menu.Name] = menu.Price
+		}
+	}
+
+	stmt, err := tx.PrepareNamedContext(ctx, `
+INSERT INTO menus (name, description, category, price, created_at, updated_at)
+VALUES (:name, :description, :category, :price, NOW(), NOW())
+ON DUPLICATE KEY UPDATE
+	id = LAST_INSERT_ID(id), description = VALUES(description), category = VALUES(category), price = VALUES(price);`)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	defer stmt.Close()
+
+	var upserted repository.UpsertResultMenu
+	var repriced []int64
+	for i := range menus {
+		row := fromDomainMapper(&menus[i])
+		result, err := stmt.ExecContext(ctx, row)
+		if err != nil {
+			_ = tx.Rollback()
+			r.Logger.ErrorfContext(ctx, "error upserting menu %s: %v", menus[i].Name, err)
+			return nil, err
+		}
+		affectedRows, err := result.RowsAffected()
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+		// the id of the menu, inserted or updated, is the last insert id thanks to LAST_INSERT_ID(id)
+		id, err := result.LastInsertId()
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+		menu := menus[i]
+		menu.ID = id
+		// MySQL counts 1 row for an insert, 2 for an update and 0 when the values are unchanged
+		switch affectedRows {
+		case 1:
+			upserted.Created++
+			upserted.CreatedMenus = append(upserted.CreatedMenus, menu)
+		case 2:
+			upserted.Updated++
+			upserted.UpdatedMenus = append(upserted.UpdatedMenus, menu)
+			if price, ok := prices[menu.Name]; ok && price != row.Price {
+				repriced = append(repriced, id)
+			}
+		default:
+			upserted.Unchanged++
+		}
+	}
+
+	// the aggregates of the days already refreshed are computed on the old price
+	if len(repriced) > 0 {
+		query, args, err := sqlx.In(`
+		INSERT INTO sales_dirty_days (day, marked_at)
+		SELECT DISTINCT DATE(created_at), NOW() FROM orders WHERE menu_id IN (?)
+		ON DUPLICATE KEY UPDATE marked_at = NOW();`, repriced)
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+		if _, err := tx.ExecContext(ctx, tx.Rebind(query), args...); err != nil {
+			_ = tx.Rollback()
+			r.Logger.ErrorfContext(ctx, "error marking the days of the repriced menus. error: %+v, param: %+v\n", err, repriced)
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		_ = tx.Rollback()
+		r.Logger.ErrorfContext(ctx, "Commit failed: %v", err)
+		return nil, err
+	}
+	return &upserted, nil
+}
+
+// Iterate ... Read every menu sorted by name one row at a time, stopping at the first error of fn
+func (r *Repository) Iterate(ctx context.Context, fn func(menu *domainMenu.Menu) error) error {
+	rows, err := r.Store.Querier(ctx).QueryxContext(ctx, `
+SELECT
+	id, name, description, category, price, created_at, updated_at
+FROM menus
+ORDER BY
+	name ASC, id ASC;`)
+	if err != nil {
+		r.Logger.ErrorfContext(ctx, "error reading menus: %v", err)
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var menu Menu
+		if err := rows.StructScan(&menu); err != nil {
+			return err
+		}
+		if err := fn(menu.toDomainMapper()); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// GetByTopCount ... Fetch only top menus by the quantity ordered or the revenue earned, optionally within a time window
+// and ranked within each category. Revenue is computed on the current price of the menu.
+func (r *Repository) GetByTopCount(ctx context.Context, filter *repository.TopMenuFilter) ([]domainMenu.Menu, error) {
+	var menus []Menu
+
+	var conditions []string
+	var args []interface{}
+	if filter.From != nil {
+		conditions = append(conditions, "o.created_at >= ?")
+		args = append(args, *filter.From)
+	}
+	if filter.To != nil {
+		conditions = append(conditions, "o.created_at < ?")
+		args = append(args, *filter.To)
+	}
+	where := ""
+	if len(conditions) > 0 {
+		where = "WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	rank := "count"
+	if filter.RankBy == domainMenu.RankByRevenue {
+		rank = "revenue"
+	}
+	orderBy := rank + " DESC, m.name ASC"
+	limit := ""
+	if filter.GroupByCategory {
+		// the ranking is cut per category below as MySQL 5.7 has no window functions
+		orderBy = "m.category ASC, " + orderBy
+	} else {
+		limit = "LIMIT ?"
+		args = append(args, filter.Count)
+	}
+
+	err := r.Store.Querier(ctx).SelectContext(ctx, &menus, `
+	SELECT
+		m.id, m.name, m.description, m.category, m.price,
+		SUM(o.quantity) AS count,
+		SUM(o.quantity * m.price) AS revenue
 	FROM menus m
 	JOIN orders o ON m.id = o.menu_id
-	GROUP BY m.id, m.name
-	ORDER BY count DESC
-	LIMIT ?;`, count)
+	`+where+`
+	GROUP BY m.id, m.name, m.description, m.category, m.price
+	ORDER BY `+orderBy+`
+	`+limit+`;`, args...)
 	if err != nil {
+		r.Logger.ErrorfContext(ctx, "error fetching top menus: %v", err)
 		return nil, err
 	}
 
+	if filter.GroupByCategory {
+		ranked := make([]Menu, 0, len(menus))
+		perCategory := map[string]int{}
+		for _, menu := range menus {
+			if perCategory[menu.Category] < filter.Count {
+				perCategory[menu.Category]++
+				ranked = append(ranked, menu)
+			}
+		}
+		menus = ranked
+	}
+
 	return *arrayToDomainMapper(&menus), nil
 }
 
-// Delete ... Delete menu
+// Delete ... Delete menu, marking the days of its orders for the sales aggregates to be refreshed
 func (r *Repository) Delete(ctx context.Context, id int64) (err error) {
-	result, err := r.Store.DB().ExecContext(ctx, `
+	tx, err := r.Store.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	// the orders are gone once deleted, so their days are marked beforehand
+	if _, err := tx.ExecContext(ctx, `
+	INSERT INTO sales_dirty_days (day, marked_at)
+	SELECT DISTINCT DATE(created_at), NOW() FROM orders WHERE menu_id = ?
+	ON DUPLICATE KEY UPDATE marked_at = NOW();`, id); err != nil {
+		_ = tx.Rollback()
+		r.Logger.ErrorfContext(ctx, "error marking the days of the deleted orders. error: %+v, param: %+v\n", err, id)
+		return err
+	}
+
+	result, err := tx.ExecContext(ctx, `
 	DELETE FROM
 		menus
 	WHERE id = ?;
 	`, id)
 	if err != nil {
+		_ = tx.Rollback()
 		r.Logger.ErrorfContext(ctx, "error when executing query. error: %+v, param: %+v\n", err, id)
 		return err
 	}
 
 	rowAffected, errGetAffectedRow := result.RowsAffected()
 	if errGetAffectedRow != nil || rowAffected == 0 {
+		_ = tx.Rollback()
 		r.Logger.Errorf("error when get affected row. error: %+v", errGetAffectedRow)
 		return appErr.NewAppErrorWithType(appErr.NotFound)
 	}
 
+	if err := tx.Commit(); err != nil {
+		_ = tx.Rollback()
+		r.Logger.ErrorfContext(ctx, "Commit failed: %v", err)
+		return err
+	}
 	return nil
 }
@@ -10,13 +10,30 @@ type Menu struct {
 	ID          int64     `db:"id" example:"123"`
 	Name        string    `db:"name" example:"Hyderabadi Dum Briyani"`
 	Description string    `db:"description" example:"Some Description"`
+	Category    string    `db:"category" example:"briyani"`
 	Price       int       `db:"price" example:"20050"`
 	CreatedAt   time.Time `db:"created_at" example:"2021-02-24 20:19:39"`
 	UpdatedAt   time.Time `db:"updated_at" example:"2021-02-24 20:19:39"`
 	Count       int       `db:"count" example:"3"`
+	Revenue     int64     `db:"revenue" example:"60150"`
 }
 
 // TableName overrides the table name used by User to `users`
 func (*Menu) TableName() string {
 	return "menus"
 }
+
+// Translation is a struct that contains the menu translation model
+type Translation struct {
+	MenuID      int64     `db:"menu_id" example:"123"`
+	Language    string    `db:"language" example:"fr"`
+	Name        string    `db:"name" example:"Biryani Dum d'Hyderabad"`
+	Description string    `db:"description" example:"Riz parfumé cuit à l'étouffée"`
+	CreatedAt   time.Time `db:"created_at" example:"2021-02-24 20:19:39"`
+	UpdatedAt   time.Time `db:"updated_at" example:"2021-02-24 20:19:39"`
+}
+
+// TableName overrides the table name used by Translation to `menu_translations`
+func (*Translation) TableName() string {
+	return "menu_translations"
+}
@@ -0,0 +1,152 @@
+// Package menu contains the repository implementation for the menu entity
+package menu
+
+import (
+	"context"
+	"errors"
+
+	appErr "github.com/Raj63/golang-rest-api/pkg/domain/errors"
+	domainMenu "github.com/Raj63/golang-rest-api/pkg/domain/menu"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/logger"
+	sdksql "github.com/Raj63/golang-rest-api/pkg/infrastructure/sql"
+	"github.com/go-sql-driver/mysql"
+	"github.com/jmoiron/sqlx"
+)
+
+// TranslationRepository is a struct that contains the database implementation for the translations of the menus
+type TranslationRepository struct {
+	Store  *sdksql.DB
+	Logger *logger.Logger
+}
+
+// GetByMenuID ... Fetch every translation of a menu, sorted by language
+func (r *TranslationRepository) GetByMenuID(ctx context.Context, menuID int64) ([]domainMenu.Translation, error) {
+	var translations []Translation
+	err := r.Store.Querier(ctx).SelectContext(ctx, &translations, `
+SELECT
+	menu_id, language, name, description, created_at, updated_at
+FROM menu_translations
+WHERE menu_id = ?
+ORDER BY language ASC;`, menuID)
+	if err != nil {
+		r.Logger.ErrorfContext(ctx, "error fetching menu translations: %v", err)
+		return nil, err
+	}
+
+	return translationsToDomainMapper(translations), nil
+}
+
+// GetByMenuIDs ... Fetch the translations of many menus at once in any of the given languages
+func (r *TranslationRepository) GetByMenuIDs(ctx context.Context, menuIDs []int64, languages []string) ([]domainMenu.Translation, error) {
+	var translations []Translation
+	if len(menuIDs) == 0 || len(languages) == 0 {
+		return translationsToDomainMapper(translations), nil
+	}
+
+	query, args, err := sqlx.In(`
+SELECT
+	menu_id, language, name, description, created_at, updated_at
+FROM menu_translations
+WHERE menu_id IN (?) AND language IN (?);`, menuIDs, languages)
+	if err != nil {
+		return nil, err
+	}
+	err = r.Store.Querier(ctx).SelectContext(ctx, &translations, r.Store.Querier(ctx).Rebind(query), args...)
+	if err != nil {
+		r.Logger.ErrorfContext(ctx, "error fetching menu translations: %v", err)
+		return nil, err
+	}
+
+	return translationsToDomainMapper(translations), nil
+}
+
+// Upsert ... Insert the translation of a menu in a language, or replace its name and description when there is one.
+// The menu is marked as updated, its localised responses having changed.
+func (r *TranslationRepository) Upsert(ctx context.Context, translation *domainMenu.Translation) (*domainMenu.Translation, error) {
+	model := fromDomainTranslationMapper(translation)
+
+	tx, err := r.Store.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	_, err = tx.NamedExecContext(ctx, `
+INSERT INTO menu_translations (menu_id, language, name, description, created_at, updated_at)
+VALUES (:menu_id, :language, :name, :description, NOW(), NOW())
+ON DUPLICATE KEY UPDATE name = VALUES(name), description = VALUES(description), updated_at = NOW();`, model)
+	if err != nil {
+		_ = tx.Rollback()
+		var mysqlErr *mysql.MySQLError
+		// the menu was deleted in between
+		if errors.As(err, &mysqlErr) && mysqlErr.Number == 1452 {
+			return nil, appErr.NewAppErrorWithType(appErr.NotFound)
+		}
+		r.Logger.ErrorfContext(ctx, "error when executing query. error: %+v, param: %+v\n", err, model)
+		return nil, err
+	}
+	if err := r.touchMenu(ctx, tx, model.MenuID); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	err = tx.GetContext(ctx, model, `
+SELECT
+	menu_id, language, name, description, created_at, updated_at
+FROM menu_translations
+WHERE menu_id = ? AND language = ?;`, model.MenuID, model.Language)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		_ = tx.Rollback()
+		r.Logger.ErrorfContext(ctx, "Commit failed: %v", err)
+		return nil, err
+	}
+	return model.toDomainMapper(), nil
+}
+
+// Delete ... Remove the translation of a menu in a language. The menu is marked as updated, its localised responses
+// falling back to another language.
+func (r *TranslationRepository) Delete(ctx context.Context, menuID int64, language string) error {
+	tx, err := r.Store.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	result, err := tx.ExecContext(ctx, `
+	DELETE FROM
+		menu_translations
+	WHERE menu_id = ? AND language = ?;
+	`, menuID, language)
+	if err != nil {
+		_ = tx.Rollback()
+		r.Logger.ErrorfContext(ctx, "error when executing query. error: %+v, param: %+v %+v\n", err, menuID, language)
+		return err
+	}
+
+	rowAffected, errGetAffectedRow := result.RowsAffected()
+	if errGetAffectedRow != nil || rowAffected == 0 {
+		_ = tx.Rollback()
+		return appErr.NewAppErrorWithType(appErr.NotFound)
+	}
+	if err := r.touchMenu(ctx, tx, menuID); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		_ = tx.Rollback()
+		r.Logger.ErrorfContext(ctx, "Commit failed: %v", err)
+		return err
+	}
+	return nil
+}
+
+// touchMenu sets the updated_at of a menu, which the Last-Modified of its responses is taken from, to now
+func (r *TranslationRepository) touchMenu(ctx context.Context, tx sdksql.Tx, menuID int64) error {
+	_, err := tx.ExecContext(ctx, `UPDATE menus SET updated_at = NOW() WHERE id = ?;`, menuID)
+	if err != nil {
+		r.Logger.ErrorfContext(ctx, "error marking the menu %d as updated: %v", menuID, err)
+	}
+	return err
+}
@@ -10,5 +10,8 @@ import (
 type Orders interface {
 	Create(ctx context.Context, newOrder *domainOrder.Request) (*domainOrder.Request, error)
 	GetByID(ctx context.Context, dinerID int64) ([]domainOrder.Response, error)
+	GetByCurrentSession(ctx context.Context, dinerID int64) ([]domainOrder.Response, error)
+	GetByDinerIDs(ctx context.Context, dinerIDs []int64, history bool) ([]domainOrder.Response, error)
+	Serve(ctx context.Context, id int64) error
 	Delete(ctx context.Context, id int) (err error)
 }
@@ -23,10 +23,14 @@ func fromDomainMapper(order *domainOrder.Request) *Request {
 func (order *Response) toDomainMapper() *domainOrder.Response {
 	return &domainOrder.Response{
 		ID:              order.ID,
+		SessionID:       order.SessionID,
+		DinerID:         order.DinerID,
+		MenuID:          order.MenuID,
 		DinnerName:      order.DinnerName,
 		MenuName:        order.MenuName,
 		MenuDescription: order.MenuDescription,
 		Quantity:        order.Quantity,
+		ServedAt:        order.ServedAt,
 		CreatedAt:       order.CreatedAt,
 		UpdatedAt:       order.UpdatedAt,
 	}
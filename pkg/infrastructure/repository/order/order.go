@@ -3,7 +3,6 @@ package order
 
 import (
 	"context"
-	"database/sql"
 	"errors"
 
 	appErr "github.com/Raj63/golang-rest-api/pkg/domain/errors"
@@ -11,6 +10,7 @@ import (
 	"github.com/Raj63/golang-rest-api/pkg/infrastructure/logger"
 	sdksql "github.com/Raj63/golang-rest-api/pkg/infrastructure/sql"
 	"github.com/go-sql-driver/mysql"
+	"github.com/jmoiron/sqlx"
 )
 
 // Repository is a struct that contains the database implementation for order entity
@@ -19,16 +19,20 @@ type Repository struct {
 	Logger *logger.Logger
 }
 
-// Create ... Insert New data
+// Create ... Insert New data into the open session of the diner
 func (r *Repository) Create(ctx context.Context, newOrder *domainOrder.Request) (*domainOrder.Request, error) {
 	order := fromDomainMapper(newOrder)
 	// store into DB
-	tx, err := r.Store.DB().Beginx()
+	tx, err := r.Store.Begin(ctx)
 	if err != nil {
 		return nil, err
 	}
 	// Named queries can use structs, so if you have an existing struct (i.e. person := &Person{}) that you have populated, you can pass it in as &person
-	result, err := tx.NamedExecContext(ctx, "INSERT INTO orders (diner_id, menu_id, quantity, created_at, updated_at) VALUES (:diner_id, :menu_id, :quantity, NOW(), NOW());", order)
+	result, err := tx.NamedExecContext(ctx, `
+	INSERT INTO orders (diner_id, session_id, menu_id, quantity, created_at, updated_at)
+	SELECT s.diner_id, s.id, :menu_id, :quantity, NOW(), NOW()
+	FROM diner_sessions s
+	WHERE s.diner_id = :diner_id AND s.checked_out_at IS NULL;`, order)
 	if err != nil {
 		_ = tx.Rollback()
 		var mysqlErr *mysql.MySQLError
@@ -46,7 +50,13 @@ func (r *Repository) Create(ctx context.Context, newOrder *domainOrder.Request)
 
 	if affectedRows == 0 {
 		_ = tx.Rollback()
-		return nil, sql.ErrNoRows
+		return nil, appErr.NewAppError(errors.New("diner has no open dining session"), appErr.ValidationError)
+	}
+
+	order.ID, err = result.LastInsertId()
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
 	}
 	if err := tx.Commit(); err != nil {
 		_ = tx.Rollback()
@@ -56,17 +66,21 @@ func (r *Repository) Create(ctx context.Context, newOrder *domainOrder.Request)
 	return order.toDomainMapper(), nil
 }
 
-// GetByID ... Fetch only one order by Id
+// GetByID ... Fetch all the orders of a diner across sessions
 func (r *Repository) GetByID(ctx context.Context, dinerID int64) ([]domainOrder.Response, error) {
 	var orders []Response
 
-	err := r.Store.DB().Select(&orders, `
+	err := r.Store.Querier(ctx).Select(&orders, `
 	SELECT 
 		o.id,
+		COALESCE(o.session_id, 0) AS session_id,
+		o.diner_id,
+		o.menu_id,
 		d.name as diner_name,
 		m.name as menu_name, 
 		m.description as menu_description,
 		o.quantity,
+		o.served_at,
 		o.created_at 
 	FROM orders o 
 	INNER JOIN menus m 
@@ -81,23 +95,151 @@ func (r *Repository) GetByID(ctx context.Context, dinerID int64) ([]domainOrder.
 	return arrayToDomainMapper(&orders), nil
 }
 
-// Delete ... Delete order
+// GetByCurrentSession ... Fetch the orders of the open session of a diner
+func (r *Repository) GetByCurrentSession(ctx context.Context, dinerID int64) ([]domainOrder.Response, error) {
+	var orders []Response
+
+	err := r.Store.Querier(ctx).SelectContext(ctx, &orders, `
+	SELECT
+		o.id,
+		o.session_id,
+		o.diner_id,
+		o.menu_id,
+		d.name as diner_name,
+		m.name as menu_name,
+		m.description as menu_description,
+		o.quantity,
+		o.served_at,
+		o.created_at
+	FROM orders o
+	INNER JOIN menus m
+		ON o.menu_id = m.id
+	INNER JOIN diners d
+		ON o.diner_id = d.id
+	INNER JOIN diner_sessions s
+		ON o.session_id = s.id
+	WHERE d.id = ? AND s.checked_out_at IS NULL;`, dinerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return arrayToDomainMapper(&orders), nil
+}
+
+// GetByDinerIDs ... Fetch the orders of the open sessions of many diners at once, or of every session of the diners
+// when history is requested
+func (r *Repository) GetByDinerIDs(ctx context.Context, dinerIDs []int64, history bool) ([]domainOrder.Response, error) {
+	var orders []Response
+	if len(dinerIDs) == 0 {
+		return arrayToDomainMapper(&orders), nil
+	}
+
+	sessionJoin := "LEFT JOIN diner_sessions s\n\t\tON o.session_id = s.id"
+	if !history {
+		sessionJoin = "INNER JOIN diner_sessions s\n\t\tON o.session_id = s.id AND s.checked_out_at IS NULL"
+	}
+	query, args, err := sqlx.In(`
+	SELECT
+		o.id,
+		COALESCE(o.session_id, 0) AS session_id,
+		o.diner_id,
+		o.menu_id,
+		d.name as diner_name,
+		m.name as menu_name,
+		m.description as menu_description,
+		o.quantity,
+		o.served_at,
+		o.created_at
+	FROM orders o
+	INNER JOIN menus m
+		ON o.menu_id = m.id
+	INNER JOIN diners d
+		ON o.diner_id = d.id
+	`+sessionJoin+`
+	WHERE d.id IN (?)
+	ORDER BY o.diner_id ASC, o.id ASC;`, dinerIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.Store.Querier(ctx).SelectContext(ctx, &orders, r.Store.Querier(ctx).Rebind(query), args...)
+	if err != nil {
+		r.Logger.ErrorfContext(ctx, "error fetching orders of diners: %v", err)
+		return nil, err
+	}
+
+	return arrayToDomainMapper(&orders), nil
+}
+
+// Serve ... Record that an order has been served
+func (r *Repository) Serve(ctx context.Context, id int64) error {
+	result, err := r.Store.Querier(ctx).ExecContext(ctx, `
+	UPDATE orders
+	SET served_at = NOW()
+	WHERE id = ? AND served_at IS NULL;`, id)
+	if err != nil {
+		r.Logger.ErrorfContext(ctx, "error when executing query. error: %+v, param: %+v\n", err, id)
+		return err
+	}
+
+	rowAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowAffected > 0 {
+		return nil
+	}
+
+	var exists int
+	err = r.Store.Querier(ctx).GetContext(ctx, &exists, `SELECT COUNT(id) FROM orders WHERE id = ?;`, id)
+	if err != nil {
+		return err
+	}
+	if exists == 0 {
+		return appErr.NewAppErrorWithType(appErr.NotFound)
+	}
+	return appErr.NewAppError(errors.New("order is already served"), appErr.ResourceAlreadyExists)
+}
+
+// Delete ... Delete order, marking its day for the sales aggregates to be refreshed
 func (r *Repository) Delete(ctx context.Context, id int) (err error) {
-	result, err := r.Store.DB().ExecContext(ctx, `
+	tx, err := r.Store.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	// the orders are gone once deleted, so their days are marked beforehand
+	if _, err := tx.ExecContext(ctx, `
+	INSERT INTO sales_dirty_days (day, marked_at)
+	SELECT DISTINCT DATE(created_at), NOW() FROM orders WHERE id = ?
+	ON DUPLICATE KEY UPDATE marked_at = NOW();`, id); err != nil {
+		_ = tx.Rollback()
+		r.Logger.ErrorfContext(ctx, "error marking the days of the deleted orders. error: %+v, param: %+v\n", err, id)
+		return err
+	}
+
+	result, err := tx.ExecContext(ctx, `
 	DELETE FROM
 		orders
 	WHERE id = ?;
 	`, id)
 	if err != nil {
+		_ = tx.Rollback()
 		r.Logger.ErrorfContext(ctx, "error when executing query. error: %+v, param: %+v\n", err, id)
 		return err
 	}
 
 	rowAffected, errGetAffectedRow := result.RowsAffected()
 	if errGetAffectedRow != nil || rowAffected == 0 {
+		_ = tx.Rollback()
 		r.Logger.Errorf("error when get affected row. error: %+v", errGetAffectedRow)
 		return appErr.NewAppErrorWithType(appErr.NotFound)
 	}
 
+	if err := tx.Commit(); err != nil {
+		_ = tx.Rollback()
+		r.Logger.ErrorfContext(ctx, "Commit failed: %v", err)
+		return err
+	}
 	return nil
 }
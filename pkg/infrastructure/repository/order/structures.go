@@ -16,11 +16,15 @@ type Request struct {
 
 // Response is a struct that contains the response order information
 type Response struct {
-	ID              int64     `db:"id" example:"123"`
-	DinnerName      string    `db:"diner_name" example:"Mr. Smith"`
-	MenuName        string    `db:"menu_name" example:"HCDB"`
-	MenuDescription string    `db:"menu_description" example:"Hyderabadi Chicken Dum Briyani"`
-	Quantity        int       `db:"quantity" example:"2"`
-	CreatedAt       time.Time `db:"created_at" example:"2021-02-24 20:19:39"`
-	UpdatedAt       time.Time `db:"updated_at" example:"2021-02-24 20:19:39"`
+	ID              int64      `db:"id" example:"123"`
+	SessionID       int64      `db:"session_id" example:"1"`
+	DinerID         int64      `db:"diner_id" example:"1"`
+	MenuID          int64      `db:"menu_id" example:"3"`
+	DinnerName      string     `db:"diner_name" example:"Mr. Smith"`
+	MenuName        string     `db:"menu_name" example:"HCDB"`
+	MenuDescription string     `db:"menu_description" example:"Hyderabadi Chicken Dum Briyani"`
+	Quantity        int        `db:"quantity" example:"2"`
+	ServedAt        *time.Time `db:"served_at" example:"2021-02-24 20:19:39"`
+	CreatedAt       time.Time  `db:"created_at" example:"2021-02-24 20:19:39"`
+	UpdatedAt       time.Time  `db:"updated_at" example:"2021-02-24 20:19:39"`
 }
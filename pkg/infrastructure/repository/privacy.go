@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	domainDiner "github.com/Raj63/golang-rest-api/pkg/domain/diner"
+	domainPrivacy "github.com/Raj63/golang-rest-api/pkg/domain/privacy"
+)
+
+// PrivacyRequests specifies the repository contracts
+type PrivacyRequests interface {
+	Create(ctx context.Context, newRequest *domainPrivacy.Request) (*domainPrivacy.Request, error)
+	GetByDinerID(ctx context.Context, dinerID int64) ([]domainPrivacy.Request, error)
+	GetPayments(ctx context.Context, dinerID int64) ([]domainDiner.Payment, error)
+	GetCustomerIDs(ctx context.Context, dinerID int64) ([]int64, error)
+	Anonymise(ctx context.Context, dinerID int64, newRequest *domainPrivacy.Request) (*domainPrivacy.Request, error)
+}
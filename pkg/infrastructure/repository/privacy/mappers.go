@@ -0,0 +1,60 @@
+// Package privacy contains the repository implementation for the personal data requests of diners
+package privacy
+
+import (
+	domainDiner "github.com/Raj63/golang-rest-api/pkg/domain/diner"
+	domainPrivacy "github.com/Raj63/golang-rest-api/pkg/domain/privacy"
+)
+
+func (request *Request) toDomainMapper() *domainPrivacy.Request {
+	return &domainPrivacy.Request{
+		ID:          request.ID,
+		DinerID:     request.DinerID,
+		Kind:        request.Kind,
+		RequestedBy: request.RequestedBy,
+		Reason:      request.Reason,
+		Channel:     request.Channel,
+		CreatedAt:   request.CreatedAt,
+	}
+}
+
+func fromDomainMapper(request *domainPrivacy.Request) *Request {
+	return &Request{
+		ID:          request.ID,
+		DinerID:     request.DinerID,
+		Kind:        request.Kind,
+		RequestedBy: request.RequestedBy,
+		Reason:      request.Reason,
+		Channel:     request.Channel,
+		CreatedAt:   request.CreatedAt,
+	}
+}
+
+func arrayToDomainMapper(requests *[]Request) []domainPrivacy.Request {
+	requestsDomain := make([]domainPrivacy.Request, len(*requests))
+	for i, request := range *requests {
+		requestsDomain[i] = *request.toDomainMapper()
+	}
+
+	return requestsDomain
+}
+
+func (payment *Payment) toDomainMapper() *domainDiner.Payment {
+	return &domainDiner.Payment{
+		ID:        payment.ID,
+		SessionID: payment.SessionID,
+		Subtotal:  float64(payment.Subtotal) / 100,
+		Discount:  float64(payment.Discount) / 100,
+		Amount:    float64(payment.Amount) / 100, // We stored amount as numeric value in MYSQL database hence to divide with 100 to get the actual decial points
+		PaidAt:    payment.PaidAt,
+	}
+}
+
+func paymentsToDomainMapper(payments *[]Payment) []domainDiner.Payment {
+	paymentsDomain := make([]domainDiner.Payment, len(*payments))
+	for i, payment := range *payments {
+		paymentsDomain[i] = *payment.toDomainMapper()
+	}
+
+	return paymentsDomain
+}
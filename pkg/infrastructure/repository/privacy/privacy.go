@@ -0,0 +1,196 @@
+// Package privacy contains the repository implementation for the personal data requests of diners
+package privacy
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	domainDiner "github.com/Raj63/golang-rest-api/pkg/domain/diner"
+	appErr "github.com/Raj63/golang-rest-api/pkg/domain/errors"
+	domainPrivacy "github.com/Raj63/golang-rest-api/pkg/domain/privacy"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/logger"
+	sdksql "github.com/Raj63/golang-rest-api/pkg/infrastructure/sql"
+)
+
+// Repository is a struct that contains the database implementation for privacy requests
+type Repository struct {
+	Store  *sdksql.DB
+	Logger *logger.Logger
+}
+
+// Create ... Insert the audit record of a privacy request
+func (r *Repository) Create(ctx context.Context, newRequest *domainPrivacy.Request) (*domainPrivacy.Request, error) {
+	request := fromDomainMapper(newRequest)
+	result, err := r.Store.Querier(ctx).NamedExecContext(ctx, insertRequestQuery, request)
+	if err != nil {
+		r.Logger.ErrorfContext(ctx, "error recording privacy request: %v", err)
+		return nil, err
+	}
+
+	request.ID, err = result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+
+	request.CreatedAt = time.Now()
+	return request.toDomainMapper(), nil
+}
+
+// GetByDinerID ... Fetch the privacy requests made about a diner, latest first
+func (r *Repository) GetByDinerID(ctx context.Context, dinerID int64) ([]domainPrivacy.Request, error) {
+	var requests []Request
+
+	err := r.Store.Querier(ctx).SelectContext(ctx, &requests, `
+	SELECT
+		id, diner_id, kind, requested_by, reason, channel, created_at, updated_at
+	FROM privacy_requests
+	WHERE diner_id = ?
+	ORDER BY
+		created_at DESC, id DESC;`, dinerID)
+	if err != nil {
+		r.Logger.ErrorfContext(ctx, "error fetching privacy requests: %v", err)
+		return nil, err
+	}
+
+	return arrayToDomainMapper(&requests), nil
+}
+
+// GetPayments ... Fetch the bills paid by a diner over all their sessions
+func (r *Repository) GetPayments(ctx context.Context, dinerID int64) ([]domainDiner.Payment, error) {
+	var payments []Payment
+
+	err := r.Store.Querier(ctx).SelectContext(ctx, &payments, `
+	SELECT
+		p.id, p.session_id, p.subtotal, p.discount, p.amount, p.paid_at
+	FROM payments p
+	INNER JOIN diner_sessions s
+		ON p.session_id = s.id
+	WHERE s.diner_id = ?
+	ORDER BY
+		p.paid_at ASC, p.id ASC;`, dinerID)
+	if err != nil {
+		r.Logger.ErrorfContext(ctx, "error fetching diner payments: %v", err)
+		return nil, err
+	}
+
+	return paymentsToDomainMapper(&payments), nil
+}
+
+// GetCustomerIDs ... Fetch the customer profiles linked to any session of a diner
+func (r *Repository) GetCustomerIDs(ctx context.Context, dinerID int64) ([]int64, error) {
+	var customerIDs []int64
+
+	err := r.Store.Querier(ctx).SelectContext(ctx, &customerIDs, `
+	SELECT DISTINCT
+		customer_id
+	FROM diner_sessions
+	WHERE diner_id = ? AND customer_id IS NOT NULL
+	ORDER BY
+		customer_id ASC;`, dinerID)
+	if err != nil {
+		r.Logger.ErrorfContext(ctx, "error fetching diner customers: %v", err)
+		return nil, err
+	}
+
+	return customerIDs, nil
+}
+
+// Anonymise ... Replace the personal data of a diner, of the customer profiles linked to them and of the reservations
+// and waitlist entries made in their name or with the contact of those profiles with placeholders and record the
+// request. Sessions, orders, payments and the loyalty ledger are kept for accounting.
+func (r *Repository) Anonymise(ctx context.Context, dinerID int64, newRequest *domainPrivacy.Request) (*domainPrivacy.Request, error) {
+	request := fromDomainMapper(newRequest)
+
+	tx, err := r.Store.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var locked int64
+	err = tx.GetContext(ctx, &locked, `SELECT id FROM diners WHERE id = ? FOR UPDATE;`, dinerID)
+	if err != nil {
+		_ = tx.Rollback()
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, appErr.NewAppErrorWithType(appErr.NotFound)
+		}
+		return nil, err
+	}
+
+	if err := anonymise(ctx, tx, dinerID); err != nil {
+		_ = tx.Rollback()
+		r.Logger.ErrorfContext(ctx, "error anonymising diner: %v", err)
+		return nil, err
+	}
+
+	result, err := tx.NamedExecContext(ctx, insertRequestQuery, request)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	request.ID, err = result.LastInsertId()
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		_ = tx.Rollback()
+		r.Logger.ErrorfContext(ctx, "Commit failed: %v", err)
+		return nil, err
+	}
+
+	request.CreatedAt = time.Now()
+	return request.toDomainMapper(), nil
+}
+
+const insertRequestQuery = `
+INSERT INTO privacy_requests (diner_id, kind, requested_by, reason, channel, created_at, updated_at)
+VALUES (:diner_id, :kind, :requested_by, :reason, :channel, NOW(), NOW());`
+
+// guestTables are the tables the bookings of a guest are kept in, by their name and contact only
+var guestTables = []string{"reservations", "waitlist_entries"}
+
+func anonymise(ctx context.Context, tx sdksql.Tx, dinerID int64) error {
+	// the bookings are matched on the name and contacts of the diner, so they are scrubbed before those are
+	for _, table := range guestTables {
+		if _, err := tx.ExecContext(ctx, fmt.Sprintf(`
+	UPDATE %[1]s g
+	SET
+		g.name = CONCAT('Anonymised guest #', g.id),
+		g.contact = '',
+		g.updated_at = NOW()
+	WHERE g.name = (SELECT d.name FROM diners d WHERE d.id = ?)
+		OR (g.contact <> '' AND g.contact IN (
+			SELECT c.email FROM customers c INNER JOIN diner_sessions s ON s.customer_id = c.id
+			WHERE s.diner_id = ? AND c.email IS NOT NULL
+			UNION
+			SELECT c.phone FROM customers c INNER JOIN diner_sessions s ON s.customer_id = c.id
+			WHERE s.diner_id = ? AND c.phone IS NOT NULL));`, table), dinerID, dinerID, dinerID); err != nil {
+			return err
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+	UPDATE customers c
+	INNER JOIN diner_sessions s
+		ON s.customer_id = c.id
+	SET
+		c.name = CONCAT('Anonymised customer #', c.id),
+		c.email = NULL,
+		c.phone = NULL,
+		c.preferences = '',
+		c.updated_at = NOW()
+	WHERE s.diner_id = ?;`, dinerID); err != nil {
+		return err
+	}
+
+	_, err := tx.ExecContext(ctx, `
+	UPDATE diners
+	SET name = CONCAT('Anonymised diner #', id), updated_at = NOW()
+	WHERE id = ?;`, dinerID)
+	return err
+}
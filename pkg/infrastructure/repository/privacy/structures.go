@@ -0,0 +1,33 @@
+// Package privacy contains the repository implementation for the personal data requests of diners
+package privacy
+
+import (
+	"time"
+)
+
+// Request is a struct that contains the privacy request audit model
+type Request struct {
+	ID          int64     `db:"id" example:"123"`
+	DinerID     int64     `db:"diner_id" example:"1"`
+	Kind        string    `db:"kind" example:"export"`
+	RequestedBy string    `db:"requested_by" example:"privacy-officer@example.com"`
+	Reason      string    `db:"reason" example:"subject access request #42"`
+	Channel     string    `db:"channel" example:"api"`
+	CreatedAt   time.Time `db:"created_at" example:"2021-02-24 20:19:39"`
+	UpdatedAt   time.Time `db:"updated_at" example:"2021-02-24 20:19:39"`
+}
+
+// TableName overrides the table name used by Request to `privacy_requests`
+func (*Request) TableName() string {
+	return "privacy_requests"
+}
+
+// Payment is a struct that contains the payment model
+type Payment struct {
+	ID        int64     `db:"id" example:"123"`
+	SessionID int64     `db:"session_id" example:"1"`
+	Subtotal  int       `db:"subtotal" example:"50050"`
+	Discount  int       `db:"discount" example:"2000"`
+	Amount    int       `db:"amount" example:"48050"`
+	PaidAt    time.Time `db:"paid_at" example:"2021-02-24 21:49:39"`
+}
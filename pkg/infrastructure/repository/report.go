@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	domainReport "github.com/Raj63/golang-rest-api/pkg/domain/report"
+)
+
+// Reports specifies the repository contracts
+type Reports interface {
+	GetSales(ctx context.Context, from time.Time, to time.Time, groupBy string) ([]domainReport.SalesRow, error)
+	GetAggregatedSales(ctx context.Context, from time.Time, to time.Time, groupBy string) ([]domainReport.SalesRow, error)
+	CountAggregatedDays(ctx context.Context, from time.Time, to time.Time) (int, error)
+	GetServiceTimes(ctx context.Context, from time.Time, to time.Time, groupBy string) ([]domainReport.ServiceTimeRow, error)
+	GetChangedDays(ctx context.Context, since time.Time) ([]time.Time, error)
+	RefreshDay(ctx context.Context, day time.Time) error
+	GetWatermark(ctx context.Context) (time.Time, error)
+	SetWatermark(ctx context.Context, watermark time.Time) error
+}
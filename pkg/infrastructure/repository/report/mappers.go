@@ -0,0 +1,59 @@
+// Package report contains the repository implementation for the management reports
+package report
+
+import (
+	"database/sql"
+	"math"
+
+	domainReport "github.com/Raj63/golang-rest-api/pkg/domain/report"
+)
+
+func (row *SalesRow) toDomainMapper() *domainReport.SalesRow {
+	sales := &domainReport.SalesRow{
+		Group:     row.Group,
+		Revenue:   float64(row.Revenue) / 100,
+		Orders:    row.Orders,
+		ItemsSold: row.ItemsSold,
+	}
+	if row.Orders > 0 {
+		sales.AverageTicket = math.Round(float64(row.Revenue)/float64(row.Orders)) / 100
+	}
+	return sales
+}
+
+func salesToDomainMapper(rows *[]SalesRow) []domainReport.SalesRow {
+	salesDomain := make([]domainReport.SalesRow, len(*rows))
+	for i, row := range *rows {
+		salesDomain[i] = *row.toDomainMapper()
+	}
+
+	return salesDomain
+}
+
+func (row *ServiceTimeRow) toDomainMapper() *domainReport.ServiceTimeRow {
+	return &domainReport.ServiceTimeRow{
+		Group:            row.Group,
+		Sessions:         row.Sessions,
+		SeatToFirstOrder: minutes(row.SeatToFirstOrder),
+		OrderToServe:     minutes(row.OrderToServe),
+		Occupancy:        minutes(row.Occupancy),
+		TotalOccupancy:   minutes(row.TotalOccupancy),
+	}
+}
+
+func serviceTimesToDomainMapper(rows *[]ServiceTimeRow) []domainReport.ServiceTimeRow {
+	serviceTimesDomain := make([]domainReport.ServiceTimeRow, len(*rows))
+	for i, row := range *rows {
+		serviceTimesDomain[i] = *row.toDomainMapper()
+	}
+
+	return serviceTimesDomain
+}
+
+// minutes converts a duration in seconds to minutes rounded to a tenth, a missing duration being 0
+func minutes(seconds sql.NullFloat64) float64 {
+	if !seconds.Valid {
+		return 0
+	}
+	return math.Round(seconds.Float64/6) / 10
+}
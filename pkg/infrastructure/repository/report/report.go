@@ -0,0 +1,317 @@
+// Package report contains the repository implementation for the management reports
+package report
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	domainReport "github.com/Raj63/golang-rest-api/pkg/domain/report"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/logger"
+	sdksql "github.com/Raj63/golang-rest-api/pkg/infrastructure/sql"
+)
+
+// Repository is a struct that contains the database implementation for the management reports
+type Repository struct {
+	Store  *sdksql.DB
+	Logger *logger.Logger
+}
+
+const dateLayout = "2006-01-02"
+
+// salesWatermark names the watermark of the sales aggregates in aggregate_watermarks
+const salesWatermark = "sales"
+
+// salesGroup holds the expression naming a group and the expression ordering the groups of a sales report
+type salesGroup struct {
+	key, order string
+}
+
+// salesGroups holds the groupings of the sales computed on the orders
+var salesGroups = map[string]salesGroup{
+	domainReport.GroupByDay:     {key: "DATE_FORMAT(o.created_at, '%Y-%m-%d')", order: "MIN(o.created_at) ASC"},
+	domainReport.GroupByHour:    {key: "DATE_FORMAT(o.created_at, '%H:00')", order: "MIN(HOUR(o.created_at)) ASC"},
+	domainReport.GroupByWeekday: {key: "DAYNAME(o.created_at)", order: "MIN(WEEKDAY(o.created_at)) ASC"},
+	domainReport.GroupByMenu:    {key: "m.name", order: "revenue DESC, group_key ASC"},
+	domainReport.GroupByTable:   {key: "CAST(COALESCE(s.table_no, 0) AS CHAR)", order: "MIN(COALESCE(s.table_no, 0)) ASC"},
+}
+
+// aggregateGroups holds the groupings of the sales read from the daily aggregates, along with the aggregate table to read
+var aggregateGroups = map[string]struct {
+	salesGroup
+	from string
+}{
+	domainReport.GroupByDay:     {salesGroup{key: "DATE_FORMAT(a.day, '%Y-%m-%d')", order: "MIN(a.day) ASC"}, "daily_table_sales a"},
+	domainReport.GroupByHour:    {salesGroup{key: "CONCAT(LPAD(a.hour, 2, '0'), ':00')", order: "MIN(a.hour) ASC"}, "hourly_sales a"},
+	domainReport.GroupByWeekday: {salesGroup{key: "DAYNAME(a.day)", order: "MIN(WEEKDAY(a.day)) ASC"}, "daily_table_sales a"},
+	domainReport.GroupByMenu:    {salesGroup{key: "m.name", order: "revenue DESC, group_key ASC"}, "daily_menu_sales a INNER JOIN menus m ON m.id = a.menu_id"},
+	domainReport.GroupByTable:   {salesGroup{key: "CAST(a.table_no AS CHAR)", order: "MIN(a.table_no) ASC"}, "daily_table_sales a"},
+}
+
+// GetSales Fetch the sales of the orders placed within the given interval, grouped by the given grouping, computed on
+// the orders. Revenue is computed on the price of the menus at the time the sales were computed. The orders are counted
+// by session, an order placed without a session counting as one of its own.
+func (r *Repository) GetSales(ctx context.Context, from time.Time, to time.Time, groupBy string) ([]domainReport.SalesRow, error) {
+	group, ok := salesGroups[groupBy]
+	if !ok {
+		return nil, fmt.Errorf("unknown sales grouping %q", groupBy)
+	}
+
+	var rows []SalesRow
+	err := r.Store.Querier(ctx).SelectContext(ctx, &rows, `
+	SELECT
+		`+group.key+` AS group_key,
+		SUM(o.quantity * m.price) AS revenue,
+		COUNT(DISTINCT COALESCE(o.session_id, -o.id)) AS orders,
+		SUM(o.quantity) AS items_sold
+	FROM orders o
+	INNER JOIN menus m
+		ON m.id = o.menu_id
+	LEFT JOIN diner_sessions s
+		ON s.id = o.session_id
+	WHERE o.created_at >= ? AND o.created_at < ?
+	GROUP BY group_key
+	ORDER BY `+group.order+`;`, from, to)
+	if err != nil {
+		r.Logger.ErrorfContext(ctx, "error fetching sales: %v", err)
+		return nil, err
+	}
+
+	return salesToDomainMapper(&rows), nil
+}
+
+// CountAggregatedDays Fetch how many days of the given interval have been aggregated and have no order deleted since
+func (r *Repository) CountAggregatedDays(ctx context.Context, from time.Time, to time.Time) (int, error) {
+	var aggregatedDays int
+	err := r.Store.Querier(ctx).GetContext(ctx, &aggregatedDays, `
+	SELECT COUNT(a.day) FROM sales_aggregate_days a
+	WHERE a.day >= ? AND a.day < ?
+		AND NOT EXISTS (SELECT 1 FROM sales_dirty_days d WHERE d.day = a.day);`, from.Format(dateLayout), to.Format(dateLayout))
+	if err != nil {
+		r.Logger.ErrorfContext(ctx, "error counting aggregated days: %v", err)
+		return 0, err
+	}
+
+	return aggregatedDays, nil
+}
+
+// GetAggregatedSales Fetch the sales of the whole days within the given interval from the daily aggregates, grouped by
+// the given grouping. Revenue is computed on the price of the menus at the time the days were aggregated.
+func (r *Repository) GetAggregatedSales(ctx context.Context, from time.Time, to time.Time, groupBy string) ([]domainReport.SalesRow, error) {
+	group, ok := aggregateGroups[groupBy]
+	if !ok {
+		return nil, fmt.Errorf("unknown sales grouping %q", groupBy)
+	}
+
+	var rows []SalesRow
+	err := r.Store.Querier(ctx).SelectContext(ctx, &rows, `
+	SELECT
+		`+group.key+` AS group_key,
+		SUM(a.revenue) AS revenue,
+		SUM(a.tickets) AS orders,
+		SUM(a.items_sold) AS items_sold
+	FROM `+group.from+`
+	WHERE a.day >= ? AND a.day < ?
+	GROUP BY group_key
+	ORDER BY `+group.order+`;`, from.Format(dateLayout), to.Format(dateLayout))
+	if err != nil {
+		r.Logger.ErrorfContext(ctx, "error fetching aggregated sales: %v", err)
+		return nil, err
+	}
+
+	return salesToDomainMapper(&rows), nil
+}
+
+// serviceTimeGroups holds the groupings of the service times, computed on the dining sessions
+var serviceTimeGroups = map[string]salesGroup{
+	domainReport.GroupByTable:   {key: "CAST(s.table_no AS CHAR)", order: "MIN(s.table_no) ASC"},
+	domainReport.GroupBySection: {key: "COALESCE(t.section, 'unknown')", order: "group_key ASC"},
+	domainReport.GroupByDaypart: {
+		key: daypartCase("s.checked_in_at", func(i int, daypart domainReport.Daypart) string {
+			return "'" + strings.ReplaceAll(daypart.Name, "'", "''") + "'"
+		}),
+		order: "MIN(" + daypartCase("s.checked_in_at", func(i int, daypart domainReport.Daypart) string {
+			return strconv.Itoa(i)
+		}) + ") ASC",
+	},
+	domainReport.GroupByWeekday: {key: "DAYNAME(s.checked_in_at)", order: "MIN(WEEKDAY(s.checked_in_at)) ASC"},
+}
+
+// daypartCase builds the expression giving a value of the daypart the hour of a time column falls in
+func daypartCase(column string, value func(i int, daypart domainReport.Daypart) string) string {
+	last := len(domainReport.Dayparts) - 1
+	expr := "CASE"
+	for i := last; i >= 0; i-- {
+		expr += fmt.Sprintf(" WHEN HOUR(%s) >= %d THEN %s", column, domainReport.Dayparts[i].StartHour, value(i, domainReport.Dayparts[i]))
+	}
+	return expr + " ELSE " + value(last, domainReport.Dayparts[last]) + " END"
+}
+
+// GetServiceTimes Fetch the service times of the dining sessions checked in within the given interval, grouped
+// by the given grouping
+func (r *Repository) GetServiceTimes(ctx context.Context, from time.Time, to time.Time, groupBy string) ([]domainReport.ServiceTimeRow, error) {
+	group, ok := serviceTimeGroups[groupBy]
+	if !ok {
+		return nil, fmt.Errorf("unknown service times grouping %q", groupBy)
+	}
+
+	var rows []ServiceTimeRow
+	err := r.Store.Querier(ctx).SelectContext(ctx, &rows, `
+	SELECT
+		`+group.key+` AS group_key,
+		COUNT(s.id) AS sessions,
+		AVG(TIMESTAMPDIFF(SECOND, s.checked_in_at, o.first_order_at)) AS seat_to_first_order,
+		SUM(o.serve_seconds) / SUM(o.served) AS order_to_serve,
+		AVG(TIMESTAMPDIFF(SECOND, s.checked_in_at, s.checked_out_at)) AS occupancy,
+		SUM(TIMESTAMPDIFF(SECOND, s.checked_in_at, s.checked_out_at)) AS total_occupancy
+	FROM diner_sessions s
+	LEFT JOIN dining_tables t
+		ON t.table_no = s.table_no
+	LEFT JOIN (
+		SELECT
+			session_id,
+			MIN(created_at) AS first_order_at,
+			SUM(TIMESTAMPDIFF(SECOND, created_at, served_at)) AS serve_seconds,
+			COUNT(served_at) AS served
+		FROM orders
+		WHERE created_at >= ?
+		GROUP BY session_id
+	) o
+		ON o.session_id = s.id
+	WHERE s.checked_in_at >= ? AND s.checked_in_at < ?
+	GROUP BY group_key
+	ORDER BY `+group.order+`;`, from, from, to)
+	if err != nil {
+		r.Logger.ErrorfContext(ctx, "error fetching service times: %v", err)
+		return nil, err
+	}
+
+	return serviceTimesToDomainMapper(&rows), nil
+}
+
+// GetChangedDays Fetch the days of the orders placed or changed since the given time, and the days of the orders
+// deleted or repriced since their day was last refreshed
+func (r *Repository) GetChangedDays(ctx context.Context, since time.Time) ([]time.Time, error) {
+	var days []time.Time
+
+	err := r.Store.Querier(ctx).SelectContext(ctx, &days, `
+	SELECT DATE(created_at) AS day
+	FROM orders
+	WHERE updated_at >= ?
+	UNION
+	SELECT day
+	FROM sales_dirty_days
+	ORDER BY day ASC;`, since)
+	if err != nil {
+		r.Logger.ErrorfContext(ctx, "error fetching changed days: %v", err)
+		return nil, err
+	}
+
+	return days, nil
+}
+
+// RefreshDay ... Recompute the sales aggregates of one day from its orders
+func (r *Repository) RefreshDay(ctx context.Context, day time.Time) error {
+	date := day.Format(dateLayout)
+
+	tx, err := r.Store.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	// the day is no longer dirty once refreshed, an order deleted while refreshing marks it again when this commits
+	if _, err := tx.ExecContext(ctx, `DELETE FROM sales_dirty_days WHERE day = ?;`, date); err != nil {
+		_ = tx.Rollback()
+		r.Logger.ErrorfContext(ctx, "error clearing the dirty day %s: %v", date, err)
+		return err
+	}
+
+	// each aggregate table is emptied for the day and filled again from the orders placed on the day
+	refreshes := []struct{ table, insert string }{
+		{"daily_menu_sales", `
+		INSERT INTO daily_menu_sales (day, menu_id, revenue, tickets, items_sold)
+		SELECT ?, o.menu_id, SUM(o.quantity * m.price), COUNT(DISTINCT COALESCE(o.session_id, -o.id)), SUM(o.quantity)
+		FROM orders o
+		INNER JOIN menus m
+			ON m.id = o.menu_id
+		WHERE o.created_at >= ? AND o.created_at < DATE_ADD(?, INTERVAL 1 DAY)
+		GROUP BY o.menu_id;`},
+		{"daily_table_sales", `
+		INSERT INTO daily_table_sales (day, table_no, revenue, tickets, items_sold)
+		SELECT ?, COALESCE(s.table_no, 0), SUM(o.quantity * m.price), COUNT(DISTINCT COALESCE(o.session_id, -o.id)), SUM(o.quantity)
+		FROM orders o
+		INNER JOIN menus m
+			ON m.id = o.menu_id
+		LEFT JOIN diner_sessions s
+			ON s.id = o.session_id
+		WHERE o.created_at >= ? AND o.created_at < DATE_ADD(?, INTERVAL 1 DAY)
+		GROUP BY COALESCE(s.table_no, 0);`},
+		{"hourly_sales", `
+		INSERT INTO hourly_sales (day, hour, revenue, tickets, items_sold)
+		SELECT ?, HOUR(o.created_at), SUM(o.quantity * m.price), COUNT(DISTINCT COALESCE(o.session_id, -o.id)), SUM(o.quantity)
+		FROM orders o
+		INNER JOIN menus m
+			ON m.id = o.menu_id
+		WHERE o.created_at >= ? AND o.created_at < DATE_ADD(?, INTERVAL 1 DAY)
+		GROUP BY HOUR(o.created_at);`},
+	}
+	for _, refresh := range refreshes {
+		if _, err := tx.ExecContext(ctx, `DELETE FROM `+refresh.table+` WHERE day = ?;`, date); err != nil {
+			_ = tx.Rollback()
+			r.Logger.ErrorfContext(ctx, "error clearing %s for %s: %v", refresh.table, date, err)
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, refresh.insert, date, date, date); err != nil {
+			_ = tx.Rollback()
+			r.Logger.ErrorfContext(ctx, "error refreshing %s for %s: %v", refresh.table, date, err)
+			return err
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, `
+	INSERT INTO sales_aggregate_days (day, refreshed_at) VALUES (?, NOW())
+	ON DUPLICATE KEY UPDATE refreshed_at = NOW();`, date)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		_ = tx.Rollback()
+		r.Logger.ErrorfContext(ctx, "Commit failed: %v", err)
+		return err
+	}
+	return nil
+}
+
+// GetWatermark Fetch the time up to which the changed orders have been aggregated, zero when they never were
+func (r *Repository) GetWatermark(ctx context.Context) (time.Time, error) {
+	var watermark time.Time
+
+	err := r.Store.Querier(ctx).GetContext(ctx, &watermark, `SELECT watermark FROM aggregate_watermarks WHERE name = ?;`, salesWatermark)
+	if errors.Is(err, sql.ErrNoRows) {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return watermark, nil
+}
+
+// SetWatermark ... Record the time up to which the changed orders have been aggregated
+func (r *Repository) SetWatermark(ctx context.Context, watermark time.Time) error {
+	_, err := r.Store.Querier(ctx).ExecContext(ctx, `
+	INSERT INTO aggregate_watermarks (name, watermark) VALUES (?, ?)
+	ON DUPLICATE KEY UPDATE watermark = VALUES(watermark);`, salesWatermark, watermark)
+	if err != nil {
+		r.Logger.ErrorfContext(ctx, "error setting the sales aggregates watermark: %v", err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,22 @@
+// Package report contains the repository implementation for the management reports
+package report
+
+import "database/sql"
+
+// SalesRow is a struct that contains the sales of one group of a sales report
+type SalesRow struct {
+	Group     string `db:"group_key" example:"2026-10-19"`
+	Revenue   int64  `db:"revenue" example:"125050"`
+	Orders    int    `db:"orders" example:"14"`
+	ItemsSold int    `db:"items_sold" example:"37"`
+}
+
+// ServiceTimeRow is a struct that contains the service times, in seconds, of one group of dining sessions
+type ServiceTimeRow struct {
+	Group            string          `db:"group_key" example:"12"`
+	Sessions         int             `db:"sessions" example:"31"`
+	SeatToFirstOrder sql.NullFloat64 `db:"seat_to_first_order" example:"450"`
+	OrderToServe     sql.NullFloat64 `db:"order_to_serve" example:"1092"`
+	Occupancy        sql.NullFloat64 `db:"occupancy" example:"3888"`
+	TotalOccupancy   sql.NullFloat64 `db:"total_occupancy" example:"116640"`
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	domainReservation "github.com/Raj63/golang-rest-api/pkg/domain/reservation"
+)
+
+// Reservations specifies the repository contracts
+type Reservations interface {
+	Create(ctx context.Context, newReservation *domainReservation.Reservation, tableNumbers []int) (*domainReservation.Reservation, error)
+	GetByID(ctx context.Context, id int64) (*domainReservation.Reservation, error)
+	GetAllBetween(ctx context.Context, from time.Time, to time.Time) ([]domainReservation.Reservation, error)
+	GetOccupancies(ctx context.Context, from time.Time, to time.Time) ([]domainReservation.Occupancy, error)
+	UpdateStatus(ctx context.Context, id int64, status string) (err error)
+}
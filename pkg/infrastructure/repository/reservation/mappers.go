@@ -0,0 +1,59 @@
+// Package reservation contains the repository implementation for the reservation entity
+package reservation
+
+import domainReservation "github.com/Raj63/golang-rest-api/pkg/domain/reservation"
+
+func (reservation *Reservation) toDomainMapper() *domainReservation.Reservation {
+	return &domainReservation.Reservation{
+		ID:              reservation.ID,
+		Name:            reservation.Name,
+		Contact:         reservation.Contact,
+		PartySize:       reservation.PartySize,
+		TableNumber:     reservation.TableNumber,
+		ReservedAt:      reservation.ReservedAt,
+		DurationMinutes: reservation.DurationMinutes,
+		Status:          reservation.Status,
+		CreatedAt:       reservation.CreatedAt,
+		UpdatedAt:       reservation.UpdatedAt,
+	}
+}
+
+func fromDomainMapper(reservation *domainReservation.Reservation) *Reservation {
+	return &Reservation{
+		ID:              reservation.ID,
+		Name:            reservation.Name,
+		Contact:         reservation.Contact,
+		PartySize:       reservation.PartySize,
+		TableNumber:     reservation.TableNumber,
+		ReservedAt:      reservation.ReservedAt,
+		DurationMinutes: reservation.DurationMinutes,
+		Status:          reservation.Status,
+		CreatedAt:       reservation.CreatedAt,
+	}
+}
+
+func arrayToDomainMapper(reservations *[]Reservation) []domainReservation.Reservation {
+	reservationsDomain := make([]domainReservation.Reservation, len(*reservations))
+	for i, reservation := range *reservations {
+		reservationsDomain[i] = *reservation.toDomainMapper()
+	}
+
+	return reservationsDomain
+}
+
+func (occupancy *Occupancy) toDomainMapper() *domainReservation.Occupancy {
+	return &domainReservation.Occupancy{
+		TableNumber: occupancy.TableNumber,
+		StartAt:     occupancy.StartAt,
+		EndAt:       occupancy.EndAt,
+	}
+}
+
+func occupanciesToDomainMapper(occupancies *[]Occupancy) []domainReservation.Occupancy {
+	occupanciesDomain := make([]domainReservation.Occupancy, len(*occupancies))
+	for i, occupancy := range *occupancies {
+		occupanciesDomain[i] = *occupancy.toDomainMapper()
+	}
+
+	return occupanciesDomain
+}
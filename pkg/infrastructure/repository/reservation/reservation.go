@@ -0,0 +1,185 @@
+// Package reservation contains the repository implementation for the reservation entity
+package reservation
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	appErr "github.com/Raj63/golang-rest-api/pkg/domain/errors"
+	domainReservation "github.com/Raj63/golang-rest-api/pkg/domain/reservation"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/logger"
+	sdksql "github.com/Raj63/golang-rest-api/pkg/infrastructure/sql"
+)
+
+// Repository is a struct that contains the database implementation for reservation entity
+type Repository struct {
+	Store  *sdksql.DB
+	Logger *logger.Logger
+}
+
+// sessionEndExpr is the end of a dining session. A session that is still open is assumed to hold the table
+// for at least the default reservation duration, and for as long as it stays open beyond that.
+const sessionEndExpr = `COALESCE(checked_out_at, GREATEST(DATE_ADD(checked_in_at, INTERVAL ? MINUTE), NOW()))`
+
+// conflictsQuery counts the active reservations and dining sessions holding a table within an interval.
+const conflictsQuery = `
+SELECT
+	(SELECT COUNT(id) FROM reservations
+	WHERE table_no = ? AND status IN (?, ?)
+		AND reserved_at < ? AND DATE_ADD(reserved_at, INTERVAL duration_minutes MINUTE) > ?)
+	+
+	(SELECT COUNT(id) FROM diner_sessions
+	WHERE table_no = ?
+		AND checked_in_at < ? AND ` + sessionEndExpr + ` > ?);`
+
+// Create ... Insert New data on the first of the given tables that is free for the reservation slot
+func (r *Repository) Create(ctx context.Context, newReservation *domainReservation.Reservation, tableNumbers []int) (*domainReservation.Reservation, error) {
+	reservation := fromDomainMapper(newReservation)
+	startAt, endAt := newReservation.ReservedAt, newReservation.EndAt()
+
+	tx, err := r.Store.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tableNumber := range tableNumbers {
+		// lock the dining table so that concurrent bookings of the same table are serialised
+		var locked int
+		err := tx.GetContext(ctx, &locked, `SELECT table_no FROM dining_tables WHERE table_no = ? FOR UPDATE;`, tableNumber)
+		if errors.Is(err, sql.ErrNoRows) {
+			continue
+		}
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+
+		var conflicts int
+		err = tx.GetContext(ctx, &conflicts, conflictsQuery,
+			tableNumber, domainReservation.StatusBooked, domainReservation.StatusSeated, endAt, startAt,
+			tableNumber, endAt, domainReservation.DefaultDurationMinutes, startAt)
+		if err != nil {
+			_ = tx.Rollback()
+			r.Logger.ErrorfContext(ctx, "error checking reservation conflicts: %v", err)
+			return nil, err
+		}
+		if conflicts > 0 {
+			continue
+		}
+
+		reservation.TableNumber = tableNumber
+		result, err := tx.NamedExecContext(ctx, `
+		INSERT INTO reservations (name, contact, party_size, table_no, reserved_at, duration_minutes, status, created_at, updated_at)
+		VALUES (:name, :contact, :party_size, :table_no, :reserved_at, :duration_minutes, :status, NOW(), NOW());`, reservation)
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+
+		reservation.ID, err = result.LastInsertId()
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+
+		if err := tx.Commit(); err != nil {
+			_ = tx.Rollback()
+			r.Logger.ErrorfContext(ctx, "Commit failed: %v", err)
+			return nil, err
+		}
+		return reservation.toDomainMapper(), nil
+	}
+
+	_ = tx.Rollback()
+	return nil, appErr.NewAppError(errors.New("no table is available for the requested slot"), appErr.ResourceAlreadyExists)
+}
+
+// GetByID ... Fetch only one reservation by Id
+func (r *Repository) GetByID(ctx context.Context, id int64) (*domainReservation.Reservation, error) {
+	var reservation Reservation
+
+	err := r.Store.Querier(ctx).GetContext(ctx, &reservation, `
+	SELECT
+		id, name, contact, party_size, table_no, reserved_at, duration_minutes, status, created_at, updated_at
+	FROM reservations
+	WHERE id = ?;`, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, appErr.NewAppErrorWithType(appErr.NotFound)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return reservation.toDomainMapper(), nil
+}
+
+// GetAllBetween Fetch all reservations starting within the given interval
+func (r *Repository) GetAllBetween(ctx context.Context, from time.Time, to time.Time) ([]domainReservation.Reservation, error) {
+	var reservations []Reservation
+
+	err := r.Store.Querier(ctx).SelectContext(ctx, &reservations, `
+	SELECT
+		id, name, contact, party_size, table_no, reserved_at, duration_minutes, status, created_at, updated_at
+	FROM reservations
+	WHERE reserved_at >= ? AND reserved_at < ?
+	ORDER BY
+		reserved_at ASC, table_no ASC;`, from, to)
+	if err != nil {
+		r.Logger.ErrorfContext(ctx, "error fetching reservations: %v", err)
+		return nil, err
+	}
+
+	return arrayToDomainMapper(&reservations), nil
+}
+
+// GetOccupancies Fetch the intervals tables are held by active reservations or dining sessions within the given interval
+func (r *Repository) GetOccupancies(ctx context.Context, from time.Time, to time.Time) ([]domainReservation.Occupancy, error) {
+	var occupancies []Occupancy
+
+	err := r.Store.Querier(ctx).SelectContext(ctx, &occupancies, `
+	SELECT
+		table_no,
+		reserved_at AS start_at,
+		DATE_ADD(reserved_at, INTERVAL duration_minutes MINUTE) AS end_at
+	FROM reservations
+	WHERE status IN (?, ?)
+		AND reserved_at < ? AND DATE_ADD(reserved_at, INTERVAL duration_minutes MINUTE) > ?
+	UNION ALL
+	SELECT
+		table_no,
+		checked_in_at AS start_at,
+		`+sessionEndExpr+` AS end_at
+	FROM diner_sessions
+	WHERE checked_in_at < ? AND `+sessionEndExpr+` > ?;`,
+		domainReservation.StatusBooked, domainReservation.StatusSeated, to, from,
+		domainReservation.DefaultDurationMinutes, to, domainReservation.DefaultDurationMinutes, from)
+	if err != nil {
+		r.Logger.ErrorfContext(ctx, "error fetching table occupancies: %v", err)
+		return nil, err
+	}
+
+	return occupanciesToDomainMapper(&occupancies), nil
+}
+
+// UpdateStatus ... Update the status of a reservation
+func (r *Repository) UpdateStatus(ctx context.Context, id int64, status string) (err error) {
+	result, err := r.Store.Querier(ctx).ExecContext(ctx, `
+	UPDATE reservations
+	SET status = ?, updated_at = NOW()
+	WHERE id = ?;
+	`, status, id)
+	if err != nil {
+		r.Logger.ErrorfContext(ctx, "error when executing query. error: %+v, param: %+v\n", err, id)
+		return err
+	}
+
+	rowAffected, errGetAffectedRow := result.RowsAffected()
+	if errGetAffectedRow != nil || rowAffected == 0 {
+		r.Logger.Errorf("error when get affected row. error: %+v", errGetAffectedRow)
+		return appErr.NewAppErrorWithType(appErr.NotFound)
+	}
+
+	return nil
+}
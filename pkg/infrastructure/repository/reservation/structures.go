@@ -0,0 +1,32 @@
+// Package reservation contains the repository implementation for the reservation entity
+package reservation
+
+import (
+	"time"
+)
+
+// Reservation is a struct that contains the reservation model
+type Reservation struct {
+	ID              int64     `db:"id" example:"123"`
+	Name            string    `db:"name" example:"Mr. Smith"`
+	Contact         string    `db:"contact" example:"+91 98765 43210"`
+	PartySize       int       `db:"party_size" example:"4"`
+	TableNumber     int       `db:"table_no" example:"101"`
+	ReservedAt      time.Time `db:"reserved_at" example:"2021-02-24 20:00:00"`
+	DurationMinutes int       `db:"duration_minutes" example:"90"`
+	Status          string    `db:"status" example:"booked"`
+	CreatedAt       time.Time `db:"created_at" example:"2021-02-24 20:19:39"`
+	UpdatedAt       time.Time `db:"updated_at" example:"2021-02-24 20:19:39"`
+}
+
+// TableName overrides the table name used by Reservation to `reservations`
+func (*Reservation) TableName() string {
+	return "reservations"
+}
+
+// Occupancy is a struct that contains the interval a table is held
+type Occupancy struct {
+	TableNumber int       `db:"table_no" example:"101"`
+	StartAt     time.Time `db:"start_at" example:"2021-02-24 20:00:00"`
+	EndAt       time.Time `db:"end_at" example:"2021-02-24 21:30:00"`
+}
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	domainTable "github.com/Raj63/golang-rest-api/pkg/domain/table"
+)
+
+// Tables specifies the repository contracts
+type Tables interface {
+	GetAll(ctx context.Context) ([]domainTable.Table, error)
+	GetByMinCapacity(ctx context.Context, capacity int) ([]domainTable.Table, error)
+}
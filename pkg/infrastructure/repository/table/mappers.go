@@ -0,0 +1,21 @@
+// Package table contains the repository implementation for the dining table entity
+package table
+
+import domainTable "github.com/Raj63/golang-rest-api/pkg/domain/table"
+
+func (table *Table) toDomainMapper() *domainTable.Table {
+	return &domainTable.Table{
+		TableNumber: table.TableNumber,
+		Capacity:    table.Capacity,
+		Section:     table.Section,
+	}
+}
+
+func arrayToDomainMapper(tables *[]Table) []domainTable.Table {
+	tablesDomain := make([]domainTable.Table, len(*tables))
+	for i, table := range *tables {
+		tablesDomain[i] = *table.toDomainMapper()
+	}
+
+	return tablesDomain
+}
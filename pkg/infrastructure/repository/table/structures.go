@@ -0,0 +1,21 @@
+// Package table contains the repository implementation for the dining table entity
+package table
+
+import (
+	"time"
+)
+
+// Table is a struct that contains the dining table model
+type Table struct {
+	ID          int64     `db:"id" example:"123"`
+	TableNumber int       `db:"table_no" example:"101"`
+	Capacity    int       `db:"capacity" example:"4"`
+	Section     string    `db:"section" example:"main"`
+	CreatedAt   time.Time `db:"created_at" example:"2021-02-24 20:19:39"`
+	UpdatedAt   time.Time `db:"updated_at" example:"2021-02-24 20:19:39"`
+}
+
+// TableName overrides the table name used by Table to `dining_tables`
+func (*Table) TableName() string {
+	return "dining_tables"
+}
@@ -0,0 +1,53 @@
+// Package table contains the repository implementation for the dining table entity
+package table
+
+import (
+	"context"
+
+	domainTable "github.com/Raj63/golang-rest-api/pkg/domain/table"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/logger"
+	sdksql "github.com/Raj63/golang-rest-api/pkg/infrastructure/sql"
+)
+
+// Repository is a struct that contains the database implementation for dining table entity
+type Repository struct {
+	Store  *sdksql.DB
+	Logger *logger.Logger
+}
+
+// GetAll Fetch all dining tables
+func (r *Repository) GetAll(ctx context.Context) ([]domainTable.Table, error) {
+	var tables []Table
+
+	err := r.Store.Querier(ctx).SelectContext(ctx, &tables, `
+	SELECT
+		id, table_no, capacity, section, created_at, updated_at
+	FROM dining_tables
+	ORDER BY
+		table_no ASC;`)
+	if err != nil {
+		r.Logger.ErrorfContext(ctx, "error fetching dining tables: %v", err)
+		return nil, err
+	}
+
+	return arrayToDomainMapper(&tables), nil
+}
+
+// GetByMinCapacity Fetch the dining tables seating at least the given capacity, smallest first
+func (r *Repository) GetByMinCapacity(ctx context.Context, capacity int) ([]domainTable.Table, error) {
+	var tables []Table
+
+	err := r.Store.Querier(ctx).SelectContext(ctx, &tables, `
+	SELECT
+		id, table_no, capacity, section, created_at, updated_at
+	FROM dining_tables
+	WHERE capacity >= ?
+	ORDER BY
+		capacity ASC, table_no ASC;`, capacity)
+	if err != nil {
+		r.Logger.ErrorfContext(ctx, "error fetching dining tables by capacity: %v", err)
+		return nil, err
+	}
+
+	return arrayToDomainMapper(&tables), nil
+}
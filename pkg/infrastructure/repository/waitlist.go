@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	domainWaitlist "github.com/Raj63/golang-rest-api/pkg/domain/waitlist"
+)
+
+// Waitlist specifies the repository contracts
+type Waitlist interface {
+	Create(ctx context.Context, newEntry *domainWaitlist.Entry) (*domainWaitlist.Entry, error)
+	GetByID(ctx context.Context, id int64) (*domainWaitlist.Entry, error)
+	GetActive(ctx context.Context) ([]domainWaitlist.Entry, error)
+	UpdateStatus(ctx context.Context, id int64, status string) (err error)
+	MarkNotified(ctx context.Context, id int64, tableNumber int) (err error)
+	ReleaseNotified(ctx context.Context, id int64) (err error)
+	GetTurnDurations(ctx context.Context, since time.Time) ([]domainWaitlist.TurnDuration, error)
+}
@@ -0,0 +1,52 @@
+// Package waitlist contains the repository implementation for the waitlist entity
+package waitlist
+
+import domainWaitlist "github.com/Raj63/golang-rest-api/pkg/domain/waitlist"
+
+func (entry *Entry) toDomainMapper() *domainWaitlist.Entry {
+	return &domainWaitlist.Entry{
+		ID:          entry.ID,
+		Name:        entry.Name,
+		Contact:     entry.Contact,
+		PartySize:   entry.PartySize,
+		Status:      entry.Status,
+		TableNumber: entry.TableNumber,
+		NotifiedAt:  entry.NotifiedAt,
+		CreatedAt:   entry.CreatedAt,
+		UpdatedAt:   entry.UpdatedAt,
+	}
+}
+
+func fromDomainMapper(entry *domainWaitlist.Entry) *Entry {
+	return &Entry{
+		ID:          entry.ID,
+		Name:        entry.Name,
+		Contact:     entry.Contact,
+		PartySize:   entry.PartySize,
+		Status:      entry.Status,
+		TableNumber: entry.TableNumber,
+		NotifiedAt:  entry.NotifiedAt,
+		CreatedAt:   entry.CreatedAt,
+	}
+}
+
+func arrayToDomainMapper(entries *[]Entry) []domainWaitlist.Entry {
+	entriesDomain := make([]domainWaitlist.Entry, len(*entries))
+	for i, entry := range *entries {
+		entriesDomain[i] = *entry.toDomainMapper()
+	}
+
+	return entriesDomain
+}
+
+func turnDurationsToDomainMapper(turnDurations *[]TurnDuration) []domainWaitlist.TurnDuration {
+	turnDurationsDomain := make([]domainWaitlist.TurnDuration, len(*turnDurations))
+	for i, turnDuration := range *turnDurations {
+		turnDurationsDomain[i] = domainWaitlist.TurnDuration{
+			Capacity:       turnDuration.Capacity,
+			AverageMinutes: turnDuration.AverageMinutes,
+		}
+	}
+
+	return turnDurationsDomain
+}
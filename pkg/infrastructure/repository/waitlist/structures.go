@@ -0,0 +1,30 @@
+// Package waitlist contains the repository implementation for the waitlist entity
+package waitlist
+
+import (
+	"time"
+)
+
+// Entry is a struct that contains the waitlist entry model
+type Entry struct {
+	ID          int64      `db:"id" example:"123"`
+	Name        string     `db:"name" example:"Mr. Smith"`
+	Contact     string     `db:"contact" example:"+91 98765 43210"`
+	PartySize   int        `db:"party_size" example:"4"`
+	Status      string     `db:"status" example:"waiting"`
+	TableNumber int        `db:"table_no" example:"101"`
+	NotifiedAt  *time.Time `db:"notified_at" example:"2021-02-24 20:19:39"`
+	CreatedAt   time.Time  `db:"created_at" example:"2021-02-24 20:19:39"`
+	UpdatedAt   time.Time  `db:"updated_at" example:"2021-02-24 20:19:39"`
+}
+
+// TableName overrides the table name used by Entry to `waitlist_entries`
+func (*Entry) TableName() string {
+	return "waitlist_entries"
+}
+
+// TurnDuration is a struct that contains the average turn duration per table capacity
+type TurnDuration struct {
+	Capacity       int     `db:"capacity" example:"4"`
+	AverageMinutes float64 `db:"average_minutes" example:"72.5"`
+}
@@ -0,0 +1,173 @@
+// Package waitlist contains the repository implementation for the waitlist entity
+package waitlist
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	appErr "github.com/Raj63/golang-rest-api/pkg/domain/errors"
+	domainWaitlist "github.com/Raj63/golang-rest-api/pkg/domain/waitlist"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/logger"
+	sdksql "github.com/Raj63/golang-rest-api/pkg/infrastructure/sql"
+)
+
+// Repository is a struct that contains the database implementation for waitlist entity
+type Repository struct {
+	Store  *sdksql.DB
+	Logger *logger.Logger
+}
+
+// Create ... Insert New data
+func (r *Repository) Create(ctx context.Context, newEntry *domainWaitlist.Entry) (*domainWaitlist.Entry, error) {
+	entry := fromDomainMapper(newEntry)
+	// store into DB
+	tx, err := r.Store.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result, err := tx.NamedExecContext(ctx, `
+	INSERT INTO waitlist_entries (name, contact, party_size, status, created_at, updated_at)
+	VALUES (:name, :contact, :party_size, :status, NOW(), NOW());`, entry)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	entry.ID, err = result.LastInsertId()
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		_ = tx.Rollback()
+		r.Logger.ErrorfContext(ctx, "Commit failed: %v", err)
+		return nil, err
+	}
+	entry.CreatedAt = time.Now()
+	return entry.toDomainMapper(), nil
+}
+
+// GetByID ... Fetch only one waitlist entry by Id
+func (r *Repository) GetByID(ctx context.Context, id int64) (*domainWaitlist.Entry, error) {
+	var entry Entry
+
+	err := r.Store.Querier(ctx).GetContext(ctx, &entry, `
+	SELECT
+		id, name, contact, party_size, status, table_no, notified_at, created_at, updated_at
+	FROM waitlist_entries
+	WHERE id = ?;`, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, appErr.NewAppErrorWithType(appErr.NotFound)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return entry.toDomainMapper(), nil
+}
+
+// GetActive Fetch the waiting and notified entries in queue order
+func (r *Repository) GetActive(ctx context.Context) ([]domainWaitlist.Entry, error) {
+	var entries []Entry
+
+	err := r.Store.Querier(ctx).SelectContext(ctx, &entries, `
+	SELECT
+		id, name, contact, party_size, status, table_no, notified_at, created_at, updated_at
+	FROM waitlist_entries
+	WHERE status IN (?, ?)
+	ORDER BY
+		created_at ASC, id ASC;`, domainWaitlist.StatusWaiting, domainWaitlist.StatusNotified)
+	if err != nil {
+		r.Logger.ErrorfContext(ctx, "error fetching waitlist entries: %v", err)
+		return nil, err
+	}
+
+	return arrayToDomainMapper(&entries), nil
+}
+
+// UpdateStatus ... Update the status of a waitlist entry
+func (r *Repository) UpdateStatus(ctx context.Context, id int64, status string) (err error) {
+	result, err := r.Store.Querier(ctx).ExecContext(ctx, `
+	UPDATE waitlist_entries
+	SET status = ?, updated_at = NOW()
+	WHERE id = ?;
+	`, status, id)
+	if err != nil {
+		r.Logger.ErrorfContext(ctx, "error when executing query. error: %+v, param: %+v\n", err, id)
+		return err
+	}
+
+	rowAffected, errGetAffectedRow := result.RowsAffected()
+	if errGetAffectedRow != nil || rowAffected == 0 {
+		r.Logger.Errorf("error when get affected row. error: %+v", errGetAffectedRow)
+		return appErr.NewAppErrorWithType(appErr.NotFound)
+	}
+
+	return nil
+}
+
+// MarkNotified ... Mark a waiting entry as notified of the given free table
+func (r *Repository) MarkNotified(ctx context.Context, id int64, tableNumber int) (err error) {
+	result, err := r.Store.Querier(ctx).ExecContext(ctx, `
+	UPDATE waitlist_entries
+	SET status = ?, table_no = ?, notified_at = NOW(), updated_at = NOW()
+	WHERE id = ? AND status = ?;
+	`, domainWaitlist.StatusNotified, tableNumber, id, domainWaitlist.StatusWaiting)
+	if err != nil {
+		r.Logger.ErrorfContext(ctx, "error when executing query. error: %+v, param: %+v\n", err, id)
+		return err
+	}
+
+	rowAffected, errGetAffectedRow := result.RowsAffected()
+	if errGetAffectedRow != nil || rowAffected == 0 {
+		r.Logger.Errorf("error when get affected row. error: %+v", errGetAffectedRow)
+		return appErr.NewAppErrorWithType(appErr.NotFound)
+	}
+
+	return nil
+}
+
+// ReleaseNotified ... Put a notified entry back in the queue, waiting for the next free table
+func (r *Repository) ReleaseNotified(ctx context.Context, id int64) (err error) {
+	result, err := r.Store.Querier(ctx).ExecContext(ctx, `
+	UPDATE waitlist_entries
+	SET status = ?, table_no = 0, notified_at = NULL, updated_at = NOW()
+	WHERE id = ? AND status = ?;
+	`, domainWaitlist.StatusWaiting, id, domainWaitlist.StatusNotified)
+	if err != nil {
+		r.Logger.ErrorfContext(ctx, "error when executing query. error: %+v, param: %+v\n", err, id)
+		return err
+	}
+
+	rowAffected, errGetAffectedRow := result.RowsAffected()
+	if errGetAffectedRow != nil || rowAffected == 0 {
+		r.Logger.Errorf("error when get affected row. error: %+v", errGetAffectedRow)
+		return appErr.NewAppErrorWithType(appErr.NotFound)
+	}
+
+	return nil
+}
+
+// GetTurnDurations Fetch the average minutes between a diner checking in and checking out, per table capacity
+func (r *Repository) GetTurnDurations(ctx context.Context, since time.Time) ([]domainWaitlist.TurnDuration, error) {
+	var turnDurations []TurnDuration
+
+	err := r.Store.Querier(ctx).SelectContext(ctx, &turnDurations, `
+	SELECT
+		t.capacity,
+		AVG(TIMESTAMPDIFF(MINUTE, s.checked_in_at, s.checked_out_at)) AS average_minutes
+	FROM diner_sessions s
+	INNER JOIN dining_tables t
+		ON t.table_no = s.table_no
+	WHERE s.checked_in_at >= ? AND s.checked_out_at IS NOT NULL
+	GROUP BY t.capacity;`, since)
+	if err != nil {
+		r.Logger.ErrorfContext(ctx, "error fetching turn durations: %v", err)
+		return nil, err
+	}
+
+	return turnDurationsToDomainMapper(&turnDurations), nil
+}
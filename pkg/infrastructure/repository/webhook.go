@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	domainWebhook "github.com/Raj63/golang-rest-api/pkg/domain/webhook"
+)
+
+// Webhooks specifies the repository contracts
+type Webhooks interface {
+	Create(ctx context.Context, newSubscription *domainWebhook.Subscription) (*domainWebhook.Subscription, error)
+	GetAll(ctx context.Context) ([]domainWebhook.Subscription, error)
+	GetByID(ctx context.Context, id int64) (*domainWebhook.Subscription, error)
+	GetByEvent(ctx context.Context, event string) ([]domainWebhook.Subscription, error)
+	Delete(ctx context.Context, id int64) (err error)
+	CreateDeliveries(ctx context.Context, deliveries []domainWebhook.Delivery) ([]domainWebhook.Delivery, error)
+	GetDeliveries(ctx context.Context, subscriptionID int64, status string) ([]domainWebhook.Delivery, error)
+	GetDelivery(ctx context.Context, id int64) (*domainWebhook.Delivery, error)
+	ClaimDueDeliveries(ctx context.Context, until time.Time, claimedUntil time.Time, limit int) ([]domainWebhook.Delivery, error)
+	ClaimDelivery(ctx context.Context, id int64, now time.Time, claimedUntil time.Time) (*domainWebhook.Delivery, error)
+	RecordAttempt(ctx context.Context, delivery *domainWebhook.Delivery, attempt *domainWebhook.Attempt) (err error)
+}
@@ -0,0 +1,107 @@
+// Package webhook contains the repository implementation for the webhook entities
+package webhook
+
+import (
+	"strings"
+
+	domainWebhook "github.com/Raj63/golang-rest-api/pkg/domain/webhook"
+)
+
+func (subscription *Subscription) toDomainMapper() *domainWebhook.Subscription {
+	return &domainWebhook.Subscription{
+		ID:        subscription.ID,
+		URL:       subscription.URL,
+		Events:    strings.Split(subscription.Events, ","),
+		Secret:    subscription.Secret,
+		CreatedAt: subscription.CreatedAt,
+		UpdatedAt: subscription.UpdatedAt,
+	}
+}
+
+func fromDomainMapper(subscription *domainWebhook.Subscription) *Subscription {
+	return &Subscription{
+		ID:        subscription.ID,
+		URL:       subscription.URL,
+		Events:    strings.Join(subscription.Events, ","),
+		Secret:    subscription.Secret,
+		CreatedAt: subscription.CreatedAt,
+	}
+}
+
+func arrayToDomainMapper(subscriptions *[]Subscription) []domainWebhook.Subscription {
+	subscriptionsDomain := make([]domainWebhook.Subscription, len(*subscriptions))
+	for i, subscription := range *subscriptions {
+		subscriptionsDomain[i] = *subscription.toDomainMapper()
+	}
+
+	return subscriptionsDomain
+}
+
+func (delivery *Delivery) toDomainMapper() *domainWebhook.Delivery {
+	return &domainWebhook.Delivery{
+		ID:             delivery.ID,
+		SubscriptionID: delivery.SubscriptionID,
+		Event:          delivery.Event,
+		Payload:        delivery.Payload,
+		Status:         delivery.Status,
+		Attempts:       delivery.Attempts,
+		NextAttemptAt:  delivery.NextAttemptAt,
+		LastStatusCode: delivery.LastStatusCode,
+		LastError:      delivery.LastError,
+		DeliveredAt:    delivery.DeliveredAt,
+		CreatedAt:      delivery.CreatedAt,
+		UpdatedAt:      delivery.UpdatedAt,
+	}
+}
+
+func fromDomainDeliveryMapper(delivery *domainWebhook.Delivery) *Delivery {
+	return &Delivery{
+		ID:             delivery.ID,
+		SubscriptionID: delivery.SubscriptionID,
+		Event:          delivery.Event,
+		Payload:        delivery.Payload,
+		Status:         delivery.Status,
+		Attempts:       delivery.Attempts,
+		NextAttemptAt:  delivery.NextAttemptAt,
+		LastStatusCode: delivery.LastStatusCode,
+		LastError:      delivery.LastError,
+		DeliveredAt:    delivery.DeliveredAt,
+		CreatedAt:      delivery.CreatedAt,
+	}
+}
+
+func deliveriesToDomainMapper(deliveries *[]Delivery) []domainWebhook.Delivery {
+	deliveriesDomain := make([]domainWebhook.Delivery, len(*deliveries))
+	for i, delivery := range *deliveries {
+		deliveriesDomain[i] = *delivery.toDomainMapper()
+	}
+
+	return deliveriesDomain
+}
+
+func fromDomainAttemptMapper(attempt *domainWebhook.Attempt) *Attempt {
+	return &Attempt{
+		ID:         attempt.ID,
+		DeliveryID: attempt.DeliveryID,
+		StatusCode: attempt.StatusCode,
+		Error:      attempt.Error,
+		DurationMs: attempt.DurationMs,
+		CreatedAt:  attempt.CreatedAt,
+	}
+}
+
+func attemptsToDomainMapper(attempts *[]Attempt) []domainWebhook.Attempt {
+	attemptsDomain := make([]domainWebhook.Attempt, len(*attempts))
+	for i, attempt := range *attempts {
+		attemptsDomain[i] = domainWebhook.Attempt{
+			ID:         attempt.ID,
+			DeliveryID: attempt.DeliveryID,
+			StatusCode: attempt.StatusCode,
+			Error:      attempt.Error,
+			DurationMs: attempt.DurationMs,
+			CreatedAt:  attempt.CreatedAt,
+		}
+	}
+
+	return attemptsDomain
+}
@@ -0,0 +1,57 @@
+// Package webhook contains the repository implementation for the webhook entities
+package webhook
+
+import (
+	"time"
+)
+
+// Subscription is a struct that contains the webhook subscription model
+type Subscription struct {
+	ID        int64     `db:"id" example:"123"`
+	URL       string    `db:"url" example:"https://partner.example.com/webhooks"`
+	Events    string    `db:"events" example:"order.created,order.served"`
+	Secret    string    `db:"secret" example:"5f2b8c0e3a9d4e7f"`
+	CreatedAt time.Time `db:"created_at" example:"2021-02-24 20:19:39"`
+	UpdatedAt time.Time `db:"updated_at" example:"2021-02-24 20:19:39"`
+}
+
+// TableName overrides the table name used by Subscription to `webhook_subscriptions`
+func (*Subscription) TableName() string {
+	return "webhook_subscriptions"
+}
+
+// Delivery is a struct that contains the webhook delivery model
+type Delivery struct {
+	ID             int64      `db:"id" example:"123"`
+	SubscriptionID int64      `db:"subscription_id" example:"7"`
+	Event          string     `db:"event" example:"order.created"`
+	Payload        []byte     `db:"payload"`
+	Status         string     `db:"status" example:"pending"`
+	Attempts       int        `db:"attempts" example:"2"`
+	NextAttemptAt  *time.Time `db:"next_attempt_at" example:"2021-02-24 20:19:39"`
+	LastStatusCode int        `db:"last_status_code" example:"503"`
+	LastError      string     `db:"last_error" example:"unexpected status 503"`
+	DeliveredAt    *time.Time `db:"delivered_at" example:"2021-02-24 20:19:39"`
+	CreatedAt      time.Time  `db:"created_at" example:"2021-02-24 20:19:39"`
+	UpdatedAt      time.Time  `db:"updated_at" example:"2021-02-24 20:19:39"`
+}
+
+// TableName overrides the table name used by Delivery to `webhook_deliveries`
+func (*Delivery) TableName() string {
+	return "webhook_deliveries"
+}
+
+// Attempt is a struct that contains the webhook delivery attempt model
+type Attempt struct {
+	ID         int64     `db:"id" example:"123"`
+	DeliveryID int64     `db:"delivery_id" example:"123"`
+	StatusCode int       `db:"status_code" example:"503"`
+	Error      string    `db:"error" example:"unexpected status 503"`
+	DurationMs int64     `db:"duration_ms" example:"87"`
+	CreatedAt  time.Time `db:"created_at" example:"2021-02-24 20:19:39"`
+}
+
+// TableName overrides the table name used by Attempt to `webhook_attempts`
+func (*Attempt) TableName() string {
+	return "webhook_attempts"
+}
@@ -0,0 +1,328 @@
+// Package webhook contains the repository implementation for the webhook entities
+package webhook
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	appErr "github.com/Raj63/golang-rest-api/pkg/domain/errors"
+	domainWebhook "github.com/Raj63/golang-rest-api/pkg/domain/webhook"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/logger"
+	sdksql "github.com/Raj63/golang-rest-api/pkg/infrastructure/sql"
+	"github.com/go-sql-driver/mysql"
+	"github.com/jmoiron/sqlx"
+)
+
+// Repository is a struct that contains the database implementation for the webhook entities
+type Repository struct {
+	Store  *sdksql.DB
+	Logger *logger.Logger
+}
+
+// Create ... Insert New data
+func (r *Repository) Create(ctx context.Context, newSubscription *domainWebhook.Subscription) (*domainWebhook.Subscription, error) {
+	subscription := fromDomainMapper(newSubscription)
+	result, err := r.Store.Querier(ctx).NamedExecContext(ctx, `
+	INSERT INTO webhook_subscriptions (url, events, secret, created_at, updated_at)
+	VALUES (:url, :events, :secret, NOW(), NOW());`, subscription)
+	if err != nil {
+		r.Logger.ErrorfContext(ctx, "error when executing query. error: %+v, param: %+v\n", err, subscription.URL)
+		return nil, err
+	}
+
+	subscription.ID, err = result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+	subscription.CreatedAt = time.Now()
+	subscription.UpdatedAt = subscription.CreatedAt
+	return subscription.toDomainMapper(), nil
+}
+
+// GetAll Fetch all the subscriptions
+func (r *Repository) GetAll(ctx context.Context) ([]domainWebhook.Subscription, error) {
+	var subscriptions []Subscription
+
+	err := r.Store.Querier(ctx).SelectContext(ctx, &subscriptions, `
+	SELECT
+		id, url, events, secret, created_at, updated_at
+	FROM webhook_subscriptions
+	ORDER BY id ASC;`)
+	if err != nil {
+		r.Logger.ErrorfContext(ctx, "error fetching webhook subscriptions: %v", err)
+		return nil, err
+	}
+
+	return arrayToDomainMapper(&subscriptions), nil
+}
+
+// GetByID ... Fetch only one subscription by Id
+func (r *Repository) GetByID(ctx context.Context, id int64) (*domainWebhook.Subscription, error) {
+	var subscription Subscription
+
+	err := r.Store.Querier(ctx).GetContext(ctx, &subscription, `
+	SELECT
+		id, url, events, secret, created_at, updated_at
+	FROM webhook_subscriptions
+	WHERE id = ?;`, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, appErr.NewAppErrorWithType(appErr.NotFound)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return subscription.toDomainMapper(), nil
+}
+
+// GetByEvent Fetch the subscriptions sent the event
+func (r *Repository) GetByEvent(ctx context.Context, event string) ([]domainWebhook.Subscription, error) {
+	var subscriptions []Subscription
+
+	err := r.Store.Querier(ctx).SelectContext(ctx, &subscriptions, `
+	SELECT
+		id, url, events, secret, created_at, updated_at
+	FROM webhook_subscriptions
+	WHERE FIND_IN_SET(?, events) > 0
+	ORDER BY id ASC;`, event)
+	if err != nil {
+		r.Logger.ErrorfContext(ctx, "error fetching webhook subscriptions: %v", err)
+		return nil, err
+	}
+
+	return arrayToDomainMapper(&subscriptions), nil
+}
+
+// Delete ... Delete a subscription, with its deliveries
+func (r *Repository) Delete(ctx context.Context, id int64) (err error) {
+	result, err := r.Store.Querier(ctx).ExecContext(ctx, `
+	DELETE FROM
+		webhook_subscriptions
+	WHERE id = ?;
+	`, id)
+	if err != nil {
+		r.Logger.ErrorfContext(ctx, "error when executing query. error: %+v, param: %+v\n", err, id)
+		return err
+	}
+
+	rowAffected, errGetAffectedRow := result.RowsAffected()
+	if errGetAffectedRow != nil || rowAffected == 0 {
+		r.Logger.Errorf("error when get affected row. error: %+v", errGetAffectedRow)
+		return appErr.NewAppErrorWithType(appErr.NotFound)
+	}
+
+	return nil
+}
+
+// CreateDeliveries ... Insert the deliveries of an event all at once
+func (r *Repository) CreateDeliveries(ctx context.Context, deliveries []domainWebhook.Delivery) ([]domainWebhook.Delivery, error) {
+	tx, err := r.Store.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	created := make([]domainWebhook.Delivery, len(deliveries))
+	for i := range deliveries {
+		delivery := fromDomainDeliveryMapper(&deliveries[i])
+		result, err := tx.NamedExecContext(ctx, `
+	INSERT INTO webhook_deliveries (subscription_id, event, payload, status, attempts, next_attempt_at, created_at, updated_at)
+	VALUES (:subscription_id, :event, :payload, :status, :attempts, :next_attempt_at, NOW(), NOW());`, delivery)
+		if err != nil {
+			_ = tx.Rollback()
+			var mysqlErr *mysql.MySQLError
+			// the subscription was deleted in between
+			if errors.As(err, &mysqlErr) && mysqlErr.Number == 1452 {
+				return nil, appErr.NewAppErrorWithType(appErr.NotFound)
+			}
+			return nil, err
+		}
+		delivery.ID, err = result.LastInsertId()
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+		delivery.CreatedAt = time.Now()
+		delivery.UpdatedAt = delivery.CreatedAt
+		created[i] = *delivery.toDomainMapper()
+	}
+
+	if err := tx.Commit(); err != nil {
+		_ = tx.Rollback()
+		r.Logger.ErrorfContext(ctx, "Commit failed: %v", err)
+		return nil, err
+	}
+	return created, nil
+}
+
+// GetDeliveries Fetch the deliveries of a subscription, the latest first, in any status when status is empty
+func (r *Repository) GetDeliveries(ctx context.Context, subscriptionID int64, status string) ([]domainWebhook.Delivery, error) {
+	var deliveries []Delivery
+
+	err := r.Store.Querier(ctx).SelectContext(ctx, &deliveries, `
+	SELECT
+		id, subscription_id, event, payload, status, attempts, next_attempt_at, last_status_code, last_error,
+		delivered_at, created_at, updated_at
+	FROM webhook_deliveries
+	WHERE subscription_id = ? AND (? = '' OR status = ?)
+	ORDER BY id DESC;`, subscriptionID, status, status)
+	if err != nil {
+		r.Logger.ErrorfContext(ctx, "error fetching webhook deliveries: %v", err)
+		return nil, err
+	}
+
+	return deliveriesToDomainMapper(&deliveries), nil
+}
+
+// GetDelivery ... Fetch only one delivery by Id, with the log of its attempts
+func (r *Repository) GetDelivery(ctx context.Context, id int64) (*domainWebhook.Delivery, error) {
+	var delivery Delivery
+
+	err := r.Store.Querier(ctx).GetContext(ctx, &delivery, `
+	SELECT
+		id, subscription_id, event, payload, status, attempts, next_attempt_at, last_status_code, last_error,
+		delivered_at, created_at, updated_at
+	FROM webhook_deliveries
+	WHERE id = ?;`, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, appErr.NewAppErrorWithType(appErr.NotFound)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var attempts []Attempt
+	err = r.Store.Querier(ctx).SelectContext(ctx, &attempts, `
+	SELECT
+		id, delivery_id, status_code, error, duration_ms, created_at
+	FROM webhook_attempts
+	WHERE delivery_id = ?
+	ORDER BY id ASC;`, id)
+	if err != nil {
+		r.Logger.ErrorfContext(ctx, "error fetching webhook attempts: %v", err)
+		return nil, err
+	}
+
+	result := delivery.toDomainMapper()
+	result.Log = attemptsToDomainMapper(&attempts)
+	return result, nil
+}
+
+// ClaimDueDeliveries Fetch the pending deliveries whose next attempt is due by until, the longest due first, and
+// claim them until claimedUntil, all in one transaction. The deliveries claimed by another transaction or whose
+// claim has not expired by until are skipped, so that no delivery is attempted twice at once.
+func (r *Repository) ClaimDueDeliveries(ctx context.Context, until time.Time, claimedUntil time.Time, limit int) ([]domainWebhook.Delivery, error) {
+	tx, err := r.Store.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var deliveries []Delivery
+	err = tx.SelectContext(ctx, &deliveries, `
+	SELECT
+		id, subscription_id, event, payload, status, attempts, next_attempt_at, last_status_code, last_error,
+		delivered_at, created_at, updated_at
+	FROM webhook_deliveries
+	WHERE status = ? AND next_attempt_at <= ? AND (claimed_until IS NULL OR claimed_until <= ?)
+	ORDER BY next_attempt_at ASC, id ASC
+	LIMIT ?
+	FOR UPDATE SKIP LOCKED;`, domainWebhook.StatusPending, until, until, limit)
+	if err != nil {
+		_ = tx.Rollback()
+		r.Logger.ErrorfContext(ctx, "error fetching webhook deliveries: %v", err)
+		return nil, err
+	}
+
+	if len(deliveries) > 0 {
+		ids := make([]int64, len(deliveries))
+		for i := range deliveries {
+			ids[i] = deliveries[i].ID
+		}
+		query, args, err := sqlx.In(`UPDATE webhook_deliveries SET claimed_until = ? WHERE id IN (?);`, claimedUntil, ids)
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+		if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+			_ = tx.Rollback()
+			r.Logger.ErrorfContext(ctx, "error claiming webhook deliveries: %v", err)
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		_ = tx.Rollback()
+		r.Logger.ErrorfContext(ctx, "Commit failed: %v", err)
+		return nil, err
+	}
+	return deliveriesToDomainMapper(&deliveries), nil
+}
+
+// ClaimDelivery Claim a delivery until claimedUntil unless its claim has not expired by now, and fetch it with the log
+// of its attempts
+func (r *Repository) ClaimDelivery(ctx context.Context, id int64, now time.Time, claimedUntil time.Time) (*domainWebhook.Delivery, error) {
+	result, err := r.Store.Querier(ctx).ExecContext(ctx, `
+	UPDATE webhook_deliveries SET claimed_until = ?
+	WHERE id = ? AND (claimed_until IS NULL OR claimed_until <= ?);`, claimedUntil, id, now)
+	if err != nil {
+		r.Logger.ErrorfContext(ctx, "error claiming webhook delivery %d: %v", id, err)
+		return nil, err
+	}
+	claimed, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	delivery, err := r.GetDelivery(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if claimed == 0 {
+		return nil, appErr.NewAppError(errors.New("the delivery is being attempted"), appErr.ResourceAlreadyExists)
+	}
+	return delivery, nil
+}
+
+// RecordAttempt ... Insert an attempt of a delivery and update the delivery with its outcome, releasing its claim, all
+// at once
+func (r *Repository) RecordAttempt(ctx context.Context, delivery *domainWebhook.Delivery, attempt *domainWebhook.Attempt) (err error) {
+	tx, err := r.Store.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.NamedExecContext(ctx, `
+	INSERT INTO webhook_attempts (delivery_id, status_code, error, duration_ms, created_at)
+	VALUES (:delivery_id, :status_code, :error, :duration_ms, NOW());`, fromDomainAttemptMapper(attempt))
+	if err != nil {
+		_ = tx.Rollback()
+		r.Logger.ErrorfContext(ctx, "error when executing query. error: %+v, param: %+v\n", err, delivery.ID)
+		return err
+	}
+
+	result, err := tx.NamedExecContext(ctx, `
+	UPDATE webhook_deliveries
+	SET status = :status, attempts = :attempts, next_attempt_at = :next_attempt_at, claimed_until = NULL,
+		last_status_code = :last_status_code, last_error = :last_error, delivered_at = :delivered_at, updated_at = NOW()
+	WHERE id = :id;`, fromDomainDeliveryMapper(delivery))
+	if err != nil {
+		_ = tx.Rollback()
+		r.Logger.ErrorfContext(ctx, "error when executing query. error: %+v, param: %+v\n", err, delivery.ID)
+		return err
+	}
+
+	rowAffected, errGetAffectedRow := result.RowsAffected()
+	if errGetAffectedRow != nil || rowAffected == 0 {
+		_ = tx.Rollback()
+		r.Logger.Errorf("error when get affected row. error: %+v", errGetAffectedRow)
+		return appErr.NewAppErrorWithType(appErr.NotFound)
+	}
+
+	if err := tx.Commit(); err != nil {
+		_ = tx.Rollback()
+		r.Logger.ErrorfContext(ctx, "Commit failed: %v", err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,15 @@
+// Package adapter is a layer that connects the infrastructure with the application layer
+package adapter
+
+import (
+	"net/http"
+
+	batchController "github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers/batch"
+	sdksql "github.com/Raj63/golang-rest-api/pkg/infrastructure/sql"
+)
+
+// BatchAdapter is a function that returns a batch controller dispatching the operations through the handler, in a
+// transaction of the database when the batch is transactional
+func BatchAdapter(handler http.Handler, db *sdksql.DB) *batchController.Controller {
+	return &batchController.Controller{Handler: handler, Transactor: db}
+}
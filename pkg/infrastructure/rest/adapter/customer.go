@@ -0,0 +1,17 @@
+// Package adapter is a layer that connects the infrastructure with the application layer
+package adapter
+
+import (
+	customerService "github.com/Raj63/golang-rest-api/pkg/app/usecases/customer"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/logger"
+	customerRepository "github.com/Raj63/golang-rest-api/pkg/infrastructure/repository/customer"
+	customerController "github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers/customer"
+	sdksql "github.com/Raj63/golang-rest-api/pkg/infrastructure/sql"
+)
+
+// CustomerAdapter is a function that returns a customer controller
+func CustomerAdapter(db *sdksql.DB, logger *logger.Logger) *customerController.Controller {
+	cRepository := customerRepository.Repository{Store: db, Logger: logger}
+	service := customerService.Service{CustomerRepository: &cRepository}
+	return &customerController.Controller{CustomerService: service}
+}
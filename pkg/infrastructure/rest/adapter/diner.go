@@ -2,9 +2,16 @@
 package adapter
 
 import (
+	customerService "github.com/Raj63/golang-rest-api/pkg/app/usecases/customer"
 	dinerService "github.com/Raj63/golang-rest-api/pkg/app/usecases/diner"
+	waitlistService "github.com/Raj63/golang-rest-api/pkg/app/usecases/waitlist"
 	"github.com/Raj63/golang-rest-api/pkg/infrastructure/logger"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/notifier"
+	customerRepository "github.com/Raj63/golang-rest-api/pkg/infrastructure/repository/customer"
 	dinerRepository "github.com/Raj63/golang-rest-api/pkg/infrastructure/repository/diner"
+	reservationRepository "github.com/Raj63/golang-rest-api/pkg/infrastructure/repository/reservation"
+	tableRepository "github.com/Raj63/golang-rest-api/pkg/infrastructure/repository/table"
+	waitlistRepository "github.com/Raj63/golang-rest-api/pkg/infrastructure/repository/waitlist"
 	dinerController "github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers/diner"
 	sdksql "github.com/Raj63/golang-rest-api/pkg/infrastructure/sql"
 )
@@ -12,6 +19,14 @@ import (
 // DinerAdapter is a function that returns a diner controller
 func DinerAdapter(db *sdksql.DB, logger *logger.Logger) *dinerController.Controller {
 	mRepository := dinerRepository.Repository{Store: db, Logger: logger}
-	service := dinerService.Service{DinerRepository: &mRepository}
+	tRepository := tableRepository.Repository{Store: db, Logger: logger}
+	releaser := waitlistService.Service{
+		WaitlistRepository:    &waitlistRepository.Repository{Store: db, Logger: logger},
+		ReservationRepository: &reservationRepository.Repository{Store: db, Logger: logger},
+		TableRepository:       &tRepository,
+		Notifier:              &notifier.LogNotifier{Logger: logger},
+	}
+	settler := customerService.Service{CustomerRepository: &customerRepository.Repository{Store: db, Logger: logger}}
+	service := dinerService.Service{DinerRepository: &mRepository, TableRepository: &tRepository, TableReleaser: &releaser, BillSettler: &settler, Transactor: db}
 	return &dinerController.Controller{DinerService: service}
 }
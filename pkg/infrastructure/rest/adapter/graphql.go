@@ -0,0 +1,18 @@
+// Package adapter is a layer that connects the infrastructure with the application layer
+package adapter
+
+import (
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/logger"
+	graphqlController "github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers/graphql"
+	sdksql "github.com/Raj63/golang-rest-api/pkg/infrastructure/sql"
+)
+
+// GraphQLAdapter is a function that returns a GraphQL controller over the same menu, diner and order use cases as
+// the REST controllers
+func GraphQLAdapter(db *sdksql.DB, logger *logger.Logger) *graphqlController.Controller {
+	return &graphqlController.Controller{
+		MenuService:  MenuAdapter(db, logger).MenuService,
+		DinerService: DinerAdapter(db, logger).DinerService,
+		OrderService: OrderAdapter(db, logger).OrderService,
+	}
+}
@@ -3,6 +3,7 @@ package adapter
 
 import (
 	menuService "github.com/Raj63/golang-rest-api/pkg/app/usecases/menu"
+	webhookService "github.com/Raj63/golang-rest-api/pkg/app/usecases/webhook"
 	"github.com/Raj63/golang-rest-api/pkg/infrastructure/logger"
 	menuRepository "github.com/Raj63/golang-rest-api/pkg/infrastructure/repository/menu"
 	menuController "github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers/menu"
@@ -11,7 +12,13 @@ import (
 
 // MenuAdapter is a function that returns a menu controller
 func MenuAdapter(db *sdksql.DB, logger *logger.Logger) *menuController.Controller {
+	return &menuController.Controller{MenuService: MenuService(db, logger)}
+}
+
+// MenuService is a function that returns the menu use case, shared by the menu endpoints and the command line
+func MenuService(db *sdksql.DB, logger *logger.Logger) menuService.Service {
 	mRepository := menuRepository.Repository{Store: db, Logger: logger}
-	service := menuService.Service{MenuRepository: &mRepository}
-	return &menuController.Controller{MenuService: service}
+	tRepository := menuRepository.TranslationRepository{Store: db, Logger: logger}
+	publisher := WebhookService(db, logger)
+	return menuService.Service{MenuRepository: &mRepository, TranslationRepository: &tRepository, Events: webhookService.Events{Publisher: &publisher, Transactor: db}}
 }
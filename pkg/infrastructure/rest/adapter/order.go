@@ -3,6 +3,7 @@ package adapter
 
 import (
 	orderService "github.com/Raj63/golang-rest-api/pkg/app/usecases/order"
+	webhookService "github.com/Raj63/golang-rest-api/pkg/app/usecases/webhook"
 	"github.com/Raj63/golang-rest-api/pkg/infrastructure/logger"
 	orderRepository "github.com/Raj63/golang-rest-api/pkg/infrastructure/repository/order"
 	orderController "github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers/order"
@@ -12,6 +13,7 @@ import (
 // OrderAdapter is a function that returns a order controller
 func OrderAdapter(db *sdksql.DB, logger *logger.Logger) *orderController.Controller {
 	mRepository := orderRepository.Repository{Store: db, Logger: logger}
-	service := orderService.Service{OrderRepository: &mRepository}
+	publisher := WebhookService(db, logger)
+	service := orderService.Service{OrderRepository: &mRepository, Events: webhookService.Events{Publisher: &publisher, Transactor: db}}
 	return &orderController.Controller{OrderService: service}
 }
@@ -0,0 +1,28 @@
+// Package adapter is a layer that connects the infrastructure with the application layer
+package adapter
+
+import (
+	privacyService "github.com/Raj63/golang-rest-api/pkg/app/usecases/privacy"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/logger"
+	customerRepository "github.com/Raj63/golang-rest-api/pkg/infrastructure/repository/customer"
+	dinerRepository "github.com/Raj63/golang-rest-api/pkg/infrastructure/repository/diner"
+	orderRepository "github.com/Raj63/golang-rest-api/pkg/infrastructure/repository/order"
+	privacyRepository "github.com/Raj63/golang-rest-api/pkg/infrastructure/repository/privacy"
+	privacyController "github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers/privacy"
+	sdksql "github.com/Raj63/golang-rest-api/pkg/infrastructure/sql"
+)
+
+// PrivacyAdapter is a function that returns a privacy controller
+func PrivacyAdapter(db *sdksql.DB, logger *logger.Logger) *privacyController.Controller {
+	return &privacyController.Controller{PrivacyService: PrivacyService(db, logger)}
+}
+
+// PrivacyService is a function that returns the privacy use case, shared by the admin endpoints and the command line
+func PrivacyService(db *sdksql.DB, logger *logger.Logger) privacyService.Service {
+	return privacyService.Service{
+		PrivacyRepository:  &privacyRepository.Repository{Store: db, Logger: logger},
+		DinerRepository:    &dinerRepository.Repository{Store: db, Logger: logger},
+		OrderRepository:    &orderRepository.Repository{Store: db, Logger: logger},
+		CustomerRepository: &customerRepository.Repository{Store: db, Logger: logger},
+	}
+}
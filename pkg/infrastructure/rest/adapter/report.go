@@ -0,0 +1,23 @@
+// Package adapter is a layer that connects the infrastructure with the application layer
+package adapter
+
+import (
+	reportService "github.com/Raj63/golang-rest-api/pkg/app/usecases/report"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/logger"
+	reportRepository "github.com/Raj63/golang-rest-api/pkg/infrastructure/repository/report"
+	reportController "github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers/report"
+	sdksql "github.com/Raj63/golang-rest-api/pkg/infrastructure/sql"
+)
+
+// ReportAdapter is a function that returns a report controller
+func ReportAdapter(db *sdksql.DB, logger *logger.Logger) *reportController.Controller {
+	return &reportController.Controller{ReportService: ReportService(db, logger)}
+}
+
+// ReportService is a function that returns the report use case, shared by the report endpoints, the background
+// refresh of the sales aggregates and the command line
+func ReportService(db *sdksql.DB, logger *logger.Logger) reportService.Service {
+	return reportService.Service{
+		ReportRepository: &reportRepository.Repository{Store: db, Logger: logger},
+	}
+}
@@ -0,0 +1,19 @@
+// Package adapter is a layer that connects the infrastructure with the application layer
+package adapter
+
+import (
+	reservationService "github.com/Raj63/golang-rest-api/pkg/app/usecases/reservation"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/logger"
+	reservationRepository "github.com/Raj63/golang-rest-api/pkg/infrastructure/repository/reservation"
+	tableRepository "github.com/Raj63/golang-rest-api/pkg/infrastructure/repository/table"
+	reservationController "github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers/reservation"
+	sdksql "github.com/Raj63/golang-rest-api/pkg/infrastructure/sql"
+)
+
+// ReservationAdapter is a function that returns a reservation controller
+func ReservationAdapter(db *sdksql.DB, logger *logger.Logger) *reservationController.Controller {
+	rRepository := reservationRepository.Repository{Store: db, Logger: logger}
+	tRepository := tableRepository.Repository{Store: db, Logger: logger}
+	service := reservationService.Service{ReservationRepository: &rRepository, TableRepository: &tRepository}
+	return &reservationController.Controller{ReservationService: service}
+}
@@ -0,0 +1,18 @@
+// Package adapter is a layer that connects the infrastructure with the application layer
+package adapter
+
+import (
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/logger"
+	v2Controller "github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers/v2"
+	sdksql "github.com/Raj63/golang-rest-api/pkg/infrastructure/sql"
+)
+
+// V2Adapter is a function that returns the v2 controller over the same menu, diner and order use cases as the v1
+// controllers
+func V2Adapter(db *sdksql.DB, logger *logger.Logger) *v2Controller.Controller {
+	return &v2Controller.Controller{
+		MenuService:  MenuService(db, logger),
+		DinerService: DinerAdapter(db, logger).DinerService,
+		OrderService: OrderAdapter(db, logger).OrderService,
+	}
+}
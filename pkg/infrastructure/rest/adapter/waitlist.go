@@ -0,0 +1,27 @@
+// Package adapter is a layer that connects the infrastructure with the application layer
+package adapter
+
+import (
+	waitlistService "github.com/Raj63/golang-rest-api/pkg/app/usecases/waitlist"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/logger"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/notifier"
+	reservationRepository "github.com/Raj63/golang-rest-api/pkg/infrastructure/repository/reservation"
+	tableRepository "github.com/Raj63/golang-rest-api/pkg/infrastructure/repository/table"
+	waitlistRepository "github.com/Raj63/golang-rest-api/pkg/infrastructure/repository/waitlist"
+	waitlistController "github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers/waitlist"
+	sdksql "github.com/Raj63/golang-rest-api/pkg/infrastructure/sql"
+)
+
+// WaitlistAdapter is a function that returns a waitlist controller
+func WaitlistAdapter(db *sdksql.DB, logger *logger.Logger) *waitlistController.Controller {
+	wRepository := waitlistRepository.Repository{Store: db, Logger: logger}
+	rRepository := reservationRepository.Repository{Store: db, Logger: logger}
+	tRepository := tableRepository.Repository{Store: db, Logger: logger}
+	service := waitlistService.Service{
+		WaitlistRepository:    &wRepository,
+		ReservationRepository: &rRepository,
+		TableRepository:       &tRepository,
+		Notifier:              &notifier.LogNotifier{Logger: logger},
+	}
+	return &waitlistController.Controller{WaitlistService: service}
+}
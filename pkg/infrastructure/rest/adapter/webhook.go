@@ -0,0 +1,21 @@
+// Package adapter is a layer that connects the infrastructure with the application layer
+package adapter
+
+import (
+	webhookService "github.com/Raj63/golang-rest-api/pkg/app/usecases/webhook"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/logger"
+	webhookRepository "github.com/Raj63/golang-rest-api/pkg/infrastructure/repository/webhook"
+	webhookController "github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers/webhook"
+	sdksql "github.com/Raj63/golang-rest-api/pkg/infrastructure/sql"
+)
+
+// WebhookAdapter is a function that returns a webhook controller
+func WebhookAdapter(db *sdksql.DB, logger *logger.Logger) *webhookController.Controller {
+	return &webhookController.Controller{WebhookService: WebhookService(db, logger)}
+}
+
+// WebhookService is a function that returns the webhook use case, shared by the webhook endpoints, the order and menu
+// use cases publishing their events and the background delivery of the due deliveries
+func WebhookService(db *sdksql.DB, logger *logger.Logger) webhookService.Service {
+	return webhookService.Service{WebhookRepository: &webhookRepository.Repository{Store: db, Logger: logger}}
+}
@@ -4,21 +4,55 @@ package controllers
 import (
 	"bytes"
 	"encoding/json"
-	"github.com/gin-gonic/gin"
+	"errors"
 	"io"
+
+	domainErrors "github.com/Raj63/golang-rest-api/pkg/domain/errors"
+	"github.com/gin-gonic/gin"
 )
 
-// BindJSON is a function that binds the request body to the given struct and rewrite the request body on the context
+// BindJSON is a function that binds the request body to the given struct, validates it against its binding tags and
+// rewrite the request body on the context. The invalid fields are returned in a ValidationError, whose messages are
+// in the language of the Accept-Language of the request when there is a catalog for it.
 func BindJSON(c *gin.Context, request interface{}) (err error) {
 	buf := make([]byte, 5120)
 	num, _ := c.Request.Body.Read(buf)
 	reqBody := string(buf[0:num])
 	c.Request.Body = io.NopCloser(bytes.NewBuffer([]byte(reqBody)))
-	err = c.ShouldBindJSON(request)
+	language := MessageLanguage(AcceptLanguages(c))
+	err = decodeJSON(c.Request.Body, request, language)
+	if err == nil {
+		err = Validate(c.Request.Context(), request, language)
+	}
+	if err != nil && language != DefaultMessageLanguage {
+		c.Header("Content-Language", language)
+	}
 	c.Request.Body = io.NopCloser(bytes.NewBuffer([]byte(reqBody)))
 	return
 }
 
+// BindJSONLimit is a function that binds the request body to the given struct and validates it as BindJSON does,
+// reading the whole body as long as it is at most limit bytes rather than its first 5KB only
+func BindJSONLimit(c *gin.Context, request interface{}, limit int64) (err error) {
+	reqBody, err := io.ReadAll(io.LimitReader(c.Request.Body, limit+1))
+	c.Request.Body = io.NopCloser(bytes.NewBuffer(reqBody))
+	language := MessageLanguage(AcceptLanguages(c))
+	switch {
+	case err != nil:
+	case int64(len(reqBody)) > limit:
+		err = domainErrors.NewAppError(errors.New(message(language, msgBodyTooLarge, limit)), domainErrors.ValidationError)
+	default:
+		err = decodeJSON(bytes.NewReader(reqBody), request, language)
+	}
+	if err == nil {
+		err = Validate(c.Request.Context(), request, language)
+	}
+	if err != nil && language != DefaultMessageLanguage {
+		c.Header("Content-Language", language)
+	}
+	return
+}
+
 // BindJSONMap is a function that binds the request body to the given map and rewrite the request body on the context
 func BindJSONMap(c *gin.Context, request *map[string]interface{}) (err error) {
 	buf := make([]byte, 5120)
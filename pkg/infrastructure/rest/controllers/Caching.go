@@ -0,0 +1,17 @@
+package controllers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SetLastModified is a function that sets the Last-Modified header to the updated_at of the resource, which the
+// ConditionalGET middleware compares to the If-Modified-Since of the request
+func SetLastModified(c *gin.Context, updatedAt time.Time) {
+	if updatedAt.IsZero() {
+		return
+	}
+	c.Header("Last-Modified", updatedAt.UTC().Format(http.TimeFormat))
+}
@@ -0,0 +1,76 @@
+package controllers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	domainErrors "github.com/Raj63/golang-rest-api/pkg/domain/errors"
+	"github.com/gin-gonic/gin"
+)
+
+// BindFields is a function that reads the comma separated fields query param, accepting only the allowed json
+// fields of the listed resource
+func BindFields(c *gin.Context, allowed []string) ([]string, error) {
+	fieldsStr := strings.TrimSpace(c.Query("fields"))
+	if fieldsStr == "" {
+		return nil, nil
+	}
+
+	var fields []string
+	for _, field := range strings.Split(fieldsStr, ",") {
+		field = strings.TrimSpace(field)
+		if !contains(allowed, field) {
+			return nil, domainErrors.NewAppError(fmt.Errorf("param fields has unknown field %q, use any of %s", field, strings.Join(allowed, ", ")), domainErrors.ValidationError)
+		}
+		if !contains(fields, field) {
+			fields = append(fields, field)
+		}
+	}
+	return fields, nil
+}
+
+// SparseFieldset is a function that trims every item of the Data of a page down to the requested fields, the page
+// is returned untouched when no fields are requested
+func SparseFieldset(page interface{}, fields []string) (interface{}, error) {
+	if len(fields) == 0 {
+		return page, nil
+	}
+
+	body, err := json.Marshal(page)
+	if err != nil {
+		return nil, err
+	}
+	var result map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err = decoder.Decode(&result); err != nil {
+		return nil, err
+	}
+
+	items, _ := result["Data"].([]interface{})
+	for i, item := range items {
+		full, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		trimmed := make(map[string]interface{}, len(fields))
+		for _, field := range fields {
+			if value, ok := full[field]; ok {
+				trimmed[field] = value
+			}
+		}
+		items[i] = trimmed
+	}
+	return result, nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
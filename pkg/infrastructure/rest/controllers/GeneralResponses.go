@@ -1,6 +1,8 @@
 // Package controllers contains the common functions and structures for the controllers
 package controllers
 
+import domainErrors "github.com/Raj63/golang-rest-api/pkg/domain/errors"
+
 // JSONSwagger is a struct that contains the swagger documentation
 type JSONSwagger struct {
 }
@@ -9,3 +11,19 @@ type JSONSwagger struct {
 type MessageResponse struct {
 	Message string `json:"message"`
 }
+
+// ProblemMIME is the media type of the RFC 7807 problem details of the errors
+const ProblemMIME = "application/problem+json"
+
+// ProblemDetails is a struct that contains the RFC 7807 problem details of an error response, extended with a stable
+// code, the trace id of the request and the invalid fields of a validation error
+type ProblemDetails struct {
+	Type     string                    `json:"type" example:"about:blank"`
+	Title    string                    `json:"title" example:"Bad Request"`
+	Status   int                       `json:"status" example:"400"`
+	Detail   string                    `json:"detail,omitempty" example:"price must be a positive amount with at most 2 decimals"`
+	Instance string                    `json:"instance" example:"/v1/menus"`
+	Code     string                    `json:"code" example:"validation_error"`
+	TraceID  string                    `json:"trace_id" example:"4bf92f3577b34da6a3ce929d0e0e4736"`
+	Errors   []domainErrors.FieldError `json:"errors,omitempty"`
+}
@@ -0,0 +1,59 @@
+// Package controllers contains the common functions and structures for the controllers
+package controllers
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AcceptLanguages is a function that returns the lower-cased language tags of the Accept-Language header of a request,
+// sorted by preference. The wildcard and the languages refused with q=0 are left out.
+func AcceptLanguages(c *gin.Context) []string {
+	type acceptLanguage struct {
+		tag     string
+		quality float64
+	}
+	var accepted []acceptLanguage
+	for _, part := range strings.Split(c.GetHeader("Accept-Language"), ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		quality := 1.0
+		if params = strings.TrimSpace(params); strings.HasPrefix(params, "q=") {
+			if parsed, err := strconv.ParseFloat(params[len("q="):], 64); err == nil {
+				quality = parsed
+			}
+		}
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" || tag == "*" || quality <= 0 {
+			continue
+		}
+		accepted = append(accepted, acceptLanguage{tag: tag, quality: quality})
+	}
+	// the languages of the same quality keep the order they are listed in
+	sort.SliceStable(accepted, func(i, j int) bool { return accepted[i].quality > accepted[j].quality })
+
+	languages := make([]string, len(accepted))
+	for i := range accepted {
+		languages[i] = accepted[i].tag
+	}
+	return languages
+}
+
+// SetContentLanguage is a function that sets the Content-Language of a response to the distinct languages of its
+// content, and marks it as varying with the Accept-Language of the request for the caches
+func SetContentLanguage(c *gin.Context, languages ...string) {
+	var distinct []string
+	seen := map[string]bool{}
+	for _, language := range languages {
+		if language != "" && !seen[language] {
+			seen[language] = true
+			distinct = append(distinct, language)
+		}
+	}
+	if len(distinct) > 0 {
+		c.Header("Content-Language", strings.Join(distinct, ", "))
+	}
+	c.Writer.Header().Add("Vary", "Accept-Language")
+}
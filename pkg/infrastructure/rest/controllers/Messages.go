@@ -0,0 +1,171 @@
+// Package controllers contains the common functions and structures for the controllers
+package controllers
+
+import (
+	"fmt"
+	"strings"
+
+	domainMenu "github.com/Raj63/golang-rest-api/pkg/domain/menu"
+)
+
+// DefaultMessageLanguage is the language of the validation messages when the request accepts none of the catalogs
+const DefaultMessageLanguage = "en"
+
+// The keys of the validation messages in the catalogs, mostly named after the rule that failed
+const (
+	msgRequired        = "required"
+	msgRequiredWithout = "required_without"
+	msgRequiredWith    = "required_with"
+	msgExcludedWith    = "excluded_with"
+	msgGtField         = "gtfield"
+	msgLtField         = "ltfield"
+	msgMin             = "min"
+	msgMinLength       = "min_length"
+	msgMax             = "max"
+	msgMaxLength       = "max_length"
+	msgGt              = "gt"
+	msgOneOf           = "oneof"
+	msgEmail           = "email"
+	msgPrice           = rulePrice
+	msgInvalid         = "invalid"
+	msgType            = "type"
+	msgBodyRequired    = "body_required"
+	msgBodyTooLarge    = "body_too_large"
+	msgKindInteger     = "kind_integer"
+	msgKindNumber      = "kind_number"
+	msgKindBoolean     = "kind_boolean"
+	msgKindString      = "kind_string"
+	msgKindList        = "kind_list"
+	msgKindObject      = "kind_object"
+)
+
+// messageCatalogs are the validation messages by language and key. The messages take the invalid field first and
+// the parameter of the rule second.
+var messageCatalogs = map[string]map[string]string{
+	"en": {
+		msgRequired:        "%s is required",
+		msgRequiredWithout: "%s is required when %s is not given",
+		msgRequiredWith:    "%s is required when %s is given",
+		msgExcludedWith:    "%s must not be given with %s",
+		msgGtField:         "%s must be greater than %s",
+		msgLtField:         "%s must be less than %s",
+		msgMin:             "%s must be at least %s",
+		msgMinLength:       "%s must be at least %s characters",
+		msgMax:             "%s must be at most %s",
+		msgMaxLength:       "%s must be at most %s characters",
+		msgGt:              "%s must be greater than %s",
+		msgOneOf:           "%s must be one of %s",
+		msgEmail:           "%s must be an email address",
+		msgPrice:           "%s must be " + domainMenu.PriceRule,
+		msgInvalid:         "%s is invalid",
+		msgType:            "%s must be %s",
+		msgBodyRequired:    "request body is required",
+		msgBodyTooLarge:    "request body must be at most %d bytes",
+		msgKindInteger:     "an integer",
+		msgKindNumber:      "a number",
+		msgKindBoolean:     "a boolean",
+		msgKindString:      "a string",
+		msgKindList:        "a list",
+		msgKindObject:      "an object",
+	},
+	"fr": {
+		msgRequired:        "%s est obligatoire",
+		msgRequiredWithout: "%s est obligatoire quand %s n'est pas donné",
+		msgRequiredWith:    "%s est obligatoire quand %s est donné",
+		msgExcludedWith:    "%s ne doit pas être donné avec %s",
+		msgGtField:         "%s doit être supérieur à %s",
+		msgLtField:         "%s doit être inférieur à %s",
+		msgMin:             "%s doit être au moins %s",
+		msgMinLength:       "%s doit contenir au moins %s caractères",
+		msgMax:             "%s doit être au plus %s",
+		msgMaxLength:       "%s doit contenir au plus %s caractères",
+		msgGt:              "%s doit être supérieur à %s",
+		msgOneOf:           "%s doit être l'une des valeurs %s",
+		msgEmail:           "%s doit être une adresse e-mail",
+		msgPrice:           "%s doit être un montant positif avec au plus 2 décimales",
+		msgInvalid:         "%s n'est pas valide",
+		msgType:            "%s doit être %s",
+		msgBodyRequired:    "le corps de la requête est obligatoire",
+		msgBodyTooLarge:    "le corps de la requête doit faire au plus %d octets",
+		msgKindInteger:     "un entier",
+		msgKindNumber:      "un nombre",
+		msgKindBoolean:     "un booléen",
+		msgKindString:      "une chaîne",
+		msgKindList:        "une liste",
+		msgKindObject:      "un objet",
+	},
+	"es": {
+		msgRequired:        "%s es obligatorio",
+		msgRequiredWithout: "%s es obligatorio cuando no se indica %s",
+		msgRequiredWith:    "%s es obligatorio cuando se indica %s",
+		msgExcludedWith:    "%s no debe indicarse junto con %s",
+		msgGtField:         "%s debe ser mayor que %s",
+		msgLtField:         "%s debe ser menor que %s",
+		msgMin:             "%s debe ser al menos %s",
+		msgMinLength:       "%s debe tener al menos %s caracteres",
+		msgMax:             "%s debe ser como máximo %s",
+		msgMaxLength:       "%s debe tener como máximo %s caracteres",
+		msgGt:              "%s debe ser mayor que %s",
+		msgOneOf:           "%s debe ser uno de %s",
+		msgEmail:           "%s debe ser una dirección de correo electrónico",
+		msgPrice:           "%s debe ser un importe positivo con como máximo 2 decimales",
+		msgInvalid:         "%s no es válido",
+		msgType:            "%s debe ser %s",
+		msgBodyRequired:    "el cuerpo de la solicitud es obligatorio",
+		msgBodyTooLarge:    "el cuerpo de la solicitud debe tener como máximo %d bytes",
+		msgKindInteger:     "un entero",
+		msgKindNumber:      "un número",
+		msgKindBoolean:     "un booleano",
+		msgKindString:      "una cadena",
+		msgKindList:        "una lista",
+		msgKindObject:      "un objeto",
+	},
+	"de": {
+		msgRequired:        "%s ist erforderlich",
+		msgRequiredWithout: "%s ist erforderlich, wenn %s nicht angegeben ist",
+		msgRequiredWith:    "%s ist erforderlich, wenn %s angegeben ist",
+		msgExcludedWith:    "%s darf nicht zusammen mit %s angegeben werden",
+		msgGtField:         "%s muss größer als %s sein",
+		msgLtField:         "%s muss kleiner als %s sein",
+		msgMin:             "%s muss mindestens %s sein",
+		msgMinLength:       "%s muss mindestens %s Zeichen lang sein",
+		msgMax:             "%s darf höchstens %s sein",
+		msgMaxLength:       "%s darf höchstens %s Zeichen lang sein",
+		msgGt:              "%s muss größer als %s sein",
+		msgOneOf:           "%s muss einer der Werte %s sein",
+		msgEmail:           "%s muss eine E-Mail-Adresse sein",
+		msgPrice:           "%s muss ein positiver Betrag mit höchstens 2 Dezimalstellen sein",
+		msgInvalid:         "%s ist ungültig",
+		msgType:            "%s muss %s sein",
+		msgBodyRequired:    "der Anfragetext ist erforderlich",
+		msgBodyTooLarge:    "der Anfragetext darf höchstens %d Bytes lang sein",
+		msgKindInteger:     "eine ganze Zahl",
+		msgKindNumber:      "eine Zahl",
+		msgKindBoolean:     "ein Wahrheitswert",
+		msgKindString:      "eine Zeichenkette",
+		msgKindList:        "eine Liste",
+		msgKindObject:      "ein Objekt",
+	},
+}
+
+// MessageLanguage is a function that returns the first of languages, sorted by preference, with a catalog of
+// validation messages, matching a language by its base language as fr-ca by fr
+func MessageLanguage(languages []string) string {
+	for _, language := range languages {
+		for _, candidate := range []string{language, strings.SplitN(language, "-", 2)[0]} {
+			if _, ok := messageCatalogs[candidate]; ok {
+				return candidate
+			}
+		}
+	}
+	return DefaultMessageLanguage
+}
+
+// message formats the validation message of a key in a language, or in the default language when it has no catalog
+func message(language, key string, args ...interface{}) string {
+	format, ok := messageCatalogs[language][key]
+	if !ok {
+		format = messageCatalogs[DefaultMessageLanguage][key]
+	}
+	return fmt.Sprintf(format, args...)
+}
@@ -0,0 +1,82 @@
+// Package controllers contains the common functions and structures for the controllers
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	domainErrors "github.com/Raj63/golang-rest-api/pkg/domain/errors"
+	"github.com/gin-gonic/gin"
+)
+
+// PageParams is a struct that contains the pagination query params of a list endpoint
+type PageParams struct {
+	// Page is only set when the client asks for a page number, keyset pages are served otherwise
+	Page      int64
+	Limit     int64
+	Cursor    string
+	WithTotal bool
+	// Sort is the raw sort query param, validated by the use case against the fields of the resource
+	Sort string
+}
+
+// BindPageParams is a function that reads the page, limit, cursor, with_total and sort query params
+func BindPageParams(c *gin.Context) (*PageParams, error) {
+	params := PageParams{Cursor: c.Query("cursor"), Sort: c.Query("sort")}
+	var err error
+
+	if pageStr, ok := c.GetQuery("page"); ok && params.Cursor == "" {
+		params.Page, err = strconv.ParseInt(pageStr, 10, 64)
+		if err != nil || params.Page < 1 {
+			return nil, domainErrors.NewAppError(errors.New("param page is necessary to be an integer"), domainErrors.ValidationError)
+		}
+	}
+	params.Limit, err = strconv.ParseInt(c.DefaultQuery("limit", "20"), 10, 64)
+	if err != nil {
+		return nil, domainErrors.NewAppError(errors.New("param limit is necessary to be an integer"), domainErrors.ValidationError)
+	}
+	if params.Limit < 1 {
+		return nil, domainErrors.NewAppError(errors.New("param limit is necessary to be positive"), domainErrors.ValidationError)
+	}
+	if withTotalStr := c.Query("with_total"); withTotalStr != "" {
+		params.WithTotal, err = strconv.ParseBool(withTotalStr)
+		if err != nil {
+			return nil, domainErrors.NewAppError(errors.New("param with_total is necessary to be a boolean"), domainErrors.ValidationError)
+		}
+	}
+
+	return &params, nil
+}
+
+// SetLinkHeader is a function that sets the RFC 8288 Link header to the first, next and previous keyset pages of
+// a list, keeping the other query params of the request
+func SetLinkHeader(c *gin.Context, nextCursor, prevCursor string) {
+	link := func(cursor, rel string) string {
+		return fmt.Sprintf(`<%s>; rel="%s"`, PageURL(c, cursor), rel)
+	}
+
+	links := []string{link("", "first")}
+	if nextCursor != "" {
+		links = append(links, link(nextCursor, "next"))
+	}
+	if prevCursor != "" {
+		links = append(links, link(prevCursor, "prev"))
+	}
+	c.Header("Link", strings.Join(links, ", "))
+}
+
+// PageURL is a function that returns the URL of the keyset page of cursor, or of the first page when cursor is empty,
+// keeping the other query params of the request
+func PageURL(c *gin.Context, cursor string) string {
+	query := c.Request.URL.Query()
+	query.Del("page")
+	query.Del("cursor")
+	if cursor != "" {
+		query.Set("cursor", cursor)
+	}
+	target := url.URL{Path: c.Request.URL.Path, RawQuery: query.Encode()}
+	return target.String()
+}
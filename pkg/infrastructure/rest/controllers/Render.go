@@ -0,0 +1,55 @@
+package controllers
+
+import (
+	"encoding/xml"
+	"reflect"
+
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rpc/mappers"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/gin-gonic/gin/render"
+)
+
+// RenderFormats are the content types Render negotiates with the Accept header, JSON being served when the client
+// accepts none of them
+var RenderFormats = []string{
+	binding.MIMEJSON,
+	binding.MIMEMSGPACK2,
+	binding.MIMEMSGPACK,
+	binding.MIMEXML,
+	binding.MIMEXML2,
+	binding.MIMEPROTOBUF,
+}
+
+// xmlList is the root element of the lists rendered as XML, which would have no single root otherwise
+type xmlList struct {
+	XMLName xml.Name    `xml:"items"`
+	Items   interface{} `xml:"item"`
+}
+
+// Render is a function that writes the response in the format negotiated with the Accept header: JSON, MessagePack,
+// XML or protobuf. The responses with no XML or protobuf form, as maps and messages, fall back to JSON.
+func Render(c *gin.Context, status int, obj interface{}) {
+	c.Writer.Header().Add("Vary", "Accept")
+
+	switch c.NegotiateFormat(RenderFormats...) {
+	case binding.MIMEMSGPACK, binding.MIMEMSGPACK2:
+		c.Render(status, render.MsgPack{Data: obj})
+		return
+	case binding.MIMEXML, binding.MIMEXML2:
+		value := obj
+		if kind := reflect.ValueOf(obj).Kind(); kind == reflect.Slice || kind == reflect.Array {
+			value = xmlList{Items: obj}
+		}
+		if body, err := xml.Marshal(value); err == nil {
+			c.Data(status, binding.MIMEXML+"; charset=utf-8", append([]byte(xml.Header), body...))
+			return
+		}
+	case binding.MIMEPROTOBUF:
+		if message, ok := mappers.Message(obj); ok {
+			c.ProtoBuf(status, message)
+			return
+		}
+	}
+	c.JSON(status, obj)
+}
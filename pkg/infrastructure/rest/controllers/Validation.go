@@ -0,0 +1,159 @@
+// Package controllers contains the common functions and structures for the controllers
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+
+	domainErrors "github.com/Raj63/golang-rest-api/pkg/domain/errors"
+	domainMenu "github.com/Raj63/golang-rest-api/pkg/domain/menu"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+)
+
+// The custom rules of the binding tags of the requests, besides those of the validator
+const (
+	// rulePrice accepts the prices a menu can be sold at, positive and in whole cents
+	rulePrice = "price"
+)
+
+func init() {
+	validate, ok := binding.Validator.Engine().(*validator.Validate)
+	if !ok {
+		return
+	}
+	// the invalid fields of a request are named as the clients send them, by their json name
+	validate.RegisterTagNameFunc(func(field reflect.StructField) string {
+		return jsonName(field)
+	})
+	_ = validate.RegisterValidation(rulePrice, func(fl validator.FieldLevel) bool {
+		return domainMenu.ValidPrice(fl.Field().Float())
+	})
+}
+
+// Validate is a function that checks a request against the rules of its binding tags, returning a ValidationError
+// with a human-readable message in language for each invalid field
+func Validate(ctx context.Context, request interface{}, language string) error {
+	validate, ok := binding.Validator.Engine().(*validator.Validate)
+	if !ok {
+		return binding.Validator.ValidateStruct(request)
+	}
+
+	err := validate.StructCtx(ctx, request)
+	var validationErrs validator.ValidationErrors
+	if !errors.As(err, &validationErrs) {
+		return err
+	}
+
+	fields := make([]domainErrors.FieldError, len(validationErrs))
+	for i, fieldErr := range validationErrs {
+		fields[i] = domainErrors.FieldError{Field: fieldErr.Field(), Message: validationMessage(request, fieldErr, language)}
+	}
+	return domainErrors.NewValidationError(fields...)
+}
+
+// decodeJSON reads a request body into request, with a ValidationError in language naming the field of a value of the
+// wrong type
+func decodeJSON(body io.Reader, request interface{}, language string) error {
+	err := json.NewDecoder(body).Decode(request)
+	if errors.Is(err, io.EOF) {
+		return domainErrors.NewAppError(errors.New(message(language, msgBodyRequired)), domainErrors.ValidationError)
+	}
+
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) && typeErr.Field != "" {
+		return domainErrors.NewValidationError(domainErrors.FieldError{
+			Field:   typeErr.Field,
+			Message: message(language, msgType, typeErr.Field, message(language, jsonKind(typeErr.Type))),
+		})
+	}
+	return err
+}
+
+// validationMessage describes the failed rule of a field of request in language
+func validationMessage(request interface{}, fieldErr validator.FieldError, language string) string {
+	field, param := fieldErr.Field(), fieldErr.Param()
+	switch fieldErr.Tag() {
+	case "required":
+		return message(language, msgRequired, field)
+	case "required_without":
+		return message(language, msgRequiredWithout, field, paramName(request, param))
+	case "required_with":
+		return message(language, msgRequiredWith, field, paramName(request, param))
+	case "excluded_with":
+		return message(language, msgExcludedWith, field, paramName(request, param))
+	case "gtfield":
+		return message(language, msgGtField, field, paramName(request, param))
+	case "ltfield":
+		return message(language, msgLtField, field, paramName(request, param))
+	case "min", "gte":
+		if fieldErr.Kind() == reflect.String {
+			return message(language, msgMinLength, field, param)
+		}
+		return message(language, msgMin, field, param)
+	case "max", "lte":
+		if fieldErr.Kind() == reflect.String {
+			return message(language, msgMaxLength, field, param)
+		}
+		return message(language, msgMax, field, param)
+	case "gt":
+		return message(language, msgGt, field, param)
+	case "oneof":
+		return message(language, msgOneOf, field, strings.Join(strings.Fields(param), ", "))
+	case "email":
+		return message(language, msgEmail, field)
+	case rulePrice:
+		return message(language, msgPrice, field)
+	default:
+		return message(language, msgInvalid, field)
+	}
+}
+
+// paramName returns the json name of the field of request a cross-field rule refers to by its Go name
+func paramName(request interface{}, param string) string {
+	requestType := reflect.TypeOf(request)
+	for requestType.Kind() == reflect.Ptr {
+		requestType = requestType.Elem()
+	}
+	if requestType.Kind() == reflect.Struct {
+		if field, ok := requestType.FieldByName(param); ok {
+			return jsonName(field)
+		}
+	}
+	return param
+}
+
+func jsonName(field reflect.StructField) string {
+	name := strings.SplitN(field.Tag.Get("json"), ",", 2)[0]
+	switch name {
+	case "-":
+		return ""
+	case "":
+		return field.Name
+	default:
+		return name
+	}
+}
+
+// jsonKind returns the message key naming the JSON type of the values a Go type is read from
+func jsonKind(goType reflect.Type) string {
+	switch goType.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return msgKindInteger
+	case reflect.Float32, reflect.Float64:
+		return msgKindNumber
+	case reflect.Bool:
+		return msgKindBoolean
+	case reflect.String:
+		return msgKindString
+	case reflect.Slice, reflect.Array:
+		return msgKindList
+	default:
+		return msgKindObject
+	}
+}
@@ -0,0 +1,211 @@
+// Package batch contains the batch controller
+package batch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	domainErrors "github.com/Raj63/golang-rest-api/pkg/domain/errors"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers"
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	// MaxBodySize is the largest body of a batch request, in bytes
+	MaxBodySize int64 = 1 << 20
+	// MaxTransactionDuration is the longest a transactional batch holds its transaction: the operations not run by
+	// then are not run and the transaction is rolled back
+	MaxTransactionDuration = 10 * time.Second
+)
+
+// batchPath is the path of the batch route, which cannot be an operation of a batch
+const batchPath = "/v1/batch"
+
+// errOperationFailed rolls back the transaction of a transactional batch whose operation failed or that ran out of
+// time
+var errOperationFailed = errors.New("batch operation failed")
+
+// Transactor runs a function with a context carrying a database transaction, that all the queries run with the
+// context are part of, and commits the transaction when the function succeeds
+type Transactor interface {
+	Transaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// Controller is a struct that contains the router the operations are dispatched through and the transactor of the
+// transactional batches
+type Controller struct {
+	Handler    http.Handler
+	Transactor Transactor
+}
+
+// Batch godoc
+//
+//	@Tags			batch
+//	@Summary		Run a batch of requests
+//	@Description	Run up to 50 requests to the v1 and v2 routes at once, one after the other, with the headers of the batch request, authentication and rate limits included. The batch requires a token as the admin routes. The response holds the status, headers and body of each operation. A transactional batch runs all its operations in a single database transaction, which is rolled back when an operation fails or the transaction is held for longer than 10 seconds: the operations not run have the 424 status.
+//	@Accept			json
+//	@Produce		json
+//	@Param			data	body		BatchRequest	true	"body data"
+//	@Success		200		{object}	BatchResponse
+//	@Failure		400		{object}	controllers.ProblemDetails
+//	@Failure		401		{object}	controllers.ProblemDetails
+//	@Failure		500		{object}	controllers.ProblemDetails
+//	@Router			/batch [post]
+func (c *Controller) Batch(ctx *gin.Context) {
+	var request BatchRequest
+
+	if err := controllers.BindJSONLimit(ctx, &request, MaxBodySize); err != nil {
+		appError := domainErrors.NewAppError(err, domainErrors.ValidationError)
+		_ = ctx.Error(appError)
+		return
+	}
+	if err := validatePaths(request.Operations); err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	response := BatchResponse{Results: make([]OperationResponse, 0, len(request.Operations))}
+	if !request.Transactional {
+		for _, operation := range request.Operations {
+			response.Results = append(response.Results, c.dispatch(ctx.Request.Context(), ctx.Request, operation))
+		}
+		ctx.JSON(http.StatusOK, response)
+		return
+	}
+
+	deadlineCtx, cancel := context.WithTimeout(ctx.Request.Context(), MaxTransactionDuration)
+	defer cancel()
+	err := c.Transactor.Transaction(deadlineCtx, func(txCtx context.Context) error {
+		for _, operation := range request.Operations {
+			if txCtx.Err() != nil {
+				return errOperationFailed
+			}
+			result := c.dispatch(txCtx, ctx.Request, operation)
+			response.Results = append(response.Results, result)
+			if result.Status >= http.StatusBadRequest {
+				return errOperationFailed
+			}
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errOperationFailed) {
+		_ = ctx.Error(err)
+		return
+	}
+	committed := err == nil
+	response.Committed = &committed
+	for _, operation := range request.Operations[len(response.Results):] {
+		response.Results = append(response.Results, OperationResponse{ID: operation.ID, Status: http.StatusFailedDependency})
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+// validatePaths checks the operations are requests to the v1 and v2 routes, other than the batch route
+func validatePaths(operations []OperationRequest) error {
+	var fields []domainErrors.FieldError
+	for i, operation := range operations {
+		field := fmt.Sprintf("operations[%d].path", i)
+		target, err := url.Parse(operation.Path)
+		switch {
+		case err != nil || target.Scheme != "" || target.Host != "":
+			fields = append(fields, domainErrors.FieldError{Field: field, Message: field + " must be a path, as /v1/menus/"})
+		case !strings.HasPrefix(target.Path, "/v1/") && !strings.HasPrefix(target.Path, "/v2/"):
+			fields = append(fields, domainErrors.FieldError{Field: field, Message: field + " must be the path of a v1 or v2 route"})
+		case path.Clean(target.Path) == batchPath:
+			fields = append(fields, domainErrors.FieldError{Field: field, Message: field + " must not be the batch route"})
+		}
+	}
+	if len(fields) > 0 {
+		return domainErrors.NewValidationError(fields...)
+	}
+	return nil
+}
+
+// dispatch serves an operation through the router, with the headers and the client address of the batch request for
+// the operation to be authenticated and rate limited as if it was sent on its own, and the context carrying the
+// transaction of a transactional batch
+func (c *Controller) dispatch(ctx context.Context, batch *http.Request, operation OperationRequest) OperationResponse {
+	result := OperationResponse{ID: operation.ID}
+
+	var body []byte
+	if operation.Body != nil {
+		var err error
+		if body, err = json.Marshal(operation.Body); err != nil {
+			result.Status = http.StatusBadRequest
+			result.Body = err.Error()
+			return result
+		}
+	}
+	request, err := http.NewRequestWithContext(ctx, operation.Method, operation.Path, bytes.NewReader(body))
+	if err != nil {
+		result.Status = http.StatusBadRequest
+		result.Body = err.Error()
+		return result
+	}
+	request.Header = batch.Header.Clone()
+	request.Header.Del("Content-Length")
+	// the bodies are embedded in the response as JSON, uncompressed
+	request.Header.Del("Accept-Encoding")
+	request.Header.Set("Accept", "application/json")
+	if body != nil {
+		request.Header.Set("Content-Type", "application/json")
+	} else {
+		request.Header.Del("Content-Type")
+	}
+	request.Host = batch.Host
+	request.RemoteAddr = batch.RemoteAddr
+
+	recorder := &responseRecorder{header: http.Header{}}
+	c.Handler.ServeHTTP(recorder, request)
+
+	result.Status = recorder.status
+	if result.Status == 0 {
+		result.Status = http.StatusOK
+	}
+	if len(recorder.header) > 0 {
+		result.Headers = make(map[string]string, len(recorder.header))
+		for name, values := range recorder.header {
+			result.Headers[name] = strings.Join(values, ", ")
+		}
+	}
+	switch {
+	case recorder.body.Len() == 0:
+	case json.Valid(recorder.body.Bytes()):
+		result.Body = json.RawMessage(recorder.body.Bytes())
+	default:
+		result.Body = recorder.body.String()
+	}
+	return result
+}
+
+// responseRecorder is the http.ResponseWriter an operation is answered into
+type responseRecorder struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (r *responseRecorder) Header() http.Header {
+	return r.header
+}
+
+func (r *responseRecorder) Write(data []byte) (int, error) {
+	if r.status == 0 {
+		r.status = http.StatusOK
+	}
+	return r.body.Write(data)
+}
+
+func (r *responseRecorder) WriteHeader(status int) {
+	if r.status == 0 {
+		r.status = status
+	}
+}
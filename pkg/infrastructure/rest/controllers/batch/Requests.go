@@ -0,0 +1,20 @@
+// Package batch contains the batch controller
+package batch
+
+// BatchRequest is a struct that contains the operations of a batch request, in the order they are run
+type BatchRequest struct {
+	// Transactional runs all the operations in a single database transaction, rolled back when one of them fails
+	Transactional bool               `json:"transactional" example:"true"`
+	Operations    []OperationRequest `json:"operations" binding:"required,min=1,max=50,dive"`
+}
+
+// OperationRequest is a struct that contains one of the requests of a batch
+type OperationRequest struct {
+	// ID is given back in the result of the operation, for the client to match them
+	ID     string `json:"id" example:"order-1" binding:"max=64"`
+	Method string `json:"method" example:"POST" binding:"required,oneof=GET POST PUT PATCH DELETE"`
+	// Path is the path of a v1 or v2 route, with its query string if any
+	Path string `json:"path" example:"/v1/orders/" binding:"required,max=2048"`
+	// Body is the JSON body of the operation, if any
+	Body interface{} `json:"body,omitempty"`
+}
@@ -0,0 +1,19 @@
+// Package batch contains the batch controller
+package batch
+
+// BatchResponse is a struct that contains the results of the operations of a batch, in the order of the operations
+type BatchResponse struct {
+	// Committed tells whether the transaction of a transactional batch was committed, it is omitted otherwise
+	Committed *bool               `json:"committed,omitempty" example:"true"`
+	Results   []OperationResponse `json:"results"`
+}
+
+// OperationResponse is a struct that contains the response to one of the operations of a batch. The operations not
+// run, as those after a failed operation of a transactional batch, have the 424 status.
+type OperationResponse struct {
+	ID      string            `json:"id,omitempty" example:"order-1"`
+	Status  int               `json:"status" example:"201"`
+	Headers map[string]string `json:"headers,omitempty"`
+	// Body is the JSON the operation was answered with, or the text when it is not JSON
+	Body interface{} `json:"body,omitempty"`
+}
@@ -0,0 +1,276 @@
+// Package customer contains the customer controller
+package customer
+
+import (
+	"errors"
+
+	useCaseCustomer "github.com/Raj63/golang-rest-api/pkg/app/usecases/customer"
+	domainCustomer "github.com/Raj63/golang-rest-api/pkg/domain/customer"
+	domainErrors "github.com/Raj63/golang-rest-api/pkg/domain/errors"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers"
+
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Controller is a struct that contains the customer service
+type Controller struct {
+	CustomerService useCaseCustomer.Service
+}
+
+// NewCustomer godoc
+//
+//	@Tags			customers
+//	@Summary		Create New Customer
+//	@Description	Create a customer profile that earns loyalty points
+//	@Accept			json
+//	@Produce		json
+//	@Param			data	body		NewCustomerRequest	true	"body data"
+//	@Success		201		{object}	domainCustomer.Customer
+//	@Failure		400		{object}	controllers.ProblemDetails
+//	@Failure		409		{object}	controllers.ProblemDetails
+//	@Failure		500		{object}	controllers.ProblemDetails
+//	@Router			/customers/ [post]
+func (c *Controller) NewCustomer(ctx *gin.Context) {
+	var request NewCustomerRequest
+
+	if err := controllers.BindJSON(ctx, &request); err != nil {
+		appError := domainErrors.NewAppError(err, domainErrors.ValidationError)
+		_ = ctx.Error(appError)
+		return
+	}
+	newCustomer := useCaseCustomer.NewCustomer{
+		Name:        request.Name,
+		Email:       request.Email,
+		Phone:       request.Phone,
+		Preferences: request.Preferences,
+	}
+
+	var result *domainCustomer.Customer
+	var err error
+
+	result, err = c.CustomerService.Create(ctx.Request.Context(), &newCustomer)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, result)
+}
+
+// GetCustomerByID godoc
+//
+//	@Tags			customers
+//	@Summary		Get customer by ID
+//	@Description	Get a customer profile with their membership level and points balance
+//	@Param			customer_id	path		int64	true	"id of customer"
+//	@Success		200			{object}	domainCustomer.Customer
+//	@Failure		400			{object}	controllers.ProblemDetails
+//	@Failure		404			{object}	controllers.ProblemDetails
+//	@Failure		500			{object}	controllers.ProblemDetails
+//	@Router			/customers/{customer_id} [get]
+func (c *Controller) GetCustomerByID(ctx *gin.Context) {
+	customerID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		appError := domainErrors.NewAppError(errors.New("customer id is invalid"), domainErrors.ValidationError)
+		_ = ctx.Error(appError)
+		return
+	}
+
+	customer, err := c.CustomerService.GetByID(ctx.Request.Context(), customerID)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, customer)
+}
+
+// UpdateCustomer godoc
+//
+//	@Tags			customers
+//	@Summary		Update customer profile
+//	@Description	Change the contact details or preferences of a customer
+//	@Accept			json
+//	@Produce		json
+//	@Param			customer_id	path		int64					true	"id of customer"
+//	@Param			data		body		UpdateCustomerRequest	true	"body data"
+//	@Success		200			{object}	domainCustomer.Customer
+//	@Failure		400			{object}	controllers.ProblemDetails
+//	@Failure		404			{object}	controllers.ProblemDetails
+//	@Failure		409			{object}	controllers.ProblemDetails
+//	@Failure		500			{object}	controllers.ProblemDetails
+//	@Router			/customers/{customer_id} [patch]
+func (c *Controller) UpdateCustomer(ctx *gin.Context) {
+	customerID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		appError := domainErrors.NewAppError(errors.New("param customer id is necessary in the url"), domainErrors.ValidationError)
+		_ = ctx.Error(appError)
+		return
+	}
+
+	var request UpdateCustomerRequest
+	if err := controllers.BindJSON(ctx, &request); err != nil {
+		appError := domainErrors.NewAppError(err, domainErrors.ValidationError)
+		_ = ctx.Error(appError)
+		return
+	}
+
+	customer, err := c.CustomerService.Update(ctx.Request.Context(), customerID, &useCaseCustomer.UpdateCustomer{
+		Name:        request.Name,
+		Email:       request.Email,
+		Phone:       request.Phone,
+		Preferences: request.Preferences,
+	})
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, customer)
+}
+
+// LinkSession godoc
+//
+//	@Tags			customers
+//	@Summary		Link a diner visit to a customer
+//	@Description	Link the open session of a diner to a customer so that the bill earns loyalty points
+//	@Accept			json
+//	@Produce		json
+//	@Param			customer_id	path		int64				true	"id of customer"
+//	@Param			data		body		LinkSessionRequest	true	"body data"
+//	@Success		200			{object}	MessageResponse
+//	@Failure		400			{object}	controllers.ProblemDetails
+//	@Failure		404			{object}	controllers.ProblemDetails
+//	@Failure		500			{object}	controllers.ProblemDetails
+//	@Router			/customers/{customer_id}/sessions [post]
+func (c *Controller) LinkSession(ctx *gin.Context) {
+	customerID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		appError := domainErrors.NewAppError(errors.New("param customer id is necessary in the url"), domainErrors.ValidationError)
+		_ = ctx.Error(appError)
+		return
+	}
+
+	var request LinkSessionRequest
+	if err := controllers.BindJSON(ctx, &request); err != nil {
+		appError := domainErrors.NewAppError(err, domainErrors.ValidationError)
+		_ = ctx.Error(appError)
+		return
+	}
+
+	if err := c.CustomerService.LinkSession(ctx.Request.Context(), customerID, request.DinerID); err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "diner session linked to the customer"})
+}
+
+// GetPoints godoc
+//
+//	@Tags			customers
+//	@Summary		Get loyalty points balance
+//	@Description	Get the points balance, membership level and points to the next level of a customer
+//	@Param			customer_id	path		int64	true	"id of customer"
+//	@Success		200			{object}	domainCustomer.Balance
+//	@Failure		400			{object}	controllers.ProblemDetails
+//	@Failure		404			{object}	controllers.ProblemDetails
+//	@Failure		500			{object}	controllers.ProblemDetails
+//	@Router			/customers/{customer_id}/points [get]
+func (c *Controller) GetPoints(ctx *gin.Context) {
+	customerID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		appError := domainErrors.NewAppError(errors.New("customer id is invalid"), domainErrors.ValidationError)
+		_ = ctx.Error(appError)
+		return
+	}
+
+	balance, err := c.CustomerService.GetBalance(ctx.Request.Context(), customerID)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, balance)
+}
+
+// GetLedger godoc
+//
+//	@Tags			customers
+//	@Summary		Get loyalty points ledger
+//	@Description	Get the points earned and redeemed by a customer, latest first
+//	@Param			customer_id	path		int64	true	"id of customer"
+//	@Success		200			{object}	[]domainCustomer.LedgerEntry
+//	@Failure		400			{object}	controllers.ProblemDetails
+//	@Failure		404			{object}	controllers.ProblemDetails
+//	@Failure		500			{object}	controllers.ProblemDetails
+//	@Router			/customers/{customer_id}/ledger [get]
+func (c *Controller) GetLedger(ctx *gin.Context) {
+	customerID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		appError := domainErrors.NewAppError(errors.New("customer id is invalid"), domainErrors.ValidationError)
+		_ = ctx.Error(appError)
+		return
+	}
+
+	entries, err := c.CustomerService.GetLedger(ctx.Request.Context(), customerID)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, entries)
+}
+
+// RedeemPoints godoc
+//
+//	@Tags			customers
+//	@Summary		Redeem loyalty points
+//	@Description	Spend loyalty points of a customer as a discount on the open bill of the diner they are seated as
+//	@Accept			json
+//	@Produce		json
+//	@Param			customer_id	path		int64			true	"id of customer"
+//	@Param			data		body		RedeemRequest	true	"body data"
+//	@Success		201			{object}	domainCustomer.LedgerEntry
+//	@Failure		400			{object}	controllers.ProblemDetails
+//	@Failure		404			{object}	controllers.ProblemDetails
+//	@Failure		409			{object}	controllers.ProblemDetails
+//	@Failure		500			{object}	controllers.ProblemDetails
+//	@Router			/customers/{customer_id}/redemptions [post]
+func (c *Controller) RedeemPoints(ctx *gin.Context) {
+	customerID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		appError := domainErrors.NewAppError(errors.New("param customer id is necessary in the url"), domainErrors.ValidationError)
+		_ = ctx.Error(appError)
+		return
+	}
+
+	var request RedeemRequest
+	if err := controllers.BindJSON(ctx, &request); err != nil {
+		appError := domainErrors.NewAppError(err, domainErrors.ValidationError)
+		_ = ctx.Error(appError)
+		return
+	}
+
+	entry, err := c.CustomerService.Redeem(ctx.Request.Context(), customerID, request.DinerID, request.Points)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, entry)
+}
+
+// GetTiers godoc
+//
+//	@Tags			customers
+//	@Summary		Get membership levels
+//	@Description	Get the membership levels with the lifetime points needed to reach them and their points multiplier
+//	@Success		200	{object}	[]domainCustomer.Tier
+//	@Router			/customers/tiers [get]
+func (c *Controller) GetTiers(ctx *gin.Context) {
+	ctx.JSON(http.StatusOK, useCaseCustomer.Tiers)
+}
@@ -0,0 +1,29 @@
+// Package customer contains the customer controller
+package customer
+
+// NewCustomerRequest is a struct that contains the new customer request information
+type NewCustomerRequest struct {
+	Name        string `json:"name" example:"Mr. Smith" binding:"required"`
+	Email       string `json:"email" example:"smith@example.com" binding:"omitempty,email"`
+	Phone       string `json:"phone" example:"+91 98765 43210"`
+	Preferences string `json:"preferences" example:"window seat, no peanuts"`
+}
+
+// UpdateCustomerRequest is a struct that contains the customer profile update request information
+type UpdateCustomerRequest struct {
+	Name        *string `json:"name" example:"Mr. Smith"`
+	Email       *string `json:"email" example:"smith@example.com"`
+	Phone       *string `json:"phone" example:"+91 98765 43210"`
+	Preferences *string `json:"preferences" example:"window seat, no peanuts"`
+}
+
+// LinkSessionRequest is a struct that contains the request information to link a diner session to a customer
+type LinkSessionRequest struct {
+	DinerID int64 `json:"diner_id" example:"1" binding:"required"`
+}
+
+// RedeemRequest is a struct that contains the points redemption request information
+type RedeemRequest struct {
+	DinerID int64 `json:"diner_id" example:"1" binding:"required"`
+	Points  int   `json:"points" example:"100" binding:"required,min=1"`
+}
@@ -0,0 +1,7 @@
+// Package customer contains the customer controller
+package customer
+
+// MessageResponse is a struct that contains the response body for the message
+type MessageResponse struct {
+	Message string `json:"message"`
+}
@@ -29,9 +29,9 @@ type Controller struct {
 //	@Produce		json
 //	@Param			data	body		NewDinerRequest	true	"body data"
 //	@Success		201		{object}	domainDiner.Diner
-//	@Failure		400		{object}	MessageResponse
-//	@Failure		500		{object}	MessageResponse
-//	@Router			/diners [post]
+//	@Failure		400		{object}	controllers.ProblemDetails
+//	@Failure		500		{object}	controllers.ProblemDetails
+//	@Router			/diners/ [post]
 func (c *Controller) NewDiner(ctx *gin.Context) {
 	var request NewDinerRequest
 
@@ -54,43 +54,77 @@ func (c *Controller) NewDiner(ctx *gin.Context) {
 		return
 	}
 
-	ctx.JSON(http.StatusCreated, result)
+	controllers.Render(ctx, http.StatusCreated, result)
 }
 
+// listFields are the fields the items of a diner list can be trimmed to
+var listFields = []string{"id", "name", "table_no", "created_at", "updated_at"}
+
 // GetAllDiners godoc
 //
 //	@Tags			diners
 //	@Summary		Get all Diners
-//	@Description	Get all Diners on the system
-//	@Param			limit	query		int64	true	"limit"
-//	@Param			page	query		int64	true	"page"
-//	@Success		200		{object}	[]useCaseDiner.PaginationResultDiner
-//	@Failure		400		{object}	MessageResponse
-//	@Failure		500		{object}	MessageResponse
-//	@Router			/diners [get]
+//	@Description	Get all Diners on the system sorted by name or by sort, optionally searched by a case-insensitive part of the name and by table number, in keyset pages following the opaque cursors, or by page number when page is given
+//	@Param			limit			query		int64	false	"limit"
+//	@Param			cursor			query		string	false	"NextCursor or PrevCursor of a previous page"
+//	@Param			with_total		query		bool	false	"count the total of a keyset page"
+//	@Param			page			query		int64	false	"page number, counts the total on every call"
+//	@Param			sort			query		string	false	"comma separated fields, descending when prefixed by a minus, e.g. table_no,-created_at"
+//	@Param			fields			query		string	false	"comma separated fields of each item to return, e.g. id,name"
+//	@Param			name			query		string	false	"part of the diner name"
+//	@Param			table_no		query		int		false	"table number"
+//	@Param			If-None-Match	header		string	false	"ETag of a previous response, answered with 304 Not Modified while unchanged"
+//	@Success		200				{object}	useCaseDiner.PaginationResultDiner
+//	@Header			200				{string}	Link	"first, next and prev pages"
+//	@Header			200				{string}	ETag	"strong tag of the body"
+//	@Failure		400				{object}	controllers.ProblemDetails
+//	@Failure		500				{object}	controllers.ProblemDetails
+//	@Router			/diners/ [get]
 func (c *Controller) GetAllDiners(ctx *gin.Context) {
-	pageStr := ctx.DefaultQuery("page", "1")
-	limitStr := ctx.DefaultQuery("limit", "20")
-
-	page, err := strconv.ParseInt(pageStr, 10, 64)
+	params, err := controllers.BindPageParams(ctx)
 	if err != nil {
-		appError := domainErrors.NewAppError(errors.New("param page is necessary to be an integer"), domainErrors.ValidationError)
-		_ = ctx.Error(appError)
+		_ = ctx.Error(err)
 		return
 	}
-	limit, err := strconv.ParseInt(limitStr, 10, 64)
+	fields, err := controllers.BindFields(ctx, listFields)
 	if err != nil {
-		appError := domainErrors.NewAppError(errors.New("param limit is necessary to be an integer"), domainErrors.ValidationError)
-		_ = ctx.Error(appError)
+		_ = ctx.Error(err)
 		return
 	}
 
-	diners, err := c.DinerService.GetAll(ctx.Request.Context(), page, limit)
+	search := useCaseDiner.SearchDiner{Name: ctx.Query("name")}
+	if tableNumberStr := ctx.Query("table_no"); tableNumberStr != "" {
+		search.TableNumber, err = strconv.Atoi(tableNumberStr)
+		if err != nil {
+			appError := domainErrors.NewAppError(errors.New("param table_no is necessary to be an integer"), domainErrors.ValidationError)
+			_ = ctx.Error(appError)
+			return
+		}
+	}
+
+	var diners *useCaseDiner.PaginationResultDiner
+	if params.Page > 0 && params.Sort == "" {
+		diners, err = c.DinerService.GetAll(ctx.Request.Context(), params.Page, params.Limit, &search)
+	} else {
+		diners, err = c.DinerService.GetPage(ctx.Request.Context(), &useCaseDiner.PageQuery{
+			Cursor:    params.Cursor,
+			Page:      params.Page,
+			Limit:     params.Limit,
+			Sort:      params.Sort,
+			WithTotal: params.WithTotal,
+		}, &search)
+	}
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+	result, err := controllers.SparseFieldset(diners, fields)
 	if err != nil {
 		_ = ctx.Error(err)
 		return
 	}
-	ctx.JSON(http.StatusOK, diners)
+	controllers.SetLinkHeader(ctx, diners.NextCursor, diners.PrevCursor)
+	controllers.Render(ctx, http.StatusOK, result)
 }
 
 // GetDinersByID godoc
@@ -98,10 +132,14 @@ func (c *Controller) GetAllDiners(ctx *gin.Context) {
 //	@Tags			diners
 //	@Summary		Get diners by ID
 //	@Description	Get Diners by ID on the system
-//	@Param			diner_id	path		int64	true	"id of diner"
-//	@Success		200			{object}	domainDiner.Diner
-//	@Failure		400			{object}	MessageResponse
-//	@Failure		500			{object}	MessageResponse
+//	@Param			diner_id			path		int64	true	"id of diner"
+//	@Param			If-None-Match		header		string	false	"ETag of a previous response, answered with 304 Not Modified while unchanged"
+//	@Param			If-Modified-Since	header		string	false	"Last-Modified of a previous response, answered with 304 Not Modified while unchanged"
+//	@Success		200					{object}	domainDiner.Diner
+//	@Header			200					{string}	ETag			"strong tag of the body"
+//	@Header			200					{string}	Last-Modified	"updated_at of the resource"
+//	@Failure		400					{object}	controllers.ProblemDetails
+//	@Failure		500					{object}	controllers.ProblemDetails
 //	@Router			/diners/{diner_id} [get]
 func (c *Controller) GetDinersByID(ctx *gin.Context) {
 	dinerID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
@@ -117,7 +155,50 @@ func (c *Controller) GetDinersByID(ctx *gin.Context) {
 		return
 	}
 
-	ctx.JSON(http.StatusOK, domainDiner)
+	controllers.SetLastModified(ctx, domainDiner.UpdatedAt)
+	controllers.Render(ctx, http.StatusOK, domainDiner)
+}
+
+// UpdateDiner godoc
+//
+//	@Tags			diners
+//	@Summary		Update diner
+//	@Description	Fix the name of a diner or move them to another table
+//	@Accept			json
+//	@Produce		json
+//	@Param			diner_id	path		int64				true	"id of diner"
+//	@Param			data		body		UpdateDinerRequest	true	"body data"
+//	@Success		200			{object}	domainDiner.Diner
+//	@Failure		400			{object}	controllers.ProblemDetails
+//	@Failure		404			{object}	controllers.ProblemDetails
+//	@Failure		409			{object}	controllers.ProblemDetails
+//	@Failure		500			{object}	controllers.ProblemDetails
+//	@Router			/diners/{diner_id} [patch]
+func (c *Controller) UpdateDiner(ctx *gin.Context) {
+	dinerID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		appError := domainErrors.NewAppError(errors.New("param diner id is necessary in the url"), domainErrors.ValidationError)
+		_ = ctx.Error(appError)
+		return
+	}
+
+	var request UpdateDinerRequest
+	if err := controllers.BindJSON(ctx, &request); err != nil {
+		appError := domainErrors.NewAppError(err, domainErrors.ValidationError)
+		_ = ctx.Error(appError)
+		return
+	}
+
+	diner, err := c.DinerService.Update(ctx.Request.Context(), dinerID, &useCaseDiner.UpdateDiner{
+		Name:        request.Name,
+		TableNumber: request.TableNumber,
+	})
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	controllers.Render(ctx, http.StatusOK, diner)
 }
 
 // DeleteDiner is the controller to delete a diner
@@ -127,8 +208,8 @@ func (c *Controller) GetDinersByID(ctx *gin.Context) {
 //	@Description	Delete Diners by ID on the system
 //	@Param			diner_id	path		int64	true	"id of diner"
 //	@Success		200			{object}	MessageResponse
-//	@Failure		400			{object}	MessageResponse
-//	@Failure		500			{object}	MessageResponse
+//	@Failure		400			{object}	controllers.ProblemDetails
+//	@Failure		500			{object}	controllers.ProblemDetails
 //	@Router			/diners/{diner_id} [delete]
 func (c *Controller) DeleteDiner(ctx *gin.Context) {
 	dinerID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
@@ -143,5 +224,99 @@ func (c *Controller) DeleteDiner(ctx *gin.Context) {
 		_ = ctx.Error(err)
 		return
 	}
-	ctx.JSON(http.StatusOK, gin.H{"message": "resource deleted successfully"})
+	controllers.Render(ctx, http.StatusOK, gin.H{"message": "resource deleted successfully"})
+}
+
+// CheckInDiner godoc
+//
+//	@Tags			diners
+//	@Summary		Check in a returning diner
+//	@Description	Open a new dining session for a returning diner at a table
+//	@Accept			json
+//	@Produce		json
+//	@Param			diner_id	path		int64			true	"id of diner"
+//	@Param			data		body		CheckInRequest	true	"body data"
+//	@Success		201			{object}	domainDiner.Session
+//	@Failure		400			{object}	controllers.ProblemDetails
+//	@Failure		404			{object}	controllers.ProblemDetails
+//	@Failure		409			{object}	controllers.ProblemDetails
+//	@Failure		500			{object}	controllers.ProblemDetails
+//	@Router			/diners/{diner_id}/sessions [post]
+func (c *Controller) CheckInDiner(ctx *gin.Context) {
+	dinerID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		appError := domainErrors.NewAppError(errors.New("param diner id is necessary in the url"), domainErrors.ValidationError)
+		_ = ctx.Error(appError)
+		return
+	}
+
+	var request CheckInRequest
+	if err := controllers.BindJSON(ctx, &request); err != nil {
+		appError := domainErrors.NewAppError(err, domainErrors.ValidationError)
+		_ = ctx.Error(appError)
+		return
+	}
+
+	session, err := c.DinerService.CheckIn(ctx.Request.Context(), dinerID, request.TableNumber)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	controllers.Render(ctx, http.StatusCreated, session)
+}
+
+// GetDinerSessions godoc
+//
+//	@Tags			diners
+//	@Summary		Get diner visits
+//	@Description	Get the dining sessions of a diner, latest first
+//	@Param			diner_id	path		int64	true	"id of diner"
+//	@Success		200			{object}	[]domainDiner.Session
+//	@Failure		400			{object}	controllers.ProblemDetails
+//	@Failure		500			{object}	controllers.ProblemDetails
+//	@Router			/diners/{diner_id}/sessions [get]
+func (c *Controller) GetDinerSessions(ctx *gin.Context) {
+	dinerID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		appError := domainErrors.NewAppError(errors.New("diner id is invalid"), domainErrors.ValidationError)
+		_ = ctx.Error(appError)
+		return
+	}
+
+	sessions, err := c.DinerService.GetSessions(ctx.Request.Context(), dinerID)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	controllers.Render(ctx, http.StatusOK, sessions)
+}
+
+// CheckoutDiner godoc
+//
+//	@Tags			diners
+//	@Summary		Pay the bill of a diner
+//	@Description	Record the paid bill of the open session of a diner and close the session
+//	@Param			diner_id	path		int64	true	"id of diner"
+//	@Success		201			{object}	domainDiner.Payment
+//	@Failure		400			{object}	controllers.ProblemDetails
+//	@Failure		404			{object}	controllers.ProblemDetails
+//	@Failure		500			{object}	controllers.ProblemDetails
+//	@Router			/diners/{diner_id}/checkout [post]
+func (c *Controller) CheckoutDiner(ctx *gin.Context) {
+	dinerID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		appError := domainErrors.NewAppError(errors.New("param diner id is necessary in the url"), domainErrors.ValidationError)
+		_ = ctx.Error(appError)
+		return
+	}
+
+	payment, err := c.DinerService.Checkout(ctx.Request.Context(), dinerID)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	controllers.Render(ctx, http.StatusCreated, payment)
 }
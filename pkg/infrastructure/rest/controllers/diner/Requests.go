@@ -6,3 +6,14 @@ type NewDinerRequest struct {
 	Name        string `json:"name" example:"Mr. Smith" binding:"required"`
 	TableNumber int    `json:"table_no" example:"101" binding:"required"`
 }
+
+// UpdateDinerRequest is a struct that contains the diner update request information, at least one of the fields
+type UpdateDinerRequest struct {
+	Name        *string `json:"name" example:"Mr. Smith" binding:"required_without=TableNumber"`
+	TableNumber *int    `json:"table_no" example:"101"`
+}
+
+// CheckInRequest is a struct that contains the check-in request information of a returning diner
+type CheckInRequest struct {
+	TableNumber int `json:"table_no" example:"101" binding:"required"`
+}
@@ -2,46 +2,163 @@
 package errors
 
 import (
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
+	"runtime/debug"
+	"strings"
 
 	domainErrors "github.com/Raj63/golang-rest-api/pkg/domain/errors"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers"
 	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/trace"
 )
 
-// MessagesResponse is a struct that contains the response body for the message
-type MessagesResponse struct {
-	Message string `json:"message"`
-}
+// The stable codes of the errors, which the clients can rely on while the messages change
+const (
+	CodeValidation       = "validation_error"
+	CodeNotFound         = "not_found"
+	CodeAlreadyExists    = "already_exists"
+	CodeUnauthorized     = "unauthorized"
+	CodeForbidden        = "forbidden"
+	CodeMethodNotAllowed = "method_not_allowed"
+	CodeRateLimited      = "rate_limited"
+	CodeInternal         = "internal_error"
+)
+
+// internalMessage is the detail of the errors whose cause is not disclosed to the caller
+const internalMessage = "We are working to improve the flow of this request."
 
-// Handler is Gin middleware to handle errors.
+// Handler is Gin middleware to handle errors. Every failed request is answered with the problem details of its
+// first error, of the error status set without a body, or of a panic of the handlers.
 func Handler(c *gin.Context) {
-	// Execute request handlers and then handle any errors
-	c.Next()
-	errs := c.Errors
-
-	if len(errs) > 0 {
-		err, ok := errs[0].Err.(*domainErrors.AppError)
-		if ok {
-			resp := MessagesResponse{Message: err.Error()}
-			switch err.Type {
-			case domainErrors.NotFound:
-				c.JSON(http.StatusNotFound, resp)
-				return
-			case domainErrors.ValidationError:
-				c.JSON(http.StatusBadRequest, resp)
-				return
-			case domainErrors.ResourceAlreadyExists:
-				c.JSON(http.StatusConflict, resp)
-				return
-			case domainErrors.RepositoryError:
-				c.JSON(http.StatusInternalServerError, MessagesResponse{Message: "We are working to improve the flow of this request."})
-				return
-			default:
-				c.JSON(http.StatusInternalServerError, MessagesResponse{Message: "We are working to improve the flow of this request."})
-				return
+	defer func() {
+		if r := recover(); r != nil {
+			fmt.Fprintf(gin.DefaultErrorWriter, "[Recovery] panic recovered: %v\n%s\n", r, debug.Stack())
+			if !c.Writer.Written() {
+				writeProblem(c, http.StatusInternalServerError, CodeInternal, internalMessage, nil)
 			}
+			c.Abort()
 		}
+	}()
 
+	// Execute request handlers and then handle any errors
+	c.Next()
+	// a response already sent, as a streamed export stopped midway, is left as it is
+	if c.Writer.Written() {
 		return
 	}
+
+	if len(c.Errors) > 0 {
+		status, code, detail, fields := Classify(c.Errors[0].Err)
+		writeProblem(c, status, code, detail, fields)
+		return
+	}
+	if status := c.Writer.Status(); status >= http.StatusBadRequest {
+		writeProblem(c, status, statusCode(status), "", nil)
+	}
+}
+
+// Classify is a function that maps an error to its HTTP status, stable code, detail and invalid fields, hiding the
+// cause of the errors that are not the caller's
+func Classify(err error) (int, string, string, []domainErrors.FieldError) {
+	var appErr *domainErrors.AppError
+	if !errors.As(err, &appErr) {
+		// the repositories that don't wrap a missing row in an application error still answer with a not found
+		if errors.Is(err, sql.ErrNoRows) {
+			return http.StatusNotFound, CodeNotFound, "record not found", nil
+		}
+		return http.StatusInternalServerError, CodeInternal, internalMessage, nil
+	}
+
+	switch appErr.Type {
+	case domainErrors.NotFound:
+		return http.StatusNotFound, CodeNotFound, appErr.Error(), nil
+	case domainErrors.ValidationError, domainErrors.InputEmpty:
+		// the controllers wrap the validation errors of the requests, with their fields, in another one
+		fields := appErr.Fields
+		var fieldsErr *domainErrors.AppError
+		if len(fields) == 0 && errors.As(appErr.Err, &fieldsErr) {
+			fields = fieldsErr.Fields
+		}
+		return http.StatusBadRequest, CodeValidation, appErr.Error(), fields
+	case domainErrors.ResourceAlreadyExists:
+		return http.StatusConflict, CodeAlreadyExists, appErr.Error(), nil
+	default:
+		return http.StatusInternalServerError, CodeInternal, internalMessage, nil
+	}
+}
+
+// statusCode returns the stable code of an error status set without an error
+func statusCode(status int) string {
+	switch status {
+	case http.StatusBadRequest:
+		return CodeValidation
+	case http.StatusUnauthorized:
+		return CodeUnauthorized
+	case http.StatusForbidden:
+		return CodeForbidden
+	case http.StatusNotFound:
+		return CodeNotFound
+	case http.StatusMethodNotAllowed:
+		return CodeMethodNotAllowed
+	case http.StatusConflict:
+		return CodeAlreadyExists
+	case http.StatusTooManyRequests:
+		return CodeRateLimited
+	default:
+		if status < http.StatusInternalServerError {
+			return strings.ReplaceAll(strings.ToLower(http.StatusText(status)), " ", "_")
+		}
+		return CodeInternal
+	}
+}
+
+// AbortWithProblem is a function that answers with the problem details of an error status and stops the handlers,
+// for the middlewares that fail a request before the handlers, or outside of Handler
+func AbortWithProblem(c *gin.Context, status int, detail string) {
+	writeProblem(c, status, statusCode(status), detail, nil)
+	c.Abort()
+}
+
+func writeProblem(c *gin.Context, status int, code, detail string, fields []domainErrors.FieldError) {
+	problem := controllers.ProblemDetails{
+		Type:     "about:blank",
+		Title:    http.StatusText(status),
+		Status:   status,
+		Detail:   detail,
+		Instance: c.Request.URL.Path,
+		Code:     code,
+		TraceID:  TraceID(c),
+		Errors:   fields,
+	}
+	body, err := json.Marshal(problem)
+	if err != nil {
+		body = []byte(`{"type":"about:blank","status":500}`)
+	}
+	c.Data(status, controllers.ProblemMIME, body)
+}
+
+// TraceID is a function that returns the id the request is traced by: the trace of its span when it is traced, or
+// its X-Request-ID, or a random id that is then sent back in the X-Request-ID of the response
+func TraceID(c *gin.Context) string {
+	if spanContext := trace.SpanContextFromContext(c.Request.Context()); spanContext.HasTraceID() {
+		return spanContext.TraceID().String()
+	}
+	if requestID := c.GetHeader("X-Request-ID"); requestID != "" {
+		return requestID
+	}
+	if requestID := c.Writer.Header().Get("X-Request-ID"); requestID != "" {
+		return requestID
+	}
+
+	id := make([]byte, 16)
+	_, _ = rand.Read(id)
+	requestID := hex.EncodeToString(id)
+	c.Header("X-Request-ID", requestID)
+	return requestID
 }
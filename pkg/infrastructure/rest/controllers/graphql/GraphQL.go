@@ -0,0 +1,74 @@
+// Package graphql contains the GraphQL controller
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+
+	useCaseDiner "github.com/Raj63/golang-rest-api/pkg/app/usecases/diner"
+	useCaseMenu "github.com/Raj63/golang-rest-api/pkg/app/usecases/menu"
+	useCaseOrder "github.com/Raj63/golang-rest-api/pkg/app/usecases/order"
+	domainErrors "github.com/Raj63/golang-rest-api/pkg/domain/errors"
+
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	gql "github.com/graphql-go/graphql"
+)
+
+// Controller is a struct that contains the services the GraphQL schema resolves with
+type Controller struct {
+	MenuService  useCaseMenu.Service
+	DinerService useCaseDiner.Service
+	OrderService useCaseOrder.Service
+
+	schemaOnce sync.Once
+	schema     gql.Schema
+	schemaErr  error
+}
+
+// Query is the controller running a GraphQL query, sent as JSON body or as query params, to fetch menus, diners and
+// their orders with their relations in one round trip or to create and delete them with mutations. It is served
+// outside of /v1, hence not in the swagger documentation.
+func (c *Controller) Query(ctx *gin.Context) {
+	var request Request
+	if ctx.Request.Method == http.MethodGet {
+		if err := ctx.ShouldBindQuery(&request); err != nil {
+			appError := domainErrors.NewAppError(err, domainErrors.ValidationError)
+			_ = ctx.Error(appError)
+			return
+		}
+		if variables := ctx.Query("variables"); variables != "" {
+			if err := json.Unmarshal([]byte(variables), &request.Variables); err != nil {
+				appError := domainErrors.NewAppError(errors.New("param variables is necessary to be a JSON object"), domainErrors.ValidationError)
+				_ = ctx.Error(appError)
+				return
+			}
+		}
+	} else if err := ctx.ShouldBindJSON(&request); err != nil {
+		// queries are bound without controllers.BindJSON as they may be longer than the body it keeps
+		appError := domainErrors.NewAppError(err, domainErrors.ValidationError)
+		_ = ctx.Error(appError)
+		return
+	}
+
+	c.schemaOnce.Do(func() {
+		c.schema, c.schemaErr = c.buildSchema()
+	})
+	if c.schemaErr != nil {
+		_ = ctx.Error(c.schemaErr)
+		return
+	}
+
+	result := gql.Do(gql.Params{
+		Schema:         c.schema,
+		RequestString:  request.Query,
+		VariableValues: request.Variables,
+		OperationName:  request.OperationName,
+		Context:        context.WithValue(ctx.Request.Context(), loadersKey{}, c.newLoaders()),
+	})
+
+	ctx.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,123 @@
+// Package graphql contains the GraphQL controller
+package graphql
+
+import (
+	"context"
+	"sync"
+)
+
+type loadersKey struct{}
+
+// loader batches the loads of records of one kind by id. The ids asked for while the executor resolves one level
+// of a query are fetched together by the first thunk of the level that is resolved.
+type loader struct {
+	fetch   func(ctx context.Context, ids []int64) (map[int64]interface{}, error)
+	mu      sync.Mutex
+	pending []int64
+	results map[int64]interface{}
+	errs    map[int64]error
+}
+
+func newLoader(fetch func(ctx context.Context, ids []int64) (map[int64]interface{}, error)) *loader {
+	return &loader{fetch: fetch, results: map[int64]interface{}{}, errs: map[int64]error{}}
+}
+
+// load queues an id and returns the thunk resolving its record, nil when it does not exist
+func (l *loader) load(ctx context.Context, id int64) func() (interface{}, error) {
+	l.mu.Lock()
+	_, loaded := l.results[id]
+	if !loaded && l.errs[id] == nil && !contains(l.pending, id) {
+		l.pending = append(l.pending, id)
+	}
+	l.mu.Unlock()
+
+	return func() (interface{}, error) {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+
+		if len(l.pending) > 0 {
+			ids := l.pending
+			l.pending = nil
+			results, err := l.fetch(ctx, ids)
+			for _, pendingID := range ids {
+				if err != nil {
+					l.errs[pendingID] = err
+					continue
+				}
+				l.results[pendingID] = results[pendingID]
+			}
+		}
+		if err := l.errs[id]; err != nil {
+			return nil, toResolverError(err)
+		}
+		return l.results[id], nil
+	}
+}
+
+// loaders holds the loaders of one GraphQL request, so that nothing is cached across requests
+type loaders struct {
+	menus         *loader
+	diners        *loader
+	currentOrders *loader
+	orderHistory  *loader
+}
+
+func (c *Controller) newLoaders() *loaders {
+	return &loaders{
+		menus: newLoader(func(ctx context.Context, ids []int64) (map[int64]interface{}, error) {
+			menus, err := c.MenuService.GetByIDs(ctx, ids)
+			if err != nil {
+				return nil, err
+			}
+			results := make(map[int64]interface{}, len(menus))
+			for i := range menus {
+				results[menus[i].ID] = &menus[i]
+			}
+			return results, nil
+		}),
+		diners: newLoader(func(ctx context.Context, ids []int64) (map[int64]interface{}, error) {
+			diners, err := c.DinerService.GetByIDs(ctx, ids)
+			if err != nil {
+				return nil, err
+			}
+			results := make(map[int64]interface{}, len(diners))
+			for i := range diners {
+				results[diners[i].ID] = &diners[i]
+			}
+			return results, nil
+		}),
+		currentOrders: c.newOrdersLoader(false),
+		orderHistory:  c.newOrdersLoader(true),
+	}
+}
+
+func (c *Controller) newOrdersLoader(history bool) *loader {
+	return newLoader(func(ctx context.Context, dinerIDs []int64) (map[int64]interface{}, error) {
+		orders, err := c.OrderService.GetByDinerIDs(ctx, dinerIDs, history)
+		if err != nil {
+			return nil, err
+		}
+		byDiner := make(map[int64][]interface{}, len(dinerIDs))
+		for i := range orders {
+			byDiner[orders[i].DinerID] = append(byDiner[orders[i].DinerID], &orders[i])
+		}
+		results := make(map[int64]interface{}, len(dinerIDs))
+		for _, dinerID := range dinerIDs {
+			results[dinerID] = append([]interface{}{}, byDiner[dinerID]...)
+		}
+		return results, nil
+	})
+}
+
+func loadersFrom(ctx context.Context) *loaders {
+	return ctx.Value(loadersKey{}).(*loaders)
+}
+
+func contains(ids []int64, id int64) bool {
+	for _, pendingID := range ids {
+		if pendingID == id {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,9 @@
+// Package graphql contains the GraphQL controller
+package graphql
+
+// Request is a struct that contains a GraphQL request
+type Request struct {
+	Query         string                 `json:"query" form:"query" example:"{ diner(id: 1) { name orders { quantity menu { name price } } } }" binding:"required"`
+	OperationName string                 `json:"operationName" form:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
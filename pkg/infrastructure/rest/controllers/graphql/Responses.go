@@ -0,0 +1,13 @@
+// Package graphql contains the GraphQL controller
+package graphql
+
+// MessageResponse is a struct that contains the response body for the message
+type MessageResponse struct {
+	Message string `json:"message"`
+}
+
+// Response is a struct that contains a GraphQL response
+type Response struct {
+	Data   interface{}   `json:"data,omitempty"`
+	Errors []interface{} `json:"errors,omitempty"`
+}
@@ -0,0 +1,423 @@
+// Package graphql contains the GraphQL controller
+package graphql
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	useCaseDiner "github.com/Raj63/golang-rest-api/pkg/app/usecases/diner"
+	useCaseMenu "github.com/Raj63/golang-rest-api/pkg/app/usecases/menu"
+	useCaseOrder "github.com/Raj63/golang-rest-api/pkg/app/usecases/order"
+	domainDiner "github.com/Raj63/golang-rest-api/pkg/domain/diner"
+	domainErrors "github.com/Raj63/golang-rest-api/pkg/domain/errors"
+	domainMenu "github.com/Raj63/golang-rest-api/pkg/domain/menu"
+	domainOrder "github.com/Raj63/golang-rest-api/pkg/domain/order"
+	gql "github.com/graphql-go/graphql"
+)
+
+// resolverError is the error reported to the client for a failed field, with the type of the application error
+// as extension code. The message of an unexpected error is not disclosed.
+type resolverError struct {
+	message string
+	code    string
+}
+
+func (e *resolverError) Error() string {
+	return e.message
+}
+
+// Extensions is reported in the extensions of the GraphQL error
+func (e *resolverError) Extensions() map[string]interface{} {
+	return map[string]interface{}{"code": e.code}
+}
+
+func toResolverError(err error) error {
+	var appError *domainErrors.AppError
+	if errors.As(err, &appError) {
+		switch appError.Type {
+		case domainErrors.NotFound, domainErrors.ValidationError, domainErrors.ResourceAlreadyExists:
+			return &resolverError{message: appError.Error(), code: appError.Type}
+		}
+	}
+	return &resolverError{message: "We are working to improve the flow of this request.", code: domainErrors.UnknownError}
+}
+
+func idArg(p gql.ResolveParams, name string) (int64, error) {
+	id, err := strconv.ParseInt(fmt.Sprint(p.Args[name]), 10, 64)
+	if err != nil {
+		return 0, toResolverError(domainErrors.NewAppError(fmt.Errorf("%s is invalid", name), domainErrors.ValidationError))
+	}
+	return id, nil
+}
+
+func pageArgs(p gql.ResolveParams) (int64, int64, error) {
+	page, limit := int64(p.Args["page"].(int)), int64(p.Args["limit"].(int))
+	if page < 1 || limit < 1 {
+		return 0, 0, toResolverError(domainErrors.NewAppError(errors.New("page and limit must be positive"), domainErrors.ValidationError))
+	}
+	return page, limit, nil
+}
+
+type cursorQuery struct {
+	cursor    string
+	withTotal bool
+}
+
+// cursorArgs returns the keyset page requested, if any
+func cursorArgs(p gql.ResolveParams) (cursorQuery, bool) {
+	cursor, _ := p.Args["cursor"].(string)
+	withTotal, _ := p.Args["withTotal"].(bool)
+	return cursorQuery{cursor: cursor, withTotal: withTotal}, cursor != ""
+}
+
+type pageInfo struct {
+	Total      int64
+	Limit      int64
+	Current    int64
+	NumPages   int64
+	NextCursor string
+	PrevCursor string
+}
+
+type connection struct {
+	Nodes    []interface{}
+	PageInfo pageInfo
+}
+
+// field resolves a field from a source of a known type
+func field[T any](resolve func(source *T) interface{}) gql.FieldResolveFn {
+	return func(p gql.ResolveParams) (interface{}, error) {
+		if source, ok := p.Source.(*T); ok {
+			return resolve(source), nil
+		}
+		return nil, nil
+	}
+}
+
+func (c *Controller) buildSchema() (gql.Schema, error) {
+	pageInfoType := gql.NewObject(gql.ObjectConfig{
+		Name: "PageInfo",
+		Fields: gql.Fields{
+			"total":      &gql.Field{Type: gql.NewNonNull(gql.Int), Resolve: field(func(p *pageInfo) interface{} { return p.Total })},
+			"limit":      &gql.Field{Type: gql.NewNonNull(gql.Int), Resolve: field(func(p *pageInfo) interface{} { return p.Limit })},
+			"current":    &gql.Field{Type: gql.NewNonNull(gql.Int), Resolve: field(func(p *pageInfo) interface{} { return p.Current })},
+			"numPages":   &gql.Field{Type: gql.NewNonNull(gql.Int), Resolve: field(func(p *pageInfo) interface{} { return p.NumPages })},
+			"nextCursor": &gql.Field{Type: gql.String, Description: "cursor of the next page, empty on the last page", Resolve: field(func(p *pageInfo) interface{} { return p.NextCursor })},
+			"prevCursor": &gql.Field{Type: gql.String, Description: "cursor of the previous page, empty on the first page", Resolve: field(func(p *pageInfo) interface{} { return p.PrevCursor })},
+		},
+	})
+
+	menuType := gql.NewObject(gql.ObjectConfig{
+		Name: "Menu",
+		Fields: gql.Fields{
+			"id":          &gql.Field{Type: gql.NewNonNull(gql.ID), Resolve: field(func(m *domainMenu.Menu) interface{} { return m.ID })},
+			"name":        &gql.Field{Type: gql.NewNonNull(gql.String), Resolve: field(func(m *domainMenu.Menu) interface{} { return m.Name })},
+			"description": &gql.Field{Type: gql.NewNonNull(gql.String), Resolve: field(func(m *domainMenu.Menu) interface{} { return m.Description })},
+			"category":    &gql.Field{Type: gql.NewNonNull(gql.String), Resolve: field(func(m *domainMenu.Menu) interface{} { return m.Category })},
+			"price":       &gql.Field{Type: gql.NewNonNull(gql.Float), Resolve: field(func(m *domainMenu.Menu) interface{} { return m.Price })},
+			"createdAt":   &gql.Field{Type: gql.DateTime, Resolve: field(func(m *domainMenu.Menu) interface{} { return m.CreatedAt })},
+			"updatedAt":   &gql.Field{Type: gql.DateTime, Resolve: field(func(m *domainMenu.Menu) interface{} { return m.UpdatedAt })},
+		},
+	})
+
+	dinerType := gql.NewObject(gql.ObjectConfig{
+		Name: "Diner",
+		Fields: gql.Fields{
+			"id":          &gql.Field{Type: gql.NewNonNull(gql.ID), Resolve: field(func(d *domainDiner.Diner) interface{} { return d.ID })},
+			"name":        &gql.Field{Type: gql.NewNonNull(gql.String), Resolve: field(func(d *domainDiner.Diner) interface{} { return d.Name })},
+			"tableNumber": &gql.Field{Type: gql.NewNonNull(gql.Int), Resolve: field(func(d *domainDiner.Diner) interface{} { return d.TableNumber })},
+			"createdAt":   &gql.Field{Type: gql.DateTime, Resolve: field(func(d *domainDiner.Diner) interface{} { return d.CreatedAt })},
+			"updatedAt":   &gql.Field{Type: gql.DateTime, Resolve: field(func(d *domainDiner.Diner) interface{} { return d.UpdatedAt })},
+		},
+	})
+
+	orderType := gql.NewObject(gql.ObjectConfig{
+		Name: "Order",
+		Fields: gql.Fields{
+			"id":        &gql.Field{Type: gql.NewNonNull(gql.ID), Resolve: field(func(o *domainOrder.Response) interface{} { return o.ID })},
+			"sessionId": &gql.Field{Type: gql.ID, Resolve: field(func(o *domainOrder.Response) interface{} { return o.SessionID })},
+			"quantity":  &gql.Field{Type: gql.NewNonNull(gql.Int), Resolve: field(func(o *domainOrder.Response) interface{} { return o.Quantity })},
+			"servedAt":  &gql.Field{Type: gql.DateTime, Resolve: field(func(o *domainOrder.Response) interface{} { return o.ServedAt })},
+			"createdAt": &gql.Field{Type: gql.DateTime, Resolve: field(func(o *domainOrder.Response) interface{} { return o.CreatedAt })},
+			"menu": &gql.Field{
+				Type: menuType,
+				Resolve: func(p gql.ResolveParams) (interface{}, error) {
+					order := p.Source.(*domainOrder.Response)
+					return loadersFrom(p.Context).menus.load(p.Context, order.MenuID), nil
+				},
+			},
+			"diner": &gql.Field{
+				Type: dinerType,
+				Resolve: func(p gql.ResolveParams) (interface{}, error) {
+					order := p.Source.(*domainOrder.Response)
+					return loadersFrom(p.Context).diners.load(p.Context, order.DinerID), nil
+				},
+			},
+		},
+	})
+
+	dinerType.AddFieldConfig("orders", &gql.Field{
+		Type:        gql.NewNonNull(gql.NewList(gql.NewNonNull(orderType))),
+		Description: "orders of the open session of the diner, or of every session with history",
+		Args: gql.FieldConfigArgument{
+			"history": &gql.ArgumentConfig{Type: gql.Boolean, DefaultValue: false},
+		},
+		Resolve: func(p gql.ResolveParams) (interface{}, error) {
+			diner := p.Source.(*domainDiner.Diner)
+			orders := loadersFrom(p.Context).currentOrders
+			if history, _ := p.Args["history"].(bool); history {
+				orders = loadersFrom(p.Context).orderHistory
+			}
+			return orders.load(p.Context, diner.ID), nil
+		},
+	})
+
+	connectionType := func(name string, nodeType *gql.Object) *gql.Object {
+		return gql.NewObject(gql.ObjectConfig{
+			Name: name,
+			Fields: gql.Fields{
+				"nodes":    &gql.Field{Type: gql.NewNonNull(gql.NewList(gql.NewNonNull(nodeType))), Resolve: field(func(c *connection) interface{} { return c.Nodes })},
+				"pageInfo": &gql.Field{Type: gql.NewNonNull(pageInfoType), Resolve: field(func(c *connection) interface{} { return &c.PageInfo })},
+			},
+		})
+	}
+	pageArgsConfig := func(extra gql.FieldConfigArgument) gql.FieldConfigArgument {
+		args := gql.FieldConfigArgument{
+			"page":      &gql.ArgumentConfig{Type: gql.Int, DefaultValue: 1},
+			"limit":     &gql.ArgumentConfig{Type: gql.Int, DefaultValue: 20},
+			"cursor":    &gql.ArgumentConfig{Type: gql.String, Description: "nextCursor or prevCursor of a previous page, fetches a keyset page instead of the page number"},
+			"withTotal": &gql.ArgumentConfig{Type: gql.Boolean, DefaultValue: false, Description: "counts the total of a keyset page"},
+		}
+		for name, arg := range extra {
+			args[name] = arg
+		}
+		return args
+	}
+	idArgConfig := gql.FieldConfigArgument{"id": &gql.ArgumentConfig{Type: gql.NewNonNull(gql.ID)}}
+
+	query := gql.NewObject(gql.ObjectConfig{
+		Name: "Query",
+		Fields: gql.Fields{
+			"menu": &gql.Field{
+				Type: menuType,
+				Args: idArgConfig,
+				Resolve: func(p gql.ResolveParams) (interface{}, error) {
+					id, err := idArg(p, "id")
+					if err != nil {
+						return nil, err
+					}
+					return loadersFrom(p.Context).menus.load(p.Context, id), nil
+				},
+			},
+			"menus": &gql.Field{
+				Type: gql.NewNonNull(connectionType("MenuConnection", menuType)),
+				Args: pageArgsConfig(nil),
+				Resolve: func(p gql.ResolveParams) (interface{}, error) {
+					page, limit, err := pageArgs(p)
+					if err != nil {
+						return nil, err
+					}
+					var result *useCaseMenu.PaginationResultMenu
+					if query, ok := cursorArgs(p); ok {
+						result, err = c.MenuService.GetPage(p.Context, &useCaseMenu.PageQuery{Cursor: query.cursor, Limit: limit, WithTotal: query.withTotal})
+					} else {
+						result, err = c.MenuService.GetAll(p.Context, page, limit)
+					}
+					if err != nil {
+						return nil, toResolverError(err)
+					}
+					nodes := make([]interface{}, len(*result.Data))
+					for i := range *result.Data {
+						nodes[i] = &(*result.Data)[i]
+					}
+					return &connection{Nodes: nodes, PageInfo: pageInfo{
+						Total: result.Total, Limit: result.Limit, Current: result.Current, NumPages: result.NumPages,
+						NextCursor: result.NextCursor, PrevCursor: result.PrevCursor,
+					}}, nil
+				},
+			},
+			"diner": &gql.Field{
+				Type: dinerType,
+				Args: idArgConfig,
+				Resolve: func(p gql.ResolveParams) (interface{}, error) {
+					id, err := idArg(p, "id")
+					if err != nil {
+						return nil, err
+					}
+					return loadersFrom(p.Context).diners.load(p.Context, id), nil
+				},
+			},
+			"diners": &gql.Field{
+				Type: gql.NewNonNull(connectionType("DinerConnection", dinerType)),
+				Args: pageArgsConfig(gql.FieldConfigArgument{
+					"name":        &gql.ArgumentConfig{Type: gql.String, Description: "part of the diner name"},
+					"tableNumber": &gql.ArgumentConfig{Type: gql.Int},
+				}),
+				Resolve: func(p gql.ResolveParams) (interface{}, error) {
+					page, limit, err := pageArgs(p)
+					if err != nil {
+						return nil, err
+					}
+					search := useCaseDiner.SearchDiner{}
+					search.Name, _ = p.Args["name"].(string)
+					search.TableNumber, _ = p.Args["tableNumber"].(int)
+					var result *useCaseDiner.PaginationResultDiner
+					if query, ok := cursorArgs(p); ok {
+						result, err = c.DinerService.GetPage(p.Context, &useCaseDiner.PageQuery{Cursor: query.cursor, Limit: limit, WithTotal: query.withTotal}, &search)
+					} else {
+						result, err = c.DinerService.GetAll(p.Context, page, limit, &search)
+					}
+					if err != nil {
+						return nil, toResolverError(err)
+					}
+					nodes := make([]interface{}, len(*result.Data))
+					for i := range *result.Data {
+						nodes[i] = &(*result.Data)[i]
+					}
+					return &connection{Nodes: nodes, PageInfo: pageInfo{
+						Total: result.Total, Limit: result.Limit, Current: result.Current, NumPages: result.NumPages,
+						NextCursor: result.NextCursor, PrevCursor: result.PrevCursor,
+					}}, nil
+				},
+			},
+			"orders": &gql.Field{
+				Type: gql.NewNonNull(gql.NewList(gql.NewNonNull(orderType))),
+				Args: gql.FieldConfigArgument{
+					"dinerId": &gql.ArgumentConfig{Type: gql.NewNonNull(gql.ID)},
+					"history": &gql.ArgumentConfig{Type: gql.Boolean, DefaultValue: false},
+				},
+				Resolve: func(p gql.ResolveParams) (interface{}, error) {
+					dinerID, err := idArg(p, "dinerId")
+					if err != nil {
+						return nil, err
+					}
+					orders := loadersFrom(p.Context).currentOrders
+					if history, _ := p.Args["history"].(bool); history {
+						orders = loadersFrom(p.Context).orderHistory
+					}
+					return orders.load(p.Context, dinerID), nil
+				},
+			},
+		},
+	})
+
+	mutation := gql.NewObject(gql.ObjectConfig{
+		Name: "Mutation",
+		Fields: gql.Fields{
+			"createMenu": &gql.Field{
+				Type: gql.NewNonNull(menuType),
+				Args: gql.FieldConfigArgument{
+					"name":        &gql.ArgumentConfig{Type: gql.NewNonNull(gql.String)},
+					"description": &gql.ArgumentConfig{Type: gql.NewNonNull(gql.String)},
+					"category":    &gql.ArgumentConfig{Type: gql.String},
+					"price":       &gql.ArgumentConfig{Type: gql.NewNonNull(gql.Float)},
+				},
+				Resolve: func(p gql.ResolveParams) (interface{}, error) {
+					newMenu := useCaseMenu.NewMenu{
+						Name:        p.Args["name"].(string),
+						Description: p.Args["description"].(string),
+						Price:       p.Args["price"].(float64),
+					}
+					newMenu.Category, _ = p.Args["category"].(string)
+					menu, err := c.MenuService.Create(p.Context, &newMenu)
+					if err != nil {
+						return nil, toResolverError(err)
+					}
+					return menu, nil
+				},
+			},
+			"deleteMenu": &gql.Field{
+				Type: gql.NewNonNull(gql.Boolean),
+				Args: idArgConfig,
+				Resolve: func(p gql.ResolveParams) (interface{}, error) {
+					id, err := idArg(p, "id")
+					if err != nil {
+						return nil, err
+					}
+					if err := c.MenuService.Delete(p.Context, id); err != nil {
+						return nil, toResolverError(err)
+					}
+					return true, nil
+				},
+			},
+			"createDiner": &gql.Field{
+				Type: gql.NewNonNull(dinerType),
+				Args: gql.FieldConfigArgument{
+					"name":        &gql.ArgumentConfig{Type: gql.NewNonNull(gql.String)},
+					"tableNumber": &gql.ArgumentConfig{Type: gql.NewNonNull(gql.Int)},
+				},
+				Resolve: func(p gql.ResolveParams) (interface{}, error) {
+					diner, err := c.DinerService.Create(p.Context, &useCaseDiner.NewDiner{
+						Name:        p.Args["name"].(string),
+						TableNumber: p.Args["tableNumber"].(int),
+					})
+					if err != nil {
+						return nil, toResolverError(err)
+					}
+					return diner, nil
+				},
+			},
+			"deleteDiner": &gql.Field{
+				Type: gql.NewNonNull(gql.Boolean),
+				Args: idArgConfig,
+				Resolve: func(p gql.ResolveParams) (interface{}, error) {
+					id, err := idArg(p, "id")
+					if err != nil {
+						return nil, err
+					}
+					if err := c.DinerService.Delete(p.Context, id); err != nil {
+						return nil, toResolverError(err)
+					}
+					return true, nil
+				},
+			},
+			"createOrder": &gql.Field{
+				Type: gql.NewNonNull(orderType),
+				Args: gql.FieldConfigArgument{
+					"dinerId":  &gql.ArgumentConfig{Type: gql.NewNonNull(gql.ID)},
+					"menuId":   &gql.ArgumentConfig{Type: gql.NewNonNull(gql.ID)},
+					"quantity": &gql.ArgumentConfig{Type: gql.NewNonNull(gql.Int)},
+				},
+				Resolve: func(p gql.ResolveParams) (interface{}, error) {
+					dinerID, err := idArg(p, "dinerId")
+					if err != nil {
+						return nil, err
+					}
+					menuID, err := idArg(p, "menuId")
+					if err != nil {
+						return nil, err
+					}
+					newOrder := useCaseOrder.NewOrder{DinnerID: dinerID, MenuID: menuID, Quantity: p.Args["quantity"].(int)}
+					created, err := c.OrderService.Create(p.Context, &newOrder)
+					if err != nil {
+						return nil, toResolverError(err)
+					}
+					return &domainOrder.Response{
+						ID:        created.ID,
+						DinerID:   dinerID,
+						MenuID:    menuID,
+						Quantity:  newOrder.Quantity,
+						CreatedAt: created.CreatedAt,
+					}, nil
+				},
+			},
+			"deleteOrder": &gql.Field{
+				Type: gql.NewNonNull(gql.Boolean),
+				Args: idArgConfig,
+				Resolve: func(p gql.ResolveParams) (interface{}, error) {
+					id, err := idArg(p, "id")
+					if err != nil {
+						return nil, err
+					}
+					if err := c.OrderService.Delete(p.Context, int(id)); err != nil {
+						return nil, toResolverError(err)
+					}
+					return true, nil
+				},
+			},
+		},
+	})
+
+	return gql.NewSchema(gql.SchemaConfig{Query: query, Mutation: mutation})
+}
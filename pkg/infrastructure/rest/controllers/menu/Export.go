@@ -0,0 +1,175 @@
+// Package menu contains the menu controller
+package menu
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"time"
+
+	domainMenu "github.com/Raj63/golang-rest-api/pkg/domain/menu"
+	"gopkg.in/yaml.v3"
+)
+
+// Export formats of the menus
+const (
+	exportCSV    = "csv"
+	exportJSON   = "json"
+	exportNDJSON = "ndjson"
+	exportYAML   = "yaml"
+)
+
+// exportFlushRows is the number of menus sent to the client at a time
+const exportFlushRows = 100
+
+// exportMIMEs are the content types of the export formats, negotiated in this order of preference
+var exportMIMEs = []struct {
+	format string
+	mime   string
+}{
+	{exportJSON, "application/json"},
+	{exportCSV, "text/csv"},
+	{exportNDJSON, "application/x-ndjson"},
+	{exportYAML, "application/yaml"},
+}
+
+// exportEncoder writes the menus of an export one at a time, begin is called before the first menu and end after
+// the last one
+type exportEncoder interface {
+	begin() error
+	encode(menu *ExportMenu) error
+	end() error
+}
+
+func newExportEncoder(format string, w io.Writer) exportEncoder {
+	switch format {
+	case exportCSV:
+		return &csvExport{w: csv.NewWriter(w)}
+	case exportNDJSON:
+		return &ndjsonExport{encoder: json.NewEncoder(w)}
+	case exportYAML:
+		return &yamlExport{w: w}
+	default:
+		return &jsonExport{w: w}
+	}
+}
+
+func toExportMenu(menu *domainMenu.Menu) *ExportMenu {
+	return &ExportMenu{
+		ID:          menu.ID,
+		Name:        menu.Name,
+		Description: menu.Description,
+		Category:    menu.Category,
+		Price:       menu.Price,
+		CreatedAt:   menu.CreatedAt,
+		UpdatedAt:   menu.UpdatedAt,
+	}
+}
+
+// csvExport writes a header row and a row per menu, with the columns the import reads by name
+type csvExport struct {
+	w *csv.Writer
+}
+
+func (e *csvExport) begin() error {
+	return e.w.Write([]string{"id", "name", "description", "category", "price", "created_at", "updated_at"})
+}
+
+func (e *csvExport) encode(menu *ExportMenu) error {
+	err := e.w.Write([]string{
+		strconv.FormatInt(menu.ID, 10),
+		menu.Name,
+		menu.Description,
+		menu.Category,
+		strconv.FormatFloat(menu.Price, 'f', 2, 64),
+		menu.CreatedAt.Format(time.RFC3339),
+		menu.UpdatedAt.Format(time.RFC3339),
+	})
+	if err != nil {
+		return err
+	}
+	// the csv writer buffers, flush it so that the rows are sent with the menus of the other formats
+	e.w.Flush()
+	return e.w.Error()
+}
+
+func (e *csvExport) end() error {
+	e.w.Flush()
+	return e.w.Error()
+}
+
+// jsonExport writes a JSON array of the menus
+type jsonExport struct {
+	w     io.Writer
+	count int
+}
+
+func (e *jsonExport) begin() error {
+	_, err := io.WriteString(e.w, "[")
+	return err
+}
+
+func (e *jsonExport) encode(menu *ExportMenu) error {
+	body, err := json.Marshal(menu)
+	if err != nil {
+		return err
+	}
+	separator := "\n"
+	if e.count > 0 {
+		separator = ",\n"
+	}
+	e.count++
+	_, err = e.w.Write(append([]byte(separator), body...))
+	return err
+}
+
+func (e *jsonExport) end() error {
+	_, err := io.WriteString(e.w, "\n]\n")
+	return err
+}
+
+// ndjsonExport writes a JSON object per line for each menu
+type ndjsonExport struct {
+	encoder *json.Encoder
+}
+
+func (e *ndjsonExport) begin() error {
+	return nil
+}
+
+func (e *ndjsonExport) encode(menu *ExportMenu) error {
+	return e.encoder.Encode(menu)
+}
+
+func (e *ndjsonExport) end() error {
+	return nil
+}
+
+// yamlExport writes a YAML sequence of the menus, an item of the sequence at a time
+type yamlExport struct {
+	w     io.Writer
+	count int
+}
+
+func (e *yamlExport) begin() error {
+	return nil
+}
+
+func (e *yamlExport) encode(menu *ExportMenu) error {
+	body, err := yaml.Marshal([]*ExportMenu{menu})
+	if err != nil {
+		return err
+	}
+	e.count++
+	_, err = e.w.Write(body)
+	return err
+}
+
+func (e *yamlExport) end() error {
+	if e.count == 0 {
+		_, err := io.WriteString(e.w, "[]\n")
+		return err
+	}
+	return nil
+}
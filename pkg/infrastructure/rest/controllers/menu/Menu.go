@@ -3,6 +3,7 @@ package menu
 
 import (
 	"errors"
+	"fmt"
 
 	useCaseMenu "github.com/Raj63/golang-rest-api/pkg/app/usecases/menu"
 	domainErrors "github.com/Raj63/golang-rest-api/pkg/domain/errors"
@@ -11,10 +12,13 @@ import (
 
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 )
 
+const dateLayout = "2006-01-02"
+
 // Controller is a struct that contains the menu service
 type Controller struct {
 	MenuService useCaseMenu.Service
@@ -29,9 +33,9 @@ type Controller struct {
 //	@Produce		json
 //	@Param			data	body		NewMenuRequest	true	"body data"
 //	@Success		201		{object}	domainMenu.Menu
-//	@Failure		400		{object}	MessageResponse
-//	@Failure		500		{object}	MessageResponse
-//	@Router			/menus [post]
+//	@Failure		400		{object}	controllers.ProblemDetails
+//	@Failure		500		{object}	controllers.ProblemDetails
+//	@Router			/menus/ [post]
 func (c *Controller) NewMenu(ctx *gin.Context) {
 	var request NewMenuRequest
 
@@ -43,6 +47,7 @@ func (c *Controller) NewMenu(ctx *gin.Context) {
 	newMenu := useCaseMenu.NewMenu{
 		Name:        request.Name,
 		Description: request.Description,
+		Category:    request.Category,
 		Price:       request.Price,
 	}
 
@@ -55,70 +60,268 @@ func (c *Controller) NewMenu(ctx *gin.Context) {
 		return
 	}
 
-	ctx.JSON(http.StatusCreated, result)
+	controllers.Render(ctx, http.StatusCreated, result)
+}
+
+// listFields are the fields the items of a menu list can be trimmed to
+var listFields = []string{"id", "name", "description", "category", "price", "language", "created_at", "updated_at"}
+
+// ImportMenus godoc
+//
+//	@Tags			menus
+//	@Summary		Import Menus
+//	@Description	Create or update, matching by name, the menus of a CSV file with a name, description, category and price header or of a JSON array of menus, all or nothing. Nothing is written when a row is invalid, and a dry run only reports what the import would do.
+//	@Accept			json
+//	@Accept			text/csv
+//	@Produce		json
+//	@Param			data	body		[]NewMenuRequest	true	"menus to import"
+//	@Param			dry_run	query		bool				false	"report the changes and the invalid rows without writing"
+//	@Success		200		{object}	useCaseMenu.ImportResult
+//	@Failure		400		{object}	useCaseMenu.ImportResult
+//	@Failure		500		{object}	controllers.ProblemDetails
+//	@Router			/menus/import [post]
+func (c *Controller) ImportMenus(ctx *gin.Context) {
+	var format string
+	switch ctx.ContentType() {
+	case "text/csv":
+		format = useCaseMenu.ImportFormatCSV
+	case "", "application/json":
+		format = useCaseMenu.ImportFormatJSON
+	default:
+		appError := domainErrors.NewAppError(errors.New("content type is necessary to be application/json or text/csv"), domainErrors.ValidationError)
+		_ = ctx.Error(appError)
+		return
+	}
+
+	var dryRun bool
+	if dryRunStr := ctx.Query("dry_run"); dryRunStr != "" {
+		var err error
+		dryRun, err = strconv.ParseBool(dryRunStr)
+		if err != nil {
+			appError := domainErrors.NewAppError(errors.New("param dry_run is necessary to be a boolean"), domainErrors.ValidationError)
+			_ = ctx.Error(appError)
+			return
+		}
+	}
+
+	result, err := c.MenuService.Import(ctx.Request.Context(), ctx.Request.Body, format, dryRun)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+	if !result.DryRun && !result.Applied {
+		controllers.Render(ctx, http.StatusBadRequest, result)
+		return
+	}
+	controllers.Render(ctx, http.StatusOK, result)
+}
+
+// ExportMenus godoc
+//
+//	@Tags			menus
+//	@Summary		Export Menus
+//	@Description	Stream every menu sorted by name as CSV, JSON, NDJSON or YAML, in the fields the import reads back
+//	@Produce		json
+//	@Produce		text/csv
+//	@Produce		application/x-ndjson
+//	@Produce		application/yaml
+//	@Param			format	query		string	false	"json, csv, ndjson or yaml, overrides the Accept header"
+//	@Success		200		{array}		ExportMenu
+//	@Failure		400		{object}	controllers.ProblemDetails
+//	@Failure		500		{object}	controllers.ProblemDetails
+//	@Router			/menus/export [get]
+func (c *Controller) ExportMenus(ctx *gin.Context) {
+	format, mime := ctx.Query("format"), ""
+	for _, export := range exportMIMEs {
+		if format == export.format {
+			mime = export.mime
+		}
+	}
+	switch {
+	case format == "":
+		offers := make([]string, len(exportMIMEs))
+		for i, export := range exportMIMEs {
+			offers[i] = export.mime
+		}
+		format, mime = exportJSON, ctx.NegotiateFormat(offers...)
+		for _, export := range exportMIMEs {
+			if mime == export.mime {
+				format = export.format
+			}
+		}
+		if mime == "" {
+			mime = gin.MIMEJSON
+		}
+	case mime == "":
+		appError := domainErrors.NewAppError(errors.New("param format must be json, csv, ndjson or yaml"), domainErrors.ValidationError)
+		_ = ctx.Error(appError)
+		return
+	}
+
+	// the status and the first bytes are only sent with the first menu, so that a failing query is still answered
+	// with an error status
+	encoder := newExportEncoder(format, ctx.Writer)
+	count := 0
+	start := func() error {
+		ctx.Header("Content-Type", mime+"; charset=utf-8")
+		ctx.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="menus.%s"`, format))
+		ctx.Status(http.StatusOK)
+		return encoder.begin()
+	}
+	err := c.MenuService.Export(ctx.Request.Context(), func(menu *domainMenu.Menu) error {
+		if count == 0 {
+			if err := start(); err != nil {
+				return err
+			}
+		}
+		count++
+		if err := encoder.encode(toExportMenu(menu)); err != nil {
+			return err
+		}
+		if count%exportFlushRows == 0 {
+			ctx.Writer.Flush()
+		}
+		return nil
+	})
+	if err == nil && count == 0 {
+		err = start()
+	}
+	if err == nil {
+		err = encoder.end()
+	}
+	if err != nil {
+		if count == 0 {
+			_ = ctx.Error(err)
+			return
+		}
+		// the export is already streaming, the client is left with a truncated body and the error is only logged
+		_ = ctx.Error(fmt.Errorf("menu export stopped after %d menus: %w", count, err))
+	}
 }
 
 // GetAllMenus godoc
 //
 //	@Tags			menus
 //	@Summary		Get all Menus
-//	@Description	Get all Menus on the system
-//	@Param			limit	query		int64	true	"limit"
-//	@Param			page	query		int64	true	"page"
-//	@Success		200		{object}	[]useCaseMenu.PaginationResultMenu
-//	@Failure		400		{object}	MessageResponse
-//	@Failure		500		{object}	MessageResponse
-//	@Router			/menus [get]
+//	@Description	Get all Menus on the system sorted by name or by sort, in keyset pages following the opaque cursors, or by page number when page is given
+//	@Param			limit			query		int64	false	"limit"
+//	@Param			cursor			query		string	false	"NextCursor or PrevCursor of a previous page"
+//	@Param			with_total		query		bool	false	"count the total of a keyset page"
+//	@Param			page			query		int64	false	"page number, counts the total on every call"
+//	@Param			sort			query		string	false	"comma separated fields, descending when prefixed by a minus, e.g. -price,name"
+//	@Param			fields			query		string	false	"comma separated fields of each item to return, e.g. id,name,price"
+//	@Param			Accept-Language	header		string	false	"languages to translate the names and descriptions in, falling back to the default language"
+//	@Param			If-None-Match	header		string	false	"ETag of a previous response, answered with 304 Not Modified while unchanged"
+//	@Success		200				{object}	useCaseMenu.PaginationResultMenu
+//	@Header			200				{string}	Link				"first, next and prev pages"
+//	@Header			200				{string}	ETag				"strong tag of the body"
+//	@Header			200				{string}	Content-Language	"languages of the menus"
+//	@Failure		400				{object}	controllers.ProblemDetails
+//	@Failure		500				{object}	controllers.ProblemDetails
+//	@Router			/menus/ [get]
 func (c *Controller) GetAllMenus(ctx *gin.Context) {
-	pageStr := ctx.DefaultQuery("page", "1")
-	limitStr := ctx.DefaultQuery("limit", "20")
-
-	page, err := strconv.ParseInt(pageStr, 10, 64)
+	params, err := controllers.BindPageParams(ctx)
 	if err != nil {
-		appError := domainErrors.NewAppError(errors.New("param page is necessary to be an integer"), domainErrors.ValidationError)
-		_ = ctx.Error(appError)
+		_ = ctx.Error(err)
 		return
 	}
-	limit, err := strconv.ParseInt(limitStr, 10, 64)
+	fields, err := controllers.BindFields(ctx, listFields)
 	if err != nil {
-		appError := domainErrors.NewAppError(errors.New("param limit is necessary to be an integer"), domainErrors.ValidationError)
-		_ = ctx.Error(appError)
+		_ = ctx.Error(err)
 		return
 	}
 
-	menus, err := c.MenuService.GetAll(ctx.Request.Context(), page, limit)
+	var menus *useCaseMenu.PaginationResultMenu
+	if params.Page > 0 && params.Sort == "" {
+		menus, err = c.MenuService.GetAll(ctx.Request.Context(), params.Page, params.Limit)
+	} else {
+		menus, err = c.MenuService.GetPage(ctx.Request.Context(), &useCaseMenu.PageQuery{
+			Cursor:    params.Cursor,
+			Page:      params.Page,
+			Limit:     params.Limit,
+			Sort:      params.Sort,
+			WithTotal: params.WithTotal,
+		})
+	}
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+	if err = c.localise(ctx, *menus.Data); err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+	result, err := controllers.SparseFieldset(menus, fields)
 	if err != nil {
 		_ = ctx.Error(err)
 		return
 	}
-	ctx.JSON(http.StatusOK, menus)
+	controllers.SetLinkHeader(ctx, menus.NextCursor, menus.PrevCursor)
+	controllers.Render(ctx, http.StatusOK, result)
 }
 
 // GetTopMenus godoc
 //
-//		@Tags			menus
-//		@Summary		Get top menus by count
-//		@Description	Get Top Menus by count on the system
-//	    @Param          count       query int true     "top count"
-//		@Success		200			{object}	[]domainMenu.Menu
-//		@Failure		400			{object}	MessageResponse
-//		@Failure		500			{object}	MessageResponse
-//		@Router			/menus/top [get]
+//	@Tags			menus
+//	@Summary		Get top menus
+//	@Description	Get the top menus by quantity ordered or revenue earned, optionally within a date window and ranked within each category
+//	@Param			count			query		int		true	"top count"
+//	@Param			from			query		string	false	"first day of orders to consider (YYYY-MM-DD)"
+//	@Param			to				query		string	false	"last day of orders to consider (YYYY-MM-DD)"
+//	@Param			rank_by			query		string	false	"quantity (default) or revenue"
+//	@Param			group_by		query		string	false	"category to rank within each category"
+//	@Param			Accept-Language	header		string	false	"languages to translate the names and descriptions in, falling back to the default language"
+//	@Param			If-None-Match	header		string	false	"ETag of a previous response, answered with 304 Not Modified while unchanged"
+//	@Success		200				{object}	[]domainMenu.Menu
+//	@Header			200				{string}	ETag	"strong tag of the body"
+//	@Failure		400				{object}	controllers.ProblemDetails
+//	@Failure		500				{object}	controllers.ProblemDetails
+//	@Router			/menus/top [get]
 func (c *Controller) GetTopMenus(ctx *gin.Context) {
 	count, err := strconv.Atoi(ctx.Query("count"))
 	if err != nil {
-		appError := domainErrors.NewAppError(errors.New("menu id is invalid"), domainErrors.ValidationError)
+		appError := domainErrors.NewAppError(errors.New("param count is necessary to be an integer"), domainErrors.ValidationError)
 		_ = ctx.Error(appError)
 		return
 	}
 
-	domainMenu, err := c.MenuService.GetByTopCount(ctx.Request.Context(), count)
+	top := useCaseMenu.TopMenus{
+		Count:   count,
+		RankBy:  ctx.Query("rank_by"),
+		GroupBy: ctx.Query("group_by"),
+	}
+	if fromStr := ctx.Query("from"); fromStr != "" {
+		from, err := time.ParseInLocation(dateLayout, fromStr, time.Local)
+		if err != nil {
+			appError := domainErrors.NewAppError(errors.New("param from is necessary to be a date formatted as YYYY-MM-DD"), domainErrors.ValidationError)
+			_ = ctx.Error(appError)
+			return
+		}
+		top.From = &from
+	}
+	if toStr := ctx.Query("to"); toStr != "" {
+		to, err := time.ParseInLocation(dateLayout, toStr, time.Local)
+		if err != nil {
+			appError := domainErrors.NewAppError(errors.New("param to is necessary to be a date formatted as YYYY-MM-DD"), domainErrors.ValidationError)
+			_ = ctx.Error(appError)
+			return
+		}
+		// the last day is included in the window
+		to = to.AddDate(0, 0, 1)
+		top.To = &to
+	}
+
+	domainMenu, err := c.MenuService.GetByTopCount(ctx.Request.Context(), &top)
 	if err != nil {
 		_ = ctx.Error(err)
 		return
 	}
+	if err = c.localise(ctx, domainMenu); err != nil {
+		_ = ctx.Error(err)
+		return
+	}
 
-	ctx.JSON(http.StatusOK, domainMenu)
+	controllers.Render(ctx, http.StatusOK, domainMenu)
 }
 
 // GetMenusByID godoc
@@ -126,10 +329,16 @@ func (c *Controller) GetTopMenus(ctx *gin.Context) {
 //	@Tags			menus
 //	@Summary		Get menus by ID
 //	@Description	Get Menus by ID on the system
-//	@Param			menu_id	path		int64	true	"id of menu"
-//	@Success		200			{object}	domainMenu.Menu
-//	@Failure		400			{object}	MessageResponse
-//	@Failure		500			{object}	MessageResponse
+//	@Param			menu_id				path		int64	true	"id of menu"
+//	@Param			Accept-Language		header		string	false	"languages to translate the name and description in, falling back to the default language"
+//	@Param			If-None-Match		header		string	false	"ETag of a previous response, answered with 304 Not Modified while unchanged"
+//	@Param			If-Modified-Since	header		string	false	"Last-Modified of a previous response, answered with 304 Not Modified while unchanged"
+//	@Success		200					{object}	domainMenu.Menu
+//	@Header			200					{string}	ETag			"strong tag of the body"
+//	@Header			200					{string}	Last-Modified	"updated_at of the resource"
+//	@Failure		400					{object}	controllers.ProblemDetails
+//	@Failure		404					{object}	controllers.ProblemDetails
+//	@Failure		500					{object}	controllers.ProblemDetails
 //	@Router			/menus/{menu_id} [get]
 func (c *Controller) GetMenusByID(ctx *gin.Context) {
 	menuID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
@@ -139,13 +348,19 @@ func (c *Controller) GetMenusByID(ctx *gin.Context) {
 		return
 	}
 
-	domainMenu, err := c.MenuService.GetByID(ctx.Request.Context(), menuID)
+	menu, err := c.MenuService.GetByID(ctx.Request.Context(), menuID)
 	if err != nil {
 		_ = ctx.Error(err)
 		return
 	}
+	menus := []domainMenu.Menu{*menu}
+	if err = c.localise(ctx, menus); err != nil {
+		_ = ctx.Error(err)
+		return
+	}
 
-	ctx.JSON(http.StatusOK, domainMenu)
+	controllers.SetLastModified(ctx, menus[0].UpdatedAt)
+	controllers.Render(ctx, http.StatusOK, &menus[0])
 }
 
 // DeleteMenu is the controller to delete a menu
@@ -154,9 +369,9 @@ func (c *Controller) GetMenusByID(ctx *gin.Context) {
 //	@Summary		Delete menus by ID
 //	@Description	Delete Menus by ID on the system
 //	@Param			menu_id	path		int64	true	"id of menu"
-//	@Success		200			{object}	MessageResponse
-//	@Failure		400			{object}	MessageResponse
-//	@Failure		500			{object}	MessageResponse
+//	@Success		200		{object}	MessageResponse
+//	@Failure		400		{object}	controllers.ProblemDetails
+//	@Failure		500		{object}	controllers.ProblemDetails
 //	@Router			/menus/{menu_id} [delete]
 func (c *Controller) DeleteMenu(ctx *gin.Context) {
 	menuID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
@@ -171,5 +386,5 @@ func (c *Controller) DeleteMenu(ctx *gin.Context) {
 		_ = ctx.Error(err)
 		return
 	}
-	ctx.JSON(http.StatusOK, gin.H{"message": "resource deleted successfully"})
+	controllers.Render(ctx, http.StatusOK, gin.H{"message": "resource deleted successfully"})
 }
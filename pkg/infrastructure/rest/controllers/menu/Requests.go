@@ -3,7 +3,14 @@ package menu
 
 // NewMenuRequest is a struct that contains the new menu request information
 type NewMenuRequest struct {
-	Name        string  `json:"name" example:"Paracetamol" binding:"required"`
-	Description string  `json:"description" example:"Something" binding:"required"`
-	Price       float64 `json:"price" example:"200.50" binding:"required"`
+	Name        string  `json:"name" example:"Paracetamol" binding:"required,max=120"`
+	Description string  `json:"description" example:"Something" binding:"required,max=255"`
+	Category    string  `json:"category" example:"briyani" binding:"max=60"`
+	Price       float64 `json:"price" example:"200.50" binding:"required,price"`
+}
+
+// TranslationRequest is a struct that contains the name and description of a menu in a language
+type TranslationRequest struct {
+	Name        string `json:"name" example:"Biryani Dum d'Hyderabad" binding:"required,max=120"`
+	Description string `json:"description" example:"Riz parfumé cuit à l'étouffée" binding:"max=255"`
 }
@@ -1,7 +1,20 @@
 // Package menu contains the menu controller
 package menu
 
+import "time"
+
 // MessageResponse is a struct that contains the response body for the message
 type MessageResponse struct {
 	Message string `json:"message"`
 }
+
+// ExportMenu is a struct that contains a menu of an export, in the fields read back by the import
+type ExportMenu struct {
+	ID          int64     `json:"id" yaml:"id" example:"123"`
+	Name        string    `json:"name" yaml:"name" example:"Hyderabadi Dum Briyani"`
+	Description string    `json:"description" yaml:"description" example:"Some Description"`
+	Category    string    `json:"category" yaml:"category" example:"briyani"`
+	Price       float64   `json:"price" yaml:"price" example:"200.50"`
+	CreatedAt   time.Time `json:"created_at" yaml:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at" yaml:"updated_at"`
+}
@@ -0,0 +1,128 @@
+// Package menu contains the menu controller
+package menu
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	useCaseMenu "github.com/Raj63/golang-rest-api/pkg/app/usecases/menu"
+	domainErrors "github.com/Raj63/golang-rest-api/pkg/domain/errors"
+	domainMenu "github.com/Raj63/golang-rest-api/pkg/domain/menu"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers"
+	"github.com/gin-gonic/gin"
+)
+
+// localise translates the menus in the languages of the Accept-Language of the request, when it has one
+func (c *Controller) localise(ctx *gin.Context, menus []domainMenu.Menu) error {
+	languages := controllers.AcceptLanguages(ctx)
+	if len(languages) == 0 {
+		return nil
+	}
+	if err := c.MenuService.Localise(ctx.Request.Context(), menus, languages); err != nil {
+		return err
+	}
+
+	contentLanguages := make([]string, len(menus))
+	for i := range menus {
+		contentLanguages[i] = menus[i].Language
+	}
+	controllers.SetContentLanguage(ctx, contentLanguages...)
+	return nil
+}
+
+// GetTranslations godoc
+//
+//	@Tags			menus
+//	@Summary		Get the translations of a menu
+//	@Description	Get the name and description of a menu in every language it is translated in
+//	@Param			menu_id	path		int64	true	"id of menu"
+//	@Success		200		{object}	[]domainMenu.Translation
+//	@Failure		400		{object}	controllers.ProblemDetails
+//	@Failure		404		{object}	controllers.ProblemDetails
+//	@Failure		500		{object}	controllers.ProblemDetails
+//	@Router			/menus/{menu_id}/translations [get]
+func (c *Controller) GetTranslations(ctx *gin.Context) {
+	menuID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		appError := domainErrors.NewAppError(errors.New("menu id is invalid"), domainErrors.ValidationError)
+		_ = ctx.Error(appError)
+		return
+	}
+
+	translations, err := c.MenuService.GetTranslations(ctx.Request.Context(), menuID)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+	controllers.Render(ctx, http.StatusOK, translations)
+}
+
+// PutTranslation godoc
+//
+//	@Tags			menus
+//	@Summary		Translate a menu
+//	@Description	Create or replace the name and description of a menu in a language other than the default, which the description falls back to when empty
+//	@Accept			json
+//	@Produce		json
+//	@Param			menu_id		path		int64				true	"id of menu"
+//	@Param			language	path		string				true	"language tag, e.g. fr or pt-br"
+//	@Param			data		body		TranslationRequest	true	"body data"
+//	@Success		200			{object}	domainMenu.Translation
+//	@Failure		400			{object}	controllers.ProblemDetails
+//	@Failure		404			{object}	controllers.ProblemDetails
+//	@Failure		500			{object}	controllers.ProblemDetails
+//	@Router			/menus/{menu_id}/translations/{language} [put]
+func (c *Controller) PutTranslation(ctx *gin.Context) {
+	menuID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		appError := domainErrors.NewAppError(errors.New("menu id is invalid"), domainErrors.ValidationError)
+		_ = ctx.Error(appError)
+		return
+	}
+	var request TranslationRequest
+	if err := controllers.BindJSON(ctx, &request); err != nil {
+		appError := domainErrors.NewAppError(err, domainErrors.ValidationError)
+		_ = ctx.Error(appError)
+		return
+	}
+
+	translation, err := c.MenuService.PutTranslation(ctx.Request.Context(), &useCaseMenu.NewTranslation{
+		MenuID:      menuID,
+		Language:    ctx.Param("language"),
+		Name:        request.Name,
+		Description: request.Description,
+	})
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+	controllers.Render(ctx, http.StatusOK, translation)
+}
+
+// DeleteTranslation godoc
+//
+//	@Tags			menus
+//	@Summary		Delete the translation of a menu
+//	@Description	Delete the translation of a menu in a language, which then falls back to the default language
+//	@Param			menu_id		path	int64	true	"id of menu"
+//	@Param			language	path	string	true	"language tag, e.g. fr or pt-br"
+//	@Success		204
+//	@Failure		400	{object}	controllers.ProblemDetails
+//	@Failure		404	{object}	controllers.ProblemDetails
+//	@Failure		500	{object}	controllers.ProblemDetails
+//	@Router			/menus/{menu_id}/translations/{language} [delete]
+func (c *Controller) DeleteTranslation(ctx *gin.Context) {
+	menuID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		appError := domainErrors.NewAppError(errors.New("menu id is invalid"), domainErrors.ValidationError)
+		_ = ctx.Error(appError)
+		return
+	}
+
+	if err := c.MenuService.DeleteTranslation(ctx.Request.Context(), menuID, ctx.Param("language")); err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
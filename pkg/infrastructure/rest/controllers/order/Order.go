@@ -29,9 +29,9 @@ type Controller struct {
 //	@Produce		json
 //	@Param			data	body		NewOrderRequest	true	"body data"
 //	@Success		201		{object}	domainOrder.Request
-//	@Failure		400		{object}	MessageResponse
-//	@Failure		500		{object}	MessageResponse
-//	@Router			/orders [post]
+//	@Failure		400		{object}	controllers.ProblemDetails
+//	@Failure		500		{object}	controllers.ProblemDetails
+//	@Router			/orders/ [post]
 func (c *Controller) NewOrder(ctx *gin.Context) {
 	var request NewOrderRequest
 
@@ -55,7 +55,7 @@ func (c *Controller) NewOrder(ctx *gin.Context) {
 		return
 	}
 
-	ctx.JSON(http.StatusCreated, result)
+	controllers.Render(ctx, http.StatusCreated, result)
 }
 
 // GetOrdersByDinerID godoc
@@ -63,11 +63,11 @@ func (c *Controller) NewOrder(ctx *gin.Context) {
 //	@Tags			orders
 //	@Summary		Get orders by Diner ID
 //	@Description	Get orders by Diner ID on the system
-//	@Param			diner_id	path		int64	true	"id of diner"
-//	@Success		200			{object}	[]domainOrder.Response
-//	@Failure		400			{object}	MessageResponse
-//	@Failure		500			{object}	MessageResponse
-//	@Router			/orders/{diner_id} [get]
+//	@Param			id	path		int64	true	"id of diner"
+//	@Success		200	{object}	[]domainOrder.Response
+//	@Failure		400	{object}	controllers.ProblemDetails
+//	@Failure		500	{object}	controllers.ProblemDetails
+//	@Router			/orders/{id} [get]
 func (c *Controller) GetOrdersByDinerID(ctx *gin.Context) {
 	orderID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
 	if err != nil {
@@ -82,7 +82,69 @@ func (c *Controller) GetOrdersByDinerID(ctx *gin.Context) {
 		return
 	}
 
-	ctx.JSON(http.StatusOK, domainOrders)
+	controllers.Render(ctx, http.StatusOK, domainOrders)
+}
+
+// GetDinerOrders godoc
+//
+//	@Tags			orders
+//	@Summary		Get orders of a diner
+//	@Description	Get the orders of the current session of a diner, or of every session when history is requested
+//	@Param			diner_id	path		int64	true	"id of diner"
+//	@Param			history		query		bool	false	"include the orders of previous sessions"
+//	@Success		200			{object}	[]domainOrder.Response
+//	@Failure		400			{object}	controllers.ProblemDetails
+//	@Failure		500			{object}	controllers.ProblemDetails
+//	@Router			/diners/{diner_id}/orders [get]
+func (c *Controller) GetDinerOrders(ctx *gin.Context) {
+	dinerID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		appError := domainErrors.NewAppError(errors.New("diner id is invalid"), domainErrors.ValidationError)
+		_ = ctx.Error(appError)
+		return
+	}
+	history, err := strconv.ParseBool(ctx.DefaultQuery("history", "false"))
+	if err != nil {
+		appError := domainErrors.NewAppError(errors.New("param history is necessary to be a boolean"), domainErrors.ValidationError)
+		_ = ctx.Error(appError)
+		return
+	}
+
+	domainOrders, err := c.OrderService.GetByDinerID(ctx.Request.Context(), dinerID, history)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	controllers.Render(ctx, http.StatusOK, domainOrders)
+}
+
+// ServeOrder godoc
+//
+//	@Tags			orders
+//	@Summary		Serve an order
+//	@Description	Record that an order has been served to the table
+//	@Param			order_id	path		int64	true	"id of order"
+//	@Success		200			{object}	MessageResponse
+//	@Failure		400			{object}	controllers.ProblemDetails
+//	@Failure		404			{object}	controllers.ProblemDetails
+//	@Failure		409			{object}	controllers.ProblemDetails
+//	@Failure		500			{object}	controllers.ProblemDetails
+//	@Router			/orders/{order_id}/serve [post]
+func (c *Controller) ServeOrder(ctx *gin.Context) {
+	orderID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		appError := domainErrors.NewAppError(errors.New("param order id is necessary in the url"), domainErrors.ValidationError)
+		_ = ctx.Error(appError)
+		return
+	}
+
+	err = c.OrderService.Serve(ctx.Request.Context(), orderID)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+	controllers.Render(ctx, http.StatusOK, gin.H{"message": "order served"})
 }
 
 // DeleteOrder is the controller to delete a order
@@ -90,11 +152,11 @@ func (c *Controller) GetOrdersByDinerID(ctx *gin.Context) {
 //	@Tags			orders
 //	@Summary		Delete orders by ID
 //	@Description	Delete orders by ID on the system
-//	@Param			order_id	path		int64	true	"id of order"
-//	@Success		200			{object}	MessageResponse
-//	@Failure		400			{object}	MessageResponse
-//	@Failure		500			{object}	MessageResponse
-//	@Router			/orders/{order_id} [delete]
+//	@Param			id	path		int64	true	"id of order"
+//	@Success		200	{object}	MessageResponse
+//	@Failure		400	{object}	controllers.ProblemDetails
+//	@Failure		500	{object}	controllers.ProblemDetails
+//	@Router			/orders/{id} [delete]
 func (c *Controller) DeleteOrder(ctx *gin.Context) {
 	orderID, err := strconv.Atoi(ctx.Param("id"))
 	if err != nil {
@@ -108,5 +170,5 @@ func (c *Controller) DeleteOrder(ctx *gin.Context) {
 		_ = ctx.Error(err)
 		return
 	}
-	ctx.JSON(http.StatusOK, gin.H{"message": "resource deleted successfully"})
+	controllers.Render(ctx, http.StatusOK, gin.H{"message": "resource deleted successfully"})
 }
@@ -0,0 +1,137 @@
+// Package privacy contains the privacy requests controller
+package privacy
+
+import (
+	"errors"
+	"fmt"
+
+	useCasePrivacy "github.com/Raj63/golang-rest-api/pkg/app/usecases/privacy"
+	domainErrors "github.com/Raj63/golang-rest-api/pkg/domain/errors"
+	domainPrivacy "github.com/Raj63/golang-rest-api/pkg/domain/privacy"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/middlewares"
+
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Controller is a struct that contains the privacy service
+type Controller struct {
+	PrivacyService useCasePrivacy.Service
+}
+
+// ExportDiner godoc
+//
+//	@Tags			admin
+//	@Summary		Export the personal data of a diner
+//	@Description	Download everything held about a diner (profile, sessions, orders, payments and linked customer profiles) as a JSON archive
+//	@Accept			json
+//	@Produce		json
+//	@Param			diner_id	path		int64			true	"id of diner"
+//	@Param			data		body		PrivacyRequest	true	"body data"
+//	@Success		200			{object}	domainPrivacy.Archive
+//	@Failure		400			{object}	controllers.ProblemDetails
+//	@Failure		401			{object}	controllers.ProblemDetails
+//	@Failure		404			{object}	controllers.ProblemDetails
+//	@Failure		500			{object}	controllers.ProblemDetails
+//	@Router			/admin/diners/{diner_id}/export [post]
+func (c *Controller) ExportDiner(ctx *gin.Context) {
+	dinerID, request, ok := bindRequest(ctx)
+	if !ok {
+		return
+	}
+
+	var archive *domainPrivacy.Archive
+	var err error
+
+	archive, err = c.PrivacyService.Export(ctx.Request.Context(), dinerID, request)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="diner-%d-export.json"`, dinerID))
+	ctx.JSON(http.StatusOK, archive)
+}
+
+// AnonymiseDiner godoc
+//
+//	@Tags			admin
+//	@Summary		Anonymise a diner
+//	@Description	Erase the personal data of a diner, their linked customer profiles and the reservations and waitlist entries made in their name, keeping the order and revenue history
+//	@Accept			json
+//	@Produce		json
+//	@Param			diner_id	path		int64			true	"id of diner"
+//	@Param			data		body		PrivacyRequest	true	"body data"
+//	@Success		200			{object}	domainPrivacy.Request
+//	@Failure		400			{object}	controllers.ProblemDetails
+//	@Failure		401			{object}	controllers.ProblemDetails
+//	@Failure		404			{object}	controllers.ProblemDetails
+//	@Failure		500			{object}	controllers.ProblemDetails
+//	@Router			/admin/diners/{diner_id}/anonymise [post]
+func (c *Controller) AnonymiseDiner(ctx *gin.Context) {
+	dinerID, request, ok := bindRequest(ctx)
+	if !ok {
+		return
+	}
+
+	record, err := c.PrivacyService.Anonymise(ctx.Request.Context(), dinerID, request)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, record)
+}
+
+// GetPrivacyRequests godoc
+//
+//	@Tags			admin
+//	@Summary		Get the privacy requests of a diner
+//	@Description	Get the audit trail of the exports and anonymisations of a diner, latest first
+//	@Param			diner_id	path		int64	true	"id of diner"
+//	@Success		200			{object}	[]domainPrivacy.Request
+//	@Failure		400			{object}	controllers.ProblemDetails
+//	@Failure		401			{object}	controllers.ProblemDetails
+//	@Failure		500			{object}	controllers.ProblemDetails
+//	@Router			/admin/diners/{diner_id}/privacy-requests [get]
+func (c *Controller) GetPrivacyRequests(ctx *gin.Context) {
+	dinerID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		appError := domainErrors.NewAppError(errors.New("diner id is invalid"), domainErrors.ValidationError)
+		_ = ctx.Error(appError)
+		return
+	}
+
+	requests, err := c.PrivacyService.GetRequests(ctx.Request.Context(), dinerID)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, requests)
+}
+
+func bindRequest(ctx *gin.Context) (int64, *useCasePrivacy.NewRequest, bool) {
+	dinerID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		appError := domainErrors.NewAppError(errors.New("param diner id is necessary in the url"), domainErrors.ValidationError)
+		_ = ctx.Error(appError)
+		return 0, nil, false
+	}
+
+	var request PrivacyRequest
+	if err := controllers.BindJSON(ctx, &request); err != nil {
+		appError := domainErrors.NewAppError(err, domainErrors.ValidationError)
+		_ = ctx.Error(appError)
+		return 0, nil, false
+	}
+
+	return dinerID, &useCasePrivacy.NewRequest{
+		RequestedBy: middlewares.AuthSubject(ctx),
+		Reason:      request.Reason,
+		Channel:     domainPrivacy.ChannelAPI,
+	}, true
+}
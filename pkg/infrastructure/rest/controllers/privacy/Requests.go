@@ -0,0 +1,8 @@
+// Package privacy contains the privacy requests controller
+package privacy
+
+// PrivacyRequest is a struct that contains why a personal data request is made, the subject of the token of the
+// request being recorded as who asked for it
+type PrivacyRequest struct {
+	Reason string `json:"reason" example:"subject access request #42"`
+}
@@ -0,0 +1,7 @@
+// Package privacy contains the privacy requests controller
+package privacy
+
+// MessageResponse is a struct that contains the response body for the message
+type MessageResponse struct {
+	Message string `json:"message"`
+}
@@ -0,0 +1,186 @@
+// Package report contains the report controller
+package report
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"strconv"
+
+	useCaseReport "github.com/Raj63/golang-rest-api/pkg/app/usecases/report"
+	domainErrors "github.com/Raj63/golang-rest-api/pkg/domain/errors"
+	domainReport "github.com/Raj63/golang-rest-api/pkg/domain/report"
+
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	dateLayout = "2006-01-02"
+	mimeCSV    = "text/csv"
+)
+
+// Controller is a struct that contains the report service
+type Controller struct {
+	ReportService useCaseReport.Service
+}
+
+// GetSales godoc
+//
+//	@Tags			reports
+//	@Summary		Get sales report
+//	@Description	Get the revenue, tickets, average ticket and items sold between two days (both included, last 7 days by default) grouped by day, hour, weekday, menu or table, as JSON or CSV
+//	@Produce		json
+//	@Produce		text/csv
+//	@Param			from		query		string	false	"first day (YYYY-MM-DD)"
+//	@Param			to			query		string	false	"last day (YYYY-MM-DD), today by default"
+//	@Param			group_by	query		string	false	"day (default), hour, weekday, menu or table"
+//	@Param			format		query		string	false	"json or csv, overrides the Accept header"
+//	@Success		200			{object}	domainReport.Sales
+//	@Failure		400			{object}	controllers.ProblemDetails
+//	@Failure		500			{object}	controllers.ProblemDetails
+//	@Router			/reports/sales [get]
+func (c *Controller) GetSales(ctx *gin.Context) {
+	from, to, ok := bindRange(ctx)
+	if !ok {
+		return
+	}
+	format, ok := bindFormat(ctx)
+	if !ok {
+		return
+	}
+
+	// the last day is included in the report
+	query := useCaseReport.SalesQuery{From: from, To: to.AddDate(0, 0, 1), GroupBy: ctx.Query("group_by")}
+
+	var sales *domainReport.Sales
+	sales, err := c.ReportService.GetSales(ctx.Request.Context(), &query)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	if format != "csv" {
+		ctx.JSON(http.StatusOK, sales)
+		return
+	}
+
+	records := [][]string{{sales.GroupBy, "revenue", "orders", "average_ticket", "items_sold"}}
+	for _, row := range sales.Rows {
+		records = append(records, []string{
+			row.Group,
+			strconv.FormatFloat(row.Revenue, 'f', 2, 64),
+			strconv.Itoa(row.Orders),
+			strconv.FormatFloat(row.AverageTicket, 'f', 2, 64),
+			strconv.Itoa(row.ItemsSold),
+		})
+	}
+	writeCSV(ctx, fmt.Sprintf("sales-by-%s-%s-%s.csv", sales.GroupBy, from.Format(dateLayout), to.Format(dateLayout)), records)
+}
+
+// GetServiceTimes godoc
+//
+//	@Tags			reports
+//	@Summary		Get service times report
+//	@Description	Get the average seat-to-first-order, order-to-serve and table occupancy minutes, and the total occupancy minutes, of the diners seated between two days (both included, last 7 days by default) grouped by table, section, daypart or weekday, as JSON or CSV
+//	@Produce		json
+//	@Produce		text/csv
+//	@Param			from		query		string	false	"first day (YYYY-MM-DD)"
+//	@Param			to			query		string	false	"last day (YYYY-MM-DD), today by default"
+//	@Param			group_by	query		string	false	"table (default), section, daypart or weekday"
+//	@Param			format		query		string	false	"json or csv, overrides the Accept header"
+//	@Success		200			{object}	domainReport.ServiceTimes
+//	@Failure		400			{object}	controllers.ProblemDetails
+//	@Failure		500			{object}	controllers.ProblemDetails
+//	@Router			/reports/service-times [get]
+func (c *Controller) GetServiceTimes(ctx *gin.Context) {
+	from, to, ok := bindRange(ctx)
+	if !ok {
+		return
+	}
+	format, ok := bindFormat(ctx)
+	if !ok {
+		return
+	}
+
+	// the last day is included in the report
+	query := useCaseReport.ServiceTimesQuery{From: from, To: to.AddDate(0, 0, 1), GroupBy: ctx.Query("group_by")}
+
+	var serviceTimes *domainReport.ServiceTimes
+	serviceTimes, err := c.ReportService.GetServiceTimes(ctx.Request.Context(), &query)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	if format != "csv" {
+		ctx.JSON(http.StatusOK, serviceTimes)
+		return
+	}
+
+	records := [][]string{{serviceTimes.GroupBy, "sessions", "seat_to_first_order", "order_to_serve", "occupancy", "total_occupancy"}}
+	for _, row := range serviceTimes.Rows {
+		records = append(records, []string{
+			row.Group,
+			strconv.Itoa(row.Sessions),
+			strconv.FormatFloat(row.SeatToFirstOrder, 'f', 1, 64),
+			strconv.FormatFloat(row.OrderToServe, 'f', 1, 64),
+			strconv.FormatFloat(row.Occupancy, 'f', 1, 64),
+			strconv.FormatFloat(row.TotalOccupancy, 'f', 1, 64),
+		})
+	}
+	writeCSV(ctx, fmt.Sprintf("service-times-by-%s-%s-%s.csv", serviceTimes.GroupBy, from.Format(dateLayout), to.Format(dateLayout)), records)
+}
+
+// bindRange reads the first and last days of a report, both included, which default to the last 7 days
+func bindRange(ctx *gin.Context) (from time.Time, to time.Time, ok bool) {
+	today := time.Now().Format(dateLayout)
+	to, err := time.ParseInLocation(dateLayout, ctx.DefaultQuery("to", today), time.Local)
+	if err != nil {
+		appError := domainErrors.NewAppError(errors.New("param to is necessary to be a date formatted as YYYY-MM-DD"), domainErrors.ValidationError)
+		_ = ctx.Error(appError)
+		return from, to, false
+	}
+	from = to.AddDate(0, 0, -6)
+	if fromStr := ctx.Query("from"); fromStr != "" {
+		from, err = time.ParseInLocation(dateLayout, fromStr, time.Local)
+		if err != nil {
+			appError := domainErrors.NewAppError(errors.New("param from is necessary to be a date formatted as YYYY-MM-DD"), domainErrors.ValidationError)
+			_ = ctx.Error(appError)
+			return from, to, false
+		}
+	}
+	return from, to, true
+}
+
+// bindFormat reads the format of a report from the format param, or else from the Accept header
+func bindFormat(ctx *gin.Context) (string, bool) {
+	format := ctx.Query("format")
+	switch format {
+	case "":
+		if ctx.NegotiateFormat(gin.MIMEJSON, mimeCSV) == mimeCSV {
+			return "csv", true
+		}
+		return "json", true
+	case "json", "csv":
+		return format, true
+	default:
+		appError := domainErrors.NewAppError(errors.New("param format must be json or csv"), domainErrors.ValidationError)
+		_ = ctx.Error(appError)
+		return "", false
+	}
+}
+
+func writeCSV(ctx *gin.Context, filename string, records [][]string) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.WriteAll(records); err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+	ctx.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	ctx.Data(http.StatusOK, mimeCSV+"; charset=utf-8", buf.Bytes())
+}
@@ -0,0 +1,7 @@
+// Package report contains the report controller
+package report
+
+// MessageResponse is a struct that contains the response body for the message
+type MessageResponse struct {
+	Message string `json:"message"`
+}
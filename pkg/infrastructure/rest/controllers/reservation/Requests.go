@@ -0,0 +1,19 @@
+// Package reservation contains the reservation controller
+package reservation
+
+import "time"
+
+// NewReservationRequest is a struct that contains the new reservation request information
+type NewReservationRequest struct {
+	Name            string    `json:"name" example:"Mr. Smith" binding:"required"`
+	Contact         string    `json:"contact" example:"+91 98765 43210"`
+	PartySize       int       `json:"party_size" example:"4" binding:"required,min=1"`
+	TableNumber     int       `json:"table_no" example:"101"`
+	ReservedAt      time.Time `json:"reserved_at" example:"2021-02-24T20:00:00Z" binding:"required"`
+	DurationMinutes int       `json:"duration_minutes" example:"90" binding:"omitempty,min=1"`
+}
+
+// UpdateStatusRequest is a struct that contains the reservation status update request information
+type UpdateStatusRequest struct {
+	Status string `json:"status" example:"seated" binding:"required"`
+}
@@ -0,0 +1,191 @@
+// Package reservation contains the reservation controller
+package reservation
+
+import (
+	"errors"
+	"time"
+
+	useCaseReservation "github.com/Raj63/golang-rest-api/pkg/app/usecases/reservation"
+	domainErrors "github.com/Raj63/golang-rest-api/pkg/domain/errors"
+	domainReservation "github.com/Raj63/golang-rest-api/pkg/domain/reservation"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers"
+
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+const dateLayout = "2006-01-02"
+
+// Controller is a struct that contains the reservation service
+type Controller struct {
+	ReservationService useCaseReservation.Service
+}
+
+// NewReservation godoc
+//
+//	@Tags			reservations
+//	@Summary		Create New Reservation
+//	@Description	Create new reservation on the system, auto-assigning a table by capacity when table_no is not given
+//	@Accept			json
+//	@Produce		json
+//	@Param			data	body		NewReservationRequest	true	"body data"
+//	@Success		201		{object}	domainReservation.Reservation
+//	@Failure		400		{object}	controllers.ProblemDetails
+//	@Failure		409		{object}	controllers.ProblemDetails
+//	@Failure		500		{object}	controllers.ProblemDetails
+//	@Router			/reservations/ [post]
+func (c *Controller) NewReservation(ctx *gin.Context) {
+	var request NewReservationRequest
+
+	if err := controllers.BindJSON(ctx, &request); err != nil {
+		appError := domainErrors.NewAppError(err, domainErrors.ValidationError)
+		_ = ctx.Error(appError)
+		return
+	}
+	newReservation := useCaseReservation.NewReservation{
+		Name:            request.Name,
+		Contact:         request.Contact,
+		PartySize:       request.PartySize,
+		TableNumber:     request.TableNumber,
+		ReservedAt:      request.ReservedAt,
+		DurationMinutes: request.DurationMinutes,
+	}
+
+	var result *domainReservation.Reservation
+	var err error
+
+	result, err = c.ReservationService.Create(ctx.Request.Context(), &newReservation)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, result)
+}
+
+// GetAllReservations godoc
+//
+//	@Tags			reservations
+//	@Summary		Get all Reservations of a date
+//	@Description	Get all Reservations of a date on the system
+//	@Param			date	query		string	false	"date (YYYY-MM-DD), defaults to today"
+//	@Success		200		{object}	[]domainReservation.Reservation
+//	@Failure		400		{object}	controllers.ProblemDetails
+//	@Failure		500		{object}	controllers.ProblemDetails
+//	@Router			/reservations/ [get]
+func (c *Controller) GetAllReservations(ctx *gin.Context) {
+	date, err := time.ParseInLocation(dateLayout, ctx.DefaultQuery("date", time.Now().Format(dateLayout)), time.Local)
+	if err != nil {
+		appError := domainErrors.NewAppError(errors.New("param date is necessary to be formatted as YYYY-MM-DD"), domainErrors.ValidationError)
+		_ = ctx.Error(appError)
+		return
+	}
+
+	reservations, err := c.ReservationService.GetAll(ctx.Request.Context(), date)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+	ctx.JSON(http.StatusOK, reservations)
+}
+
+// GetAvailability godoc
+//
+//	@Tags			reservations
+//	@Summary		Search available reservation slots
+//	@Description	Get the time slots of a date with free tables for a party size
+//	@Param			date		query		string	false	"date (YYYY-MM-DD), defaults to today"
+//	@Param			party_size	query		int		true	"party size"
+//	@Success		200			{object}	[]domainReservation.Slot
+//	@Failure		400			{object}	controllers.ProblemDetails
+//	@Failure		500			{object}	controllers.ProblemDetails
+//	@Router			/reservations/availability [get]
+func (c *Controller) GetAvailability(ctx *gin.Context) {
+	date, err := time.ParseInLocation(dateLayout, ctx.DefaultQuery("date", time.Now().Format(dateLayout)), time.Local)
+	if err != nil {
+		appError := domainErrors.NewAppError(errors.New("param date is necessary to be formatted as YYYY-MM-DD"), domainErrors.ValidationError)
+		_ = ctx.Error(appError)
+		return
+	}
+	partySize, err := strconv.Atoi(ctx.Query("party_size"))
+	if err != nil {
+		appError := domainErrors.NewAppError(errors.New("param party_size is necessary to be an integer"), domainErrors.ValidationError)
+		_ = ctx.Error(appError)
+		return
+	}
+
+	slots, err := c.ReservationService.Availability(ctx.Request.Context(), date, partySize)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+	ctx.JSON(http.StatusOK, slots)
+}
+
+// GetReservationByID godoc
+//
+//	@Tags			reservations
+//	@Summary		Get reservations by ID
+//	@Description	Get Reservations by ID on the system
+//	@Param			reservation_id	path		int64	true	"id of reservation"
+//	@Success		200				{object}	domainReservation.Reservation
+//	@Failure		400				{object}	controllers.ProblemDetails
+//	@Failure		404				{object}	controllers.ProblemDetails
+//	@Failure		500				{object}	controllers.ProblemDetails
+//	@Router			/reservations/{reservation_id} [get]
+func (c *Controller) GetReservationByID(ctx *gin.Context) {
+	reservationID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		appError := domainErrors.NewAppError(errors.New("reservation id is invalid"), domainErrors.ValidationError)
+		_ = ctx.Error(appError)
+		return
+	}
+
+	domainReservation, err := c.ReservationService.GetByID(ctx.Request.Context(), reservationID)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, domainReservation)
+}
+
+// UpdateReservationStatus godoc
+//
+//	@Tags			reservations
+//	@Summary		Update reservation status
+//	@Description	Move a booked reservation to seated, no-show or cancelled
+//	@Accept			json
+//	@Produce		json
+//	@Param			reservation_id	path		int64				true	"id of reservation"
+//	@Param			data			body		UpdateStatusRequest	true	"body data"
+//	@Success		200				{object}	domainReservation.Reservation
+//	@Failure		400				{object}	controllers.ProblemDetails
+//	@Failure		404				{object}	controllers.ProblemDetails
+//	@Failure		500				{object}	controllers.ProblemDetails
+//	@Router			/reservations/{reservation_id}/status [patch]
+func (c *Controller) UpdateReservationStatus(ctx *gin.Context) {
+	reservationID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		appError := domainErrors.NewAppError(errors.New("param reservation id is necessary in the url"), domainErrors.ValidationError)
+		_ = ctx.Error(appError)
+		return
+	}
+
+	var request UpdateStatusRequest
+	if err := controllers.BindJSON(ctx, &request); err != nil {
+		appError := domainErrors.NewAppError(err, domainErrors.ValidationError)
+		_ = ctx.Error(appError)
+		return
+	}
+
+	domainReservation, err := c.ReservationService.UpdateStatus(ctx.Request.Context(), reservationID, request.Status)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, domainReservation)
+}
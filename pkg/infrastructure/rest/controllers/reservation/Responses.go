@@ -0,0 +1,7 @@
+// Package reservation contains the reservation controller
+package reservation
+
+// MessageResponse is a struct that contains the response body for the message
+type MessageResponse struct {
+	Message string `json:"message"`
+}
@@ -0,0 +1,50 @@
+// Package v2 contains the controller of the v2 API, which answers every request with an envelope of its data,
+// meta, links and errors over the same use cases as v1
+package v2
+
+import (
+	"net/http"
+	"strconv"
+
+	useCaseDiner "github.com/Raj63/golang-rest-api/pkg/app/usecases/diner"
+	useCaseMenu "github.com/Raj63/golang-rest-api/pkg/app/usecases/menu"
+	useCaseOrder "github.com/Raj63/golang-rest-api/pkg/app/usecases/order"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/envelope"
+	"github.com/gin-gonic/gin"
+)
+
+// Controller is a struct that contains the menu, diner and order services
+type Controller struct {
+	MenuService  useCaseMenu.Service
+	DinerService useCaseDiner.Service
+	OrderService useCaseOrder.Service
+}
+
+// bindID is a function that reads the id path param, aborting with a validation error keyed by the param when it is
+// not an integer
+func bindID(ctx *gin.Context, resource string) (int64, bool) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		envelope.Abort(ctx, http.StatusBadRequest, envelope.Error{
+			Code:    envelope.CodeValidation,
+			Message: resource + " id is invalid",
+			Field:   "id",
+		})
+		return 0, false
+	}
+	return id, true
+}
+
+// listLinks is a function that returns the links of a page of a list, to itself and to the first, next and previous
+// keyset pages
+func listLinks(ctx *gin.Context, nextCursor, prevCursor string) *envelope.Links {
+	links := envelope.Links{Self: ctx.Request.URL.RequestURI(), First: controllers.PageURL(ctx, "")}
+	if nextCursor != "" {
+		links.Next = controllers.PageURL(ctx, nextCursor)
+	}
+	if prevCursor != "" {
+		links.Prev = controllers.PageURL(ctx, prevCursor)
+	}
+	return &links
+}
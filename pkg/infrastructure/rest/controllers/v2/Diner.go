@@ -0,0 +1,92 @@
+// Package v2 contains the controller of the v2 API, which answers every request with an envelope of its data,
+// meta, links and errors over the same use cases as v1
+package v2
+
+import (
+	"net/http"
+	"strconv"
+
+	useCaseDiner "github.com/Raj63/golang-rest-api/pkg/app/usecases/diner"
+	domainErrors "github.com/Raj63/golang-rest-api/pkg/domain/errors"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/envelope"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/transformers"
+	"github.com/gin-gonic/gin"
+)
+
+// NewDiner is the controller to create a diner
+func (c *Controller) NewDiner(ctx *gin.Context) {
+	var request NewDinerRequest
+
+	if err := controllers.BindJSON(ctx, &request); err != nil {
+		appError := domainErrors.NewAppError(err, domainErrors.ValidationError)
+		_ = ctx.Error(appError)
+		return
+	}
+
+	diner, err := c.DinerService.Create(ctx.Request.Context(), &useCaseDiner.NewDiner{
+		Name:        request.Name,
+		TableNumber: request.TableNumber,
+	})
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	envelope.Render(ctx, http.StatusCreated, transformers.FromDiner(diner))
+}
+
+// GetDiners is the controller to list the diners, optionally searched by a part of the name and by table number,
+// in keyset pages following the cursors or by page number when page is given
+func (c *Controller) GetDiners(ctx *gin.Context) {
+	params, err := controllers.BindPageParams(ctx)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	search := useCaseDiner.SearchDiner{Name: ctx.Query("name")}
+	if tableNumberStr := ctx.Query("table_no"); tableNumberStr != "" {
+		search.TableNumber, err = strconv.Atoi(tableNumberStr)
+		if err != nil {
+			envelope.Abort(ctx, http.StatusBadRequest, envelope.Error{
+				Code:    envelope.CodeValidation,
+				Message: "param table_no is necessary to be an integer",
+				Field:   "table_no",
+			})
+			return
+		}
+	}
+
+	page, err := c.DinerService.GetPage(ctx.Request.Context(), &useCaseDiner.PageQuery{
+		Cursor:    params.Cursor,
+		Page:      params.Page,
+		Limit:     params.Limit,
+		Sort:      params.Sort,
+		WithTotal: params.WithTotal,
+	}, &search)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	diners, meta := transformers.FromDinerPage(page, params.Page > 0 || params.WithTotal)
+	envelope.RenderList(ctx, diners, meta, listLinks(ctx, page.NextCursor, page.PrevCursor))
+}
+
+// GetDiner is the controller to get a diner by its id
+func (c *Controller) GetDiner(ctx *gin.Context) {
+	dinerID, ok := bindID(ctx, "diner")
+	if !ok {
+		return
+	}
+
+	diner, err := c.DinerService.GetByID(ctx.Request.Context(), dinerID)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	controllers.SetLastModified(ctx, diner.UpdatedAt)
+	envelope.Render(ctx, http.StatusOK, transformers.FromDiner(diner))
+}
@@ -0,0 +1,94 @@
+// Package v2 contains the controller of the v2 API, which answers every request with an envelope of its data,
+// meta, links and errors over the same use cases as v1
+package v2
+
+import (
+	"net/http"
+
+	useCaseMenu "github.com/Raj63/golang-rest-api/pkg/app/usecases/menu"
+	domainErrors "github.com/Raj63/golang-rest-api/pkg/domain/errors"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/envelope"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/transformers"
+	"github.com/gin-gonic/gin"
+)
+
+// NewMenu is the controller to create a menu
+func (c *Controller) NewMenu(ctx *gin.Context) {
+	var request NewMenuRequest
+
+	if err := controllers.BindJSON(ctx, &request); err != nil {
+		appError := domainErrors.NewAppError(err, domainErrors.ValidationError)
+		_ = ctx.Error(appError)
+		return
+	}
+
+	menu, err := c.MenuService.Create(ctx.Request.Context(), &useCaseMenu.NewMenu{
+		Name:        request.Name,
+		Description: request.Description,
+		Category:    request.Category,
+		Price:       request.Price,
+	})
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	envelope.Render(ctx, http.StatusCreated, transformers.FromMenu(menu))
+}
+
+// GetMenus is the controller to list the menus, in keyset pages following the cursors or by page number when page
+// is given
+func (c *Controller) GetMenus(ctx *gin.Context) {
+	params, err := controllers.BindPageParams(ctx)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	page, err := c.MenuService.GetPage(ctx.Request.Context(), &useCaseMenu.PageQuery{
+		Cursor:    params.Cursor,
+		Page:      params.Page,
+		Limit:     params.Limit,
+		Sort:      params.Sort,
+		WithTotal: params.WithTotal,
+	})
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	menus, meta := transformers.FromMenuPage(page, params.Page > 0 || params.WithTotal)
+	envelope.RenderList(ctx, menus, meta, listLinks(ctx, page.NextCursor, page.PrevCursor))
+}
+
+// GetMenu is the controller to get a menu by its id
+func (c *Controller) GetMenu(ctx *gin.Context) {
+	menuID, ok := bindID(ctx, "menu")
+	if !ok {
+		return
+	}
+
+	menu, err := c.MenuService.GetByID(ctx.Request.Context(), menuID)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	controllers.SetLastModified(ctx, menu.UpdatedAt)
+	envelope.Render(ctx, http.StatusOK, transformers.FromMenu(menu))
+}
+
+// DeleteMenu is the controller to delete a menu by its id, answered with no content
+func (c *Controller) DeleteMenu(ctx *gin.Context) {
+	menuID, ok := bindID(ctx, "menu")
+	if !ok {
+		return
+	}
+
+	if err := c.MenuService.Delete(ctx.Request.Context(), menuID); err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
@@ -0,0 +1,64 @@
+// Package v2 contains the controller of the v2 API, which answers every request with an envelope of its data,
+// meta, links and errors over the same use cases as v1
+package v2
+
+import (
+	"net/http"
+	"strconv"
+
+	useCaseOrder "github.com/Raj63/golang-rest-api/pkg/app/usecases/order"
+	domainErrors "github.com/Raj63/golang-rest-api/pkg/domain/errors"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/envelope"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/transformers"
+	"github.com/gin-gonic/gin"
+)
+
+// NewOrder is the controller to place an order
+func (c *Controller) NewOrder(ctx *gin.Context) {
+	var request NewOrderRequest
+
+	if err := controllers.BindJSON(ctx, &request); err != nil {
+		appError := domainErrors.NewAppError(err, domainErrors.ValidationError)
+		_ = ctx.Error(appError)
+		return
+	}
+
+	order, err := c.OrderService.Create(ctx.Request.Context(), &useCaseOrder.NewOrder{
+		DinnerID: request.DinerID,
+		MenuID:   request.MenuID,
+		Quantity: request.Quantity,
+	})
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	envelope.Render(ctx, http.StatusCreated, transformers.FromOrderRequest(order))
+}
+
+// GetDinerOrders is the controller to get the orders of the current session of a diner, or of every session when
+// history is requested
+func (c *Controller) GetDinerOrders(ctx *gin.Context) {
+	dinerID, ok := bindID(ctx, "diner")
+	if !ok {
+		return
+	}
+	history, err := strconv.ParseBool(ctx.DefaultQuery("history", "false"))
+	if err != nil {
+		envelope.Abort(ctx, http.StatusBadRequest, envelope.Error{
+			Code:    envelope.CodeValidation,
+			Message: "param history is necessary to be a boolean",
+			Field:   "history",
+		})
+		return
+	}
+
+	orders, err := c.OrderService.GetByDinerID(ctx.Request.Context(), dinerID, history)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	envelope.Render(ctx, http.StatusOK, transformers.FromOrders(orders))
+}
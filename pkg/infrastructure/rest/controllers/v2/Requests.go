@@ -0,0 +1,24 @@
+// Package v2 contains the controller of the v2 API, which answers every request with an envelope of its data,
+// meta, links and errors over the same use cases as v1
+package v2
+
+// NewMenuRequest is a struct that contains the new menu request information
+type NewMenuRequest struct {
+	Name        string  `json:"name" example:"Hyderabadi Dum Briyani" binding:"required,max=120"`
+	Description string  `json:"description" example:"Something" binding:"required,max=255"`
+	Category    string  `json:"category" example:"briyani" binding:"max=60"`
+	Price       float64 `json:"price" example:"200.50" binding:"required,price"`
+}
+
+// NewDinerRequest is a struct that contains the new diner request information
+type NewDinerRequest struct {
+	Name        string `json:"name" example:"Mr. Smith" binding:"required"`
+	TableNumber int    `json:"table_no" example:"101" binding:"required"`
+}
+
+// NewOrderRequest is a struct that contains the new order request information
+type NewOrderRequest struct {
+	DinerID  int64 `json:"diner_id" example:"1" binding:"required,min=1"`
+	MenuID   int64 `json:"menu_id" example:"3" binding:"required,min=1"`
+	Quantity int   `json:"quantity" example:"2" binding:"required,min=1,max=50"`
+}
@@ -0,0 +1,14 @@
+// Package waitlist contains the waitlist controller
+package waitlist
+
+// NewEntryRequest is a struct that contains the new waitlist entry request information
+type NewEntryRequest struct {
+	Name      string `json:"name" example:"Mr. Smith" binding:"required"`
+	Contact   string `json:"contact" example:"+91 98765 43210" binding:"required"`
+	PartySize int    `json:"party_size" example:"4" binding:"required,min=1"`
+}
+
+// UpdateStatusRequest is a struct that contains the waitlist entry status update request information
+type UpdateStatusRequest struct {
+	Status string `json:"status" example:"seated" binding:"required"`
+}
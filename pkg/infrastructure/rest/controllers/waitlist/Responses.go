@@ -0,0 +1,7 @@
+// Package waitlist contains the waitlist controller
+package waitlist
+
+// MessageResponse is a struct that contains the response body for the message
+type MessageResponse struct {
+	Message string `json:"message"`
+}
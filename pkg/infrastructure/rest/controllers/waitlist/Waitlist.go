@@ -0,0 +1,174 @@
+// Package waitlist contains the waitlist controller
+package waitlist
+
+import (
+	"errors"
+
+	useCaseWaitlist "github.com/Raj63/golang-rest-api/pkg/app/usecases/waitlist"
+	domainErrors "github.com/Raj63/golang-rest-api/pkg/domain/errors"
+	domainWaitlist "github.com/Raj63/golang-rest-api/pkg/domain/waitlist"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers"
+
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Controller is a struct that contains the waitlist service
+type Controller struct {
+	WaitlistService useCaseWaitlist.Service
+}
+
+// NewEntry godoc
+//
+//	@Tags			waitlist
+//	@Summary		Join the waitlist
+//	@Description	Add a walk-in party to the end of the waitlist
+//	@Accept			json
+//	@Produce		json
+//	@Param			data	body		NewEntryRequest	true	"body data"
+//	@Success		201		{object}	domainWaitlist.Entry
+//	@Failure		400		{object}	controllers.ProblemDetails
+//	@Failure		500		{object}	controllers.ProblemDetails
+//	@Router			/waitlist/ [post]
+func (c *Controller) NewEntry(ctx *gin.Context) {
+	var request NewEntryRequest
+
+	if err := controllers.BindJSON(ctx, &request); err != nil {
+		appError := domainErrors.NewAppError(err, domainErrors.ValidationError)
+		_ = ctx.Error(appError)
+		return
+	}
+	newEntry := useCaseWaitlist.NewEntry{
+		Name:      request.Name,
+		Contact:   request.Contact,
+		PartySize: request.PartySize,
+	}
+
+	var result *domainWaitlist.Entry
+	var err error
+
+	result, err = c.WaitlistService.Create(ctx.Request.Context(), &newEntry)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, result)
+}
+
+// GetWaitlist godoc
+//
+//	@Tags			waitlist
+//	@Summary		Get the waitlist
+//	@Description	Get the active waitlist with queue positions and estimated wait times
+//	@Success		200	{object}	[]domainWaitlist.Entry
+//	@Failure		500	{object}	controllers.ProblemDetails
+//	@Router			/waitlist/ [get]
+func (c *Controller) GetWaitlist(ctx *gin.Context) {
+	entries, err := c.WaitlistService.GetAll(ctx.Request.Context())
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+	ctx.JSON(http.StatusOK, entries)
+}
+
+// GetEntryByID godoc
+//
+//	@Tags			waitlist
+//	@Summary		Get waitlist entry by ID
+//	@Description	Get a waitlist entry with its queue position and estimated wait time
+//	@Param			entry_id	path		int64	true	"id of waitlist entry"
+//	@Success		200			{object}	domainWaitlist.Entry
+//	@Failure		400			{object}	controllers.ProblemDetails
+//	@Failure		404			{object}	controllers.ProblemDetails
+//	@Failure		500			{object}	controllers.ProblemDetails
+//	@Router			/waitlist/{entry_id} [get]
+func (c *Controller) GetEntryByID(ctx *gin.Context) {
+	entryID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		appError := domainErrors.NewAppError(errors.New("waitlist entry id is invalid"), domainErrors.ValidationError)
+		_ = ctx.Error(appError)
+		return
+	}
+
+	entry, err := c.WaitlistService.GetByID(ctx.Request.Context(), entryID)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, entry)
+}
+
+// UpdateEntryStatus godoc
+//
+//	@Tags			waitlist
+//	@Summary		Update waitlist entry status
+//	@Description	Mark a waitlist entry as seated or left
+//	@Accept			json
+//	@Produce		json
+//	@Param			entry_id	path		int64				true	"id of waitlist entry"
+//	@Param			data		body		UpdateStatusRequest	true	"body data"
+//	@Success		200			{object}	domainWaitlist.Entry
+//	@Failure		400			{object}	controllers.ProblemDetails
+//	@Failure		404			{object}	controllers.ProblemDetails
+//	@Failure		500			{object}	controllers.ProblemDetails
+//	@Router			/waitlist/{entry_id}/status [patch]
+func (c *Controller) UpdateEntryStatus(ctx *gin.Context) {
+	entryID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		appError := domainErrors.NewAppError(errors.New("param waitlist entry id is necessary in the url"), domainErrors.ValidationError)
+		_ = ctx.Error(appError)
+		return
+	}
+
+	var request UpdateStatusRequest
+	if err := controllers.BindJSON(ctx, &request); err != nil {
+		appError := domainErrors.NewAppError(err, domainErrors.ValidationError)
+		_ = ctx.Error(appError)
+		return
+	}
+
+	entry, err := c.WaitlistService.UpdateStatus(ctx.Request.Context(), entryID, request.Status)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, entry)
+}
+
+// TableFreed godoc
+//
+//	@Tags			waitlist
+//	@Summary		Notify the next party of a free table
+//	@Description	Notify the first waiting party that fits the freed table
+//	@Param			table_no	path		int	true	"number of the freed table"
+//	@Success		200			{object}	domainWaitlist.Entry
+//	@Failure		400			{object}	controllers.ProblemDetails
+//	@Failure		404			{object}	controllers.ProblemDetails
+//	@Failure		500			{object}	controllers.ProblemDetails
+//	@Router			/waitlist/tables/{table_no}/free [post]
+func (c *Controller) TableFreed(ctx *gin.Context) {
+	tableNumber, err := strconv.Atoi(ctx.Param("table_no"))
+	if err != nil {
+		appError := domainErrors.NewAppError(errors.New("param table number is necessary in the url"), domainErrors.ValidationError)
+		_ = ctx.Error(appError)
+		return
+	}
+
+	entry, err := c.WaitlistService.TableFreed(ctx.Request.Context(), tableNumber)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+	if entry == nil {
+		ctx.JSON(http.StatusOK, gin.H{"message": "no waiting party fits the table"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, entry)
+}
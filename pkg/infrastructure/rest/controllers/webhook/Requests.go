@@ -0,0 +1,10 @@
+// Package webhook contains the webhook controller
+package webhook
+
+// NewSubscriptionRequest is a struct that contains the new webhook subscription request information
+type NewSubscriptionRequest struct {
+	URL    string   `json:"url" example:"https://partner.example.com/webhooks" binding:"required,max=2048"`
+	Events []string `json:"events" example:"order.created,order.served" binding:"required"`
+	// Secret signs the deliveries, a random one is made when it is empty
+	Secret string `json:"secret" example:"5f2b8c0e3a9d4e7f9a1c" binding:"max=255"`
+}
@@ -0,0 +1,216 @@
+// Package webhook contains the webhook controller
+package webhook
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	useCaseWebhook "github.com/Raj63/golang-rest-api/pkg/app/usecases/webhook"
+	domainErrors "github.com/Raj63/golang-rest-api/pkg/domain/errors"
+	domainWebhook "github.com/Raj63/golang-rest-api/pkg/domain/webhook"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers"
+	"github.com/gin-gonic/gin"
+)
+
+// Controller is a struct that contains the webhook service
+type Controller struct {
+	WebhookService useCaseWebhook.Service
+}
+
+// NewSubscription godoc
+//
+//	@Tags			webhooks
+//	@Summary		Subscribe to events
+//	@Description	Subscribe a partner endpoint to some of the order and menu events. The deliveries are signed with the secret, which is only shown in this response: the X-Webhook-Signature header is sha256= and the hex HMAC-SHA256 of the X-Webhook-Timestamp header, a dot and the body. The endpoint must resolve to public addresses only.
+//	@Accept			json
+//	@Produce		json
+//	@Param			data	body		NewSubscriptionRequest	true	"body data"
+//	@Success		201		{object}	domainWebhook.Subscription
+//	@Failure		400		{object}	controllers.ProblemDetails
+//	@Failure		401		{object}	controllers.ProblemDetails
+//	@Failure		500		{object}	controllers.ProblemDetails
+//	@Router			/webhooks/ [post]
+func (c *Controller) NewSubscription(ctx *gin.Context) {
+	var request NewSubscriptionRequest
+
+	if err := controllers.BindJSON(ctx, &request); err != nil {
+		appError := domainErrors.NewAppError(err, domainErrors.ValidationError)
+		_ = ctx.Error(appError)
+		return
+	}
+	newSubscription := useCaseWebhook.NewSubscription{
+		URL:    request.URL,
+		Events: request.Events,
+		Secret: request.Secret,
+	}
+
+	var subscription *domainWebhook.Subscription
+	var err error
+
+	subscription, err = c.WebhookService.Subscribe(ctx.Request.Context(), &newSubscription)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+	controllers.Render(ctx, http.StatusCreated, subscription)
+}
+
+// GetAllSubscriptions godoc
+//
+//	@Tags			webhooks
+//	@Summary		Get all subscriptions
+//	@Description	Get all webhook subscriptions, without their secret
+//	@Success		200	{object}	[]domainWebhook.Subscription
+//	@Failure		401	{object}	controllers.ProblemDetails
+//	@Failure		500	{object}	controllers.ProblemDetails
+//	@Router			/webhooks/ [get]
+func (c *Controller) GetAllSubscriptions(ctx *gin.Context) {
+	subscriptions, err := c.WebhookService.GetAll(ctx.Request.Context())
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+	controllers.Render(ctx, http.StatusOK, subscriptions)
+}
+
+// GetSubscriptionByID godoc
+//
+//	@Tags			webhooks
+//	@Summary		Get subscription by ID
+//	@Description	Get a webhook subscription, without its secret
+//	@Param			subscription_id	path		int64	true	"id of subscription"
+//	@Success		200				{object}	domainWebhook.Subscription
+//	@Failure		400				{object}	controllers.ProblemDetails
+//	@Failure		401				{object}	controllers.ProblemDetails
+//	@Failure		404				{object}	controllers.ProblemDetails
+//	@Failure		500				{object}	controllers.ProblemDetails
+//	@Router			/webhooks/{subscription_id} [get]
+func (c *Controller) GetSubscriptionByID(ctx *gin.Context) {
+	subscriptionID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		appError := domainErrors.NewAppError(errors.New("subscription id is invalid"), domainErrors.ValidationError)
+		_ = ctx.Error(appError)
+		return
+	}
+
+	subscription, err := c.WebhookService.GetByID(ctx.Request.Context(), subscriptionID)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+	controllers.Render(ctx, http.StatusOK, subscription)
+}
+
+// DeleteSubscription godoc
+//
+//	@Tags			webhooks
+//	@Summary		Unsubscribe
+//	@Description	Delete a webhook subscription with its deliveries
+//	@Param			subscription_id	path	int64	true	"id of subscription"
+//	@Success		204
+//	@Failure		400	{object}	controllers.ProblemDetails
+//	@Failure		401	{object}	controllers.ProblemDetails
+//	@Failure		404	{object}	controllers.ProblemDetails
+//	@Failure		500	{object}	controllers.ProblemDetails
+//	@Router			/webhooks/{subscription_id} [delete]
+func (c *Controller) DeleteSubscription(ctx *gin.Context) {
+	subscriptionID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		appError := domainErrors.NewAppError(errors.New("subscription id is invalid"), domainErrors.ValidationError)
+		_ = ctx.Error(appError)
+		return
+	}
+
+	if err := c.WebhookService.Delete(ctx.Request.Context(), subscriptionID); err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+	ctx.Status(http.StatusNoContent)
+}
+
+// GetDeliveries godoc
+//
+//	@Tags			webhooks
+//	@Summary		Get the deliveries of a subscription
+//	@Description	Get the log of the deliveries of a subscription, the latest first, with the outcome of their last attempt
+//	@Param			subscription_id	path		int64	true	"id of subscription"
+//	@Param			status			query		string	false	"status of the deliveries"	Enums(pending, succeeded, dead)
+//	@Success		200				{object}	[]domainWebhook.Delivery
+//	@Failure		400				{object}	controllers.ProblemDetails
+//	@Failure		401				{object}	controllers.ProblemDetails
+//	@Failure		404				{object}	controllers.ProblemDetails
+//	@Failure		500				{object}	controllers.ProblemDetails
+//	@Router			/webhooks/{subscription_id}/deliveries [get]
+func (c *Controller) GetDeliveries(ctx *gin.Context) {
+	subscriptionID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		appError := domainErrors.NewAppError(errors.New("subscription id is invalid"), domainErrors.ValidationError)
+		_ = ctx.Error(appError)
+		return
+	}
+
+	deliveries, err := c.WebhookService.GetDeliveries(ctx.Request.Context(), subscriptionID, ctx.Query("status"))
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+	controllers.Render(ctx, http.StatusOK, deliveries)
+}
+
+// GetDelivery godoc
+//
+//	@Tags			webhooks
+//	@Summary		Get delivery by ID
+//	@Description	Get a delivery with the log of all its attempts
+//	@Param			delivery_id	path		int64	true	"id of delivery"
+//	@Success		200			{object}	domainWebhook.Delivery
+//	@Failure		400			{object}	controllers.ProblemDetails
+//	@Failure		401			{object}	controllers.ProblemDetails
+//	@Failure		404			{object}	controllers.ProblemDetails
+//	@Failure		500			{object}	controllers.ProblemDetails
+//	@Router			/webhooks/deliveries/{delivery_id} [get]
+func (c *Controller) GetDelivery(ctx *gin.Context) {
+	deliveryID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		appError := domainErrors.NewAppError(errors.New("delivery id is invalid"), domainErrors.ValidationError)
+		_ = ctx.Error(appError)
+		return
+	}
+
+	delivery, err := c.WebhookService.GetDelivery(ctx.Request.Context(), deliveryID)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+	controllers.Render(ctx, http.StatusOK, delivery)
+}
+
+// ReplayDelivery godoc
+//
+//	@Tags			webhooks
+//	@Summary		Replay a delivery
+//	@Description	Send a delivery again right away, whatever its status, a dead one included, and answer with the outcome in the log of its attempts. A delivery being attempted meanwhile is not replayed.
+//	@Param			delivery_id	path		int64	true	"id of delivery"
+//	@Success		200			{object}	domainWebhook.Delivery
+//	@Failure		400			{object}	controllers.ProblemDetails
+//	@Failure		401			{object}	controllers.ProblemDetails
+//	@Failure		404			{object}	controllers.ProblemDetails
+//	@Failure		409			{object}	controllers.ProblemDetails
+//	@Failure		500			{object}	controllers.ProblemDetails
+//	@Router			/webhooks/deliveries/{delivery_id}/replay [post]
+func (c *Controller) ReplayDelivery(ctx *gin.Context) {
+	deliveryID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		appError := domainErrors.NewAppError(errors.New("delivery id is invalid"), domainErrors.ValidationError)
+		_ = ctx.Error(appError)
+		return
+	}
+
+	delivery, err := c.WebhookService.Replay(ctx.Request.Context(), deliveryID)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+	controllers.Render(ctx, http.StatusOK, delivery)
+}
@@ -0,0 +1,97 @@
+// Package envelope contains the response envelope of the v2 API, in which every response, the errors included,
+// carries its data, meta, links and errors members
+package envelope
+
+import (
+	"net/http"
+
+	errorsController "github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers/errors"
+	"github.com/gin-gonic/gin"
+)
+
+// The codes of the errors of an envelope, the same as those of the problem details of v1
+const (
+	CodeValidation    = errorsController.CodeValidation
+	CodeNotFound      = errorsController.CodeNotFound
+	CodeAlreadyExists = errorsController.CodeAlreadyExists
+	CodeRateLimited   = errorsController.CodeRateLimited
+	CodeInternal      = errorsController.CodeInternal
+)
+
+// Envelope is a struct that contains a v2 response, the data of a successful response or the errors of a failed one
+type Envelope struct {
+	Data   interface{} `json:"data,omitempty"`
+	Meta   *Meta       `json:"meta,omitempty"`
+	Links  *Links      `json:"links,omitempty"`
+	Errors []Error     `json:"errors,omitempty"`
+}
+
+// Meta is a struct that contains the pagination metadata of a list
+type Meta struct {
+	// Total is only set when the list was counted, on page numbers or when with_total is asked for
+	Total      *int64 `json:"total,omitempty" example:"42"`
+	Limit      int64  `json:"limit" example:"20"`
+	Page       int64  `json:"page,omitempty" example:"2"`
+	Pages      int64  `json:"pages,omitempty" example:"3"`
+	NextCursor string `json:"next_cursor,omitempty"`
+	PrevCursor string `json:"prev_cursor,omitempty"`
+}
+
+// Links is a struct that contains the links of a response, to itself and to the first, next and previous pages of
+// a list
+type Links struct {
+	Self  string `json:"self"`
+	First string `json:"first,omitempty"`
+	Next  string `json:"next,omitempty"`
+	Prev  string `json:"prev,omitempty"`
+}
+
+// Error is a struct that contains an error of a response
+type Error struct {
+	Code    string `json:"code" example:"validation_error"`
+	Message string `json:"message" example:"param limit is necessary to be positive"`
+	Field   string `json:"field,omitempty" example:"limit"`
+}
+
+// Render is a function that writes the data of a successful response, with the link to itself
+func Render(c *gin.Context, status int, data interface{}) {
+	c.JSON(status, Envelope{Data: data, Links: &Links{Self: c.Request.URL.RequestURI()}})
+}
+
+// RenderList is a function that writes a page of a list with its pagination metadata and links
+func RenderList(c *gin.Context, data interface{}, meta *Meta, links *Links) {
+	c.JSON(http.StatusOK, Envelope{Data: data, Meta: meta, Links: links})
+}
+
+// Abort is a function that writes the errors of a failed response and stops the handlers after the current one
+func Abort(c *gin.Context, status int, errs ...Error) {
+	c.AbortWithStatusJSON(status, Envelope{Errors: errs})
+}
+
+// ErrorHandler is Gin middleware to handle the errors of the v2 routes. The errors are written in an envelope and
+// then cleared, so that the v1 error handler of the engine leaves the response as it is.
+func ErrorHandler(c *gin.Context) {
+	c.Next()
+	if len(c.Errors) == 0 || c.Writer.Written() {
+		return
+	}
+
+	status, envelopeErrors := FromError(c.Errors[0].Err)
+	c.Errors = c.Errors[:0]
+	Abort(c, status, envelopeErrors...)
+}
+
+// FromError is a function that maps an error to the status and the errors of an envelope, one per invalid field of
+// a validation error, in the same way as the problem details of v1
+func FromError(err error) (int, []Error) {
+	status, code, detail, fields := errorsController.Classify(err)
+	if len(fields) == 0 {
+		return status, []Error{{Code: code, Message: detail}}
+	}
+
+	envelopeErrors := make([]Error, len(fields))
+	for i, field := range fields {
+		envelopeErrors[i] = Error{Code: code, Message: field.Message, Field: field.Field}
+	}
+	return status, envelopeErrors
+}
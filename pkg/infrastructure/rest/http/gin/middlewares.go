@@ -4,11 +4,15 @@ import (
 	"fmt"
 	"html/template"
 	"net/http"
+	"strings"
 	"time"
 
 	healthcheck "github.com/RaMin0/gin-health-check"
 	"github.com/Raj63/golang-rest-api/pkg/infrastructure/logger"
+	errorsController "github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers/errors"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/envelope"
 	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/http/gin/ratelimiter"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/openapi"
 
 	"github.com/chenjiandongx/ginprom"
 	inspector "github.com/fatihkahveci/gin-inspector"
@@ -82,6 +86,8 @@ func AddBasicHandlers(router *gin.Engine, config *MiddlewaresConfig, logger *log
 	// Documentation Swagger
 	{
 		router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
+		// the same document in OpenAPI 3
+		router.GET("/openapi.json", openapi.Handler)
 	}
 
 	// Setup the Static file middleware handler before the inspector
@@ -156,8 +162,15 @@ func AddBasicHandlers(router *gin.Engine, config *MiddlewaresConfig, logger *log
 			},
 			// config: how to respond when limiting
 			LimitedHandler: func(c *gin.Context) {
-				c.JSON(200, "Exceeds Request rate limit!!!")
-				c.Abort()
+				// v2 answers in its envelope, the other routes with problem details
+				if strings.HasPrefix(c.Request.URL.Path, "/v2/") {
+					envelope.Abort(c, http.StatusTooManyRequests, envelope.Error{
+						Code:    envelope.CodeRateLimited,
+						Message: "exceeds request rate limit",
+					})
+					return
+				}
+				errorsController.AbortWithProblem(c, http.StatusTooManyRequests, "exceeds request rate limit")
 			},
 			// config: return ratelimiter token fill interval and bucket size
 			TokenBucketConfig: func(*gin.Context) (time.Duration, int) {
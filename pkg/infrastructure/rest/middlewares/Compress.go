@@ -0,0 +1,165 @@
+// Package middlewares contains the middlewares for the rest api
+package middlewares
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/andybalholm/brotli"
+	"github.com/gin-gonic/gin"
+)
+
+// CompressMinSize is the size in bytes from which the responses are compressed, smaller ones gaining too little
+// to be worth the time
+const CompressMinSize = 1024
+
+// Content codings of Compress
+const (
+	encodingBrotli = "br"
+	encodingGzip   = "gzip"
+)
+
+type compressWriter struct {
+	gin.ResponseWriter
+	encoding   string
+	minSize    int
+	buf        bytes.Buffer
+	compressor io.WriteCloser
+	// decided is set once the response is known to be compressed or not, then buf is no longer used
+	decided bool
+}
+
+func (w *compressWriter) Write(b []byte) (int, error) {
+	if w.decided {
+		if w.compressor != nil {
+			return w.compressor.Write(b)
+		}
+		return w.ResponseWriter.Write(b)
+	}
+
+	w.buf.Write(b)
+	if w.buf.Len() >= w.minSize {
+		if err := w.decide(true); err != nil {
+			return 0, err
+		}
+	}
+	return len(b), nil
+}
+
+func (w *compressWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+// Written tells whether a body was written, even while it is still buffered
+func (w *compressWriter) Written() bool {
+	return w.buf.Len() > 0 || w.ResponseWriter.Written()
+}
+
+// Flush sends what is written so far, compressed when it is a streamed response since its size is unknown
+func (w *compressWriter) Flush() {
+	if !w.decided && w.buf.Len() > 0 {
+		_ = w.decide(true)
+	}
+	if flusher, ok := w.compressor.(interface{ Flush() error }); ok {
+		_ = flusher.Flush()
+	}
+	w.ResponseWriter.Flush()
+}
+
+// decide writes the buffered start of the response, compressed when asked and the response can be compressed
+func (w *compressWriter) decide(compress bool) error {
+	w.decided = true
+	header := w.ResponseWriter.Header()
+	status := w.ResponseWriter.Status()
+	if compress && header.Get("Content-Encoding") == "" && status != http.StatusNoContent && status != http.StatusNotModified {
+		header.Set("Content-Encoding", w.encoding)
+		header.Del("Content-Length")
+		// the compressed body is another representation, the tag of the identity body only stays weakly valid
+		if etag := header.Get("ETag"); strings.HasPrefix(etag, `"`) {
+			header.Set("ETag", "W/"+etag)
+		}
+		if w.encoding == encodingBrotli {
+			w.compressor = brotli.NewWriter(w.ResponseWriter)
+		} else {
+			w.compressor, _ = gzip.NewWriterLevel(w.ResponseWriter, gzip.DefaultCompression)
+		}
+		_, err := w.compressor.Write(w.buf.Bytes())
+		return err
+	}
+
+	if w.buf.Len() == 0 {
+		return nil
+	}
+	_, err := w.ResponseWriter.Write(w.buf.Bytes())
+	return err
+}
+
+// close ends the response, which is left uncompressed when it stayed smaller than minSize
+func (w *compressWriter) close() error {
+	if !w.decided {
+		return w.decide(false)
+	}
+	if w.compressor != nil {
+		return w.compressor.Close()
+	}
+	return nil
+}
+
+// Compress is a middleware that compresses the responses of at least minSize bytes with brotli or gzip, whichever
+// the Accept-Encoding of the request prefers, brotli on a tie
+func Compress(minSize int) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Writer.Header().Add("Vary", "Accept-Encoding")
+		encoding := acceptedEncoding(c.Request.Header.Get("Accept-Encoding"))
+		if encoding == "" || c.Request.Method == http.MethodHead {
+			c.Next()
+			return
+		}
+
+		writer := &compressWriter{ResponseWriter: c.Writer, encoding: encoding, minSize: minSize}
+		c.Writer = writer
+		c.Next()
+		c.Writer = writer.ResponseWriter
+		if err := writer.close(); err != nil {
+			_ = c.Error(err)
+		}
+	}
+}
+
+// acceptedEncoding returns the coding of Compress with the highest quality in the Accept-Encoding, empty when none
+// is acceptable
+func acceptedEncoding(acceptEncoding string) string {
+	qualities := map[string]float64{}
+	for _, part := range strings.Split(acceptEncoding, ",") {
+		coding, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		quality := 1.0
+		if params = strings.TrimSpace(params); strings.HasPrefix(params, "q=") {
+			if parsed, err := strconv.ParseFloat(params[len("q="):], 64); err == nil {
+				quality = parsed
+			}
+		}
+		coding = strings.ToLower(strings.TrimSpace(coding))
+		switch coding {
+		case encodingBrotli, encodingGzip:
+			qualities[coding] = quality
+		case "*":
+			for _, c := range []string{encodingBrotli, encodingGzip} {
+				if _, ok := qualities[c]; !ok {
+					qualities[c] = quality
+				}
+			}
+		}
+	}
+
+	encoding, best := "", 0.0
+	for _, coding := range []string{encodingBrotli, encodingGzip} {
+		if quality := qualities[coding]; quality > best {
+			encoding, best = coding, quality
+		}
+	}
+	return encoding
+}
@@ -0,0 +1,86 @@
+// Package middlewares contains the middlewares for the rest api
+package middlewares
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/logger"
+	errorsController "github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers/errors"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/openapi"
+	"github.com/gin-gonic/gin"
+)
+
+// Modes of ContractValidation
+const (
+	// ContractLog logs the requests and responses breaking the OpenAPI contract and lets them through
+	ContractLog = "log"
+	// ContractReject answers the requests breaking the contract with a 400 problem, and replaces the responses
+	// breaking it by a 500 problem
+	ContractReject = "reject"
+)
+
+type contractWriter struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *contractWriter) Write(b []byte) (int, error) {
+	return w.body.Write(b)
+}
+
+func (w *contractWriter) WriteString(s string) (int, error) {
+	return w.body.WriteString(s)
+}
+
+// Written tells whether a body was written, even while it is still held for the check
+func (w *contractWriter) Written() bool {
+	return w.body.Len() > 0 || w.ResponseWriter.Written()
+}
+
+// Flush is held back with the body, the status would otherwise be sent before the response is checked
+func (w *contractWriter) Flush() {}
+
+// ContractValidation is a middleware that checks the requests to the documented routes, and their responses, against
+// the OpenAPI document, in the ContractLog or ContractReject mode. It is meant for development, as every response is
+// held in memory until it is checked; it is set up inside Compress, to see the bodies uncompressed, and outside the
+// errors handler, to see the problems.
+func ContractValidation(mode string, logger *logger.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		err := openapi.CheckRequest(c.Request)
+		if errors.Is(err, openapi.ErrNotDocumented) {
+			c.Next()
+			return
+		}
+		if err != nil {
+			logger.WarnfContext(c.Request.Context(), "request %s %s breaks the OpenAPI contract: %v", c.Request.Method, c.Request.URL.Path, err)
+			if mode == ContractReject {
+				errorsController.AbortWithProblem(c, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		writer := &contractWriter{ResponseWriter: c.Writer}
+		c.Writer = writer
+		c.Next()
+		c.Writer = writer.ResponseWriter
+
+		err = openapi.CheckResponse(c.Request, c.Writer.Status(), c.Writer.Header(), writer.body.Bytes())
+		if err != nil {
+			logger.WarnfContext(c.Request.Context(), "response %d to %s %s breaks the OpenAPI contract: %v", c.Writer.Status(), c.Request.Method, c.Request.URL.Path, err)
+			if mode == ContractReject {
+				for _, header := range []string{"Content-Type", "ETag", "Last-Modified", "Cache-Control", "Content-Language", "Link"} {
+					c.Writer.Header().Del(header)
+				}
+				errorsController.AbortWithProblem(c, http.StatusInternalServerError, err.Error())
+				return
+			}
+		}
+		if writer.body.Len() > 0 {
+			_, _ = c.Writer.Write(writer.body.Bytes())
+		} else {
+			c.Writer.WriteHeaderNow()
+		}
+	}
+}
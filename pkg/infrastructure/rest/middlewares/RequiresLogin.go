@@ -4,41 +4,51 @@ package middlewares
 import (
 	"fmt"
 	"net/http"
+	"strings"
 
+	errorsController "github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers/errors"
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v4"
-	"github.com/spf13/viper"
 )
 
-// AuthJWTMiddleware is a function that validates the jwt token
-func AuthJWTMiddleware() gin.HandlerFunc {
+// authSubjectKey is the key of the gin context the subject of the validated token is set under
+const authSubjectKey = "auth.subject"
+
+// AuthJWTMiddleware is a function that validates the jwt token of the Authorization header, with or without its
+// Bearer prefix. The token must be signed with secret by an HMAC method and name its subject, which AuthSubject
+// returns to the handlers. Every request is rejected while secret is empty.
+func AuthJWTMiddleware(secret string) gin.HandlerFunc {
+	signature := []byte(secret)
 	return func(c *gin.Context) {
-		viper.SetConfigFile("config.json")
-		if err := viper.ReadInConfig(); err != nil {
-			_ = fmt.Errorf("fatal error in config file: %s", err.Error())
+		if len(signature) == 0 {
+			errorsController.AbortWithProblem(c, http.StatusUnauthorized, "Authentication is not configured")
+			return
 		}
 
-		JWTAccessSecure := viper.GetString("Secure.JWTAccessSecure")
-		tokenString := c.GetHeader("Authorization")
-		signature := []byte(JWTAccessSecure)
-
+		tokenString := strings.TrimPrefix(c.GetHeader("Authorization"), "Bearer ")
 		if tokenString == "" {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "Token not provided"})
-			c.Abort()
+			errorsController.AbortWithProblem(c, http.StatusUnauthorized, "Token not provided")
 			return
 		}
 
-		claims := jwt.MapClaims{}
-		_, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		claims := jwt.RegisteredClaims{}
+		token, err := jwt.ParseWithClaims(tokenString, &claims, func(token *jwt.Token) (interface{}, error) {
+			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+				return nil, fmt.Errorf("unexpected signing method %v", token.Header["alg"])
+			}
 			return signature, nil
 		})
-
-		if err != nil {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid token"})
-			c.Abort()
+		if err != nil || !token.Valid || strings.TrimSpace(claims.Subject) == "" {
+			errorsController.AbortWithProblem(c, http.StatusUnauthorized, "Invalid token")
 			return
 		}
 
+		c.Set(authSubjectKey, claims.Subject)
 		c.Next()
 	}
 }
+
+// AuthSubject returns the subject of the token AuthJWTMiddleware validated, empty when the request went through none
+func AuthSubject(c *gin.Context) string {
+	return c.GetString(authSubjectKey)
+}
@@ -0,0 +1,195 @@
+// Package openapi contains the OpenAPI 3 document of the v1 API and the checks of the requests and responses against it
+package openapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/Raj63/golang-rest-api/docs"
+	errorsController "github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers/errors"
+	"github.com/getkin/kin-openapi/openapi2"
+	"github.com/getkin/kin-openapi/openapi2conv"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/gorillamux"
+	"github.com/gin-gonic/gin"
+)
+
+// problemMIME is the media type the errors are answered in, documented as application/json by the annotations
+const problemMIME = "application/problem+json"
+
+// problemSchema is the name of the schema of the problem details in the converted document
+const problemSchema = "controllers.ProblemDetails"
+
+// pathParam matches the parameters of the path templates
+var pathParam = regexp.MustCompile(`\{[^}]*\}`)
+
+// ErrNotDocumented is the error of a request to a route the document doesn't describe, which is not checked
+var ErrNotDocumented = errors.New("route is not documented")
+
+var (
+	loadOnce sync.Once
+	document *openapi3.T
+	router   routers.Router
+	loadErr  error
+)
+
+// Document is a function that returns the OpenAPI 3 document of the v1 API, converted from the swagger document
+// generated from the annotations of the controllers. The errors are documented in problem details.
+func Document() (*openapi3.T, error) {
+	loadOnce.Do(load)
+	return document, loadErr
+}
+
+func load() {
+	var swagger openapi2.T
+	if loadErr = json.Unmarshal([]byte(docs.SwaggerInfo.ReadDoc()), &swagger); loadErr != nil {
+		loadErr = fmt.Errorf("reading the swagger document: %w", loadErr)
+		return
+	}
+	document, loadErr = openapi2conv.ToV3(&swagger)
+	if loadErr != nil {
+		loadErr = fmt.Errorf("converting the swagger document: %w", loadErr)
+		return
+	}
+	// the routes are matched whatever host serves them
+	document.Servers = openapi3.Servers{{URL: swagger.BasePath}}
+	problem := document.Components.Schemas[problemSchema]
+	if problem == nil {
+		loadErr = fmt.Errorf("the swagger document has no %s schema", problemSchema)
+		return
+	}
+	problemRef := openapi3.NewSchemaRef("#/components/schemas/"+problemSchema, problem.Value)
+
+	templates := map[string]string{}
+	for path, pathItem := range document.Paths {
+		// the paths only differing by the names of their parameters are the same route, which can't be told apart
+		template := pathParam.ReplaceAllString(path, "{}")
+		if other, ok := templates[template]; ok {
+			loadErr = fmt.Errorf("paths %s and %s are the same route, their parameters have to be named alike", other, path)
+			return
+		}
+		templates[template] = path
+
+		for _, operation := range pathItem.Operations() {
+			for status, response := range operation.Responses {
+				if response.Value == nil || strings.HasPrefix(status, "2") {
+					continue
+				}
+				// any error can be answered in problem details, besides the other bodies documented for its status
+				mediaType := response.Value.Content.Get("application/json")
+				if mediaType != nil && mediaType.Schema != nil && mediaType.Schema.Ref == problemRef.Ref {
+					delete(response.Value.Content, "application/json")
+				}
+				if response.Value.Content == nil {
+					response.Value.Content = openapi3.Content{}
+				}
+				response.Value.Content[problemMIME] = openapi3.NewMediaType().WithSchemaRef(problemRef)
+			}
+		}
+	}
+
+	if loadErr = document.Validate(context.Background()); loadErr != nil {
+		loadErr = fmt.Errorf("validating the OpenAPI document: %w", loadErr)
+		return
+	}
+	router, loadErr = gorillamux.NewRouter(document)
+}
+
+// Handler is a function that serves the OpenAPI 3 document
+func Handler(c *gin.Context) {
+	doc, err := Document()
+	if err != nil {
+		errorsController.AbortWithProblem(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	c.JSON(http.StatusOK, doc)
+}
+
+// CheckRequest is a function that checks a request against the operation of the document it is routed to. The body
+// is only checked when it is JSON, and is left readable for the handlers.
+func CheckRequest(req *http.Request) error {
+	input, err := requestInput(req)
+	if err != nil {
+		return err
+	}
+	input.Options.ExcludeRequestBody = !isJSON(req.Header.Get("Content-Type"))
+	return openapi3filter.ValidateRequest(req.Context(), input)
+}
+
+// CheckResponse is a function that checks the status, headers and body of the response to a request against the
+// operation of the document the request is routed to. The body is only checked when it is uncompressed JSON, and every
+// status is expected to be documented.
+func CheckResponse(req *http.Request, status int, header http.Header, body []byte) error {
+	input, err := requestInput(req)
+	if err != nil {
+		return err
+	}
+	input.Options.IncludeResponseStatus = true
+	input.Options.ExcludeResponseBody = !isJSON(header.Get("Content-Type")) || header.Get("Content-Encoding") != ""
+	return openapi3filter.ValidateResponse(req.Context(), &openapi3filter.ResponseValidationInput{
+		RequestValidationInput: input,
+		Status:                 status,
+		Header:                 header,
+		Body:                   io.NopCloser(strings.NewReader(string(body))),
+		Options:                input.Options,
+	})
+}
+
+// CheckRecorded is a function that checks a request served to a recorder, and the response it recorded, against the
+// document, for the route tests. The request is only checked when it succeeded, as the failed ones are mostly meant to
+// break the contract, and its body is read again from its GetBody.
+func CheckRecorded(req *http.Request, rr *httptest.ResponseRecorder) error {
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return err
+		}
+		req.Body = body
+	}
+	if err := CheckRequest(req); err != nil && (rr.Code < http.StatusBadRequest || errors.Is(err, ErrNotDocumented)) {
+		return fmt.Errorf("request: %w", err)
+	}
+	if err := CheckResponse(req, rr.Code, rr.Header(), rr.Body.Bytes()); err != nil {
+		return fmt.Errorf("response: %w", err)
+	}
+	return nil
+}
+
+func requestInput(req *http.Request) (*openapi3filter.RequestValidationInput, error) {
+	if _, err := Document(); err != nil {
+		return nil, err
+	}
+	route, pathParams, err := router.FindRoute(req)
+	if errors.Is(err, routers.ErrPathNotFound) || errors.Is(err, routers.ErrMethodNotAllowed) {
+		return nil, ErrNotDocumented
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &openapi3filter.RequestValidationInput{
+		Request:    req,
+		PathParams: pathParams,
+		Route:      route,
+		Options: &openapi3filter.Options{
+			AuthenticationFunc:  openapi3filter.NoopAuthenticationFunc,
+			SkipSettingDefaults: true,
+		},
+	}, nil
+}
+
+// isJSON tells whether a media type is JSON, as application/json or application/problem+json
+func isJSON(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	return err == nil && (mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"))
+}
@@ -0,0 +1,12 @@
+// Package routes contains all routes of the application
+package routes
+
+import (
+	batchController "github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers/batch"
+	"github.com/gin-gonic/gin"
+)
+
+// BatchRoutes is a function that contains the batch route, which requires the requests to pass auth
+func BatchRoutes(router *gin.RouterGroup, controller *batchController.Controller, auth gin.HandlerFunc) {
+	router.POST("/batch", auth, controller.Batch)
+}
@@ -0,0 +1,358 @@
+package routes_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	menuService "github.com/Raj63/golang-rest-api/pkg/app/usecases/menu"
+	orderService "github.com/Raj63/golang-rest-api/pkg/app/usecases/order"
+	appErr "github.com/Raj63/golang-rest-api/pkg/domain/errors"
+	domainMenu "github.com/Raj63/golang-rest-api/pkg/domain/menu"
+	domainOrder "github.com/Raj63/golang-rest-api/pkg/domain/order"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/logger"
+	mockRepository "github.com/Raj63/golang-rest-api/pkg/infrastructure/mocks/repository"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/repository"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers"
+	batchController "github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers/batch"
+	errorsController "github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers/errors"
+	menuController "github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers/menu"
+	orderController "github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers/order"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/http/gin/ratelimiter"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/middlewares"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/routes"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+)
+
+// batchResult is the part of the response to a batch the tests check
+type batchResult struct {
+	Committed *bool `json:"committed"`
+	Results   []struct {
+		ID     string          `json:"id"`
+		Status int             `json:"status"`
+		Body   json.RawMessage `json:"body"`
+	} `json:"results"`
+}
+
+func (r batchResult) statuses() []int {
+	statuses := make([]int, len(r.Results))
+	for i, result := range r.Results {
+		statuses[i] = result.Status
+	}
+	return statuses
+}
+
+func TestBatchRoutes(t *testing.T) {
+
+	menu := domainMenu.Menu{ID: 1, Name: "Paneer Tikka", Description: "Grilled cottage cheese", Category: "starters", Price: 120.5, CreatedAt: time.Now(), UpdatedAt: time.Now()}
+	// createOrders expects the orders created, checking they are in the transaction when transactional is set
+	createOrders := func(times int, transactional bool) func() repository.Orders {
+		return func() repository.Orders {
+			oRepository := mockRepository.NewMockOrders(gomock.NewController(t))
+			oRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Times(times).
+				DoAndReturn(func(ctx context.Context, order *domainOrder.Request) (*domainOrder.Request, error) {
+					if inTransaction(ctx) != transactional {
+						t.Errorf("Order created with the wrong transaction. Expected in a transaction: %t.", transactional)
+					}
+					order.ID = 7
+					return order, nil
+				})
+			return oRepository
+		}
+	}
+	noOrders := func() repository.Orders {
+		return mockRepository.NewMockOrders(gomock.NewController(t))
+	}
+	noMenus := func() repository.Menus {
+		return mockRepository.NewMockMenus(gomock.NewController(t))
+	}
+	order := `{"id": "order-%d", "method": "POST", "path": "/v1/orders/", "body": {"diner_id": 1, "menu_id": 1, "quantity": 2}}`
+
+	type args struct {
+		body             string
+		noToken          bool
+		maxDuration      time.Duration
+		transactorErr    error
+		mockMenusFn      func() repository.Menus
+		mockOrdersFn     func() repository.Orders
+		outputStatus     int
+		outputStatuses   []int
+		outputCommitted  *bool
+		outputCommits    int
+		outputRollbacks  int
+		outputFirstBody  string
+		outputFirstOrder bool
+	}
+	committed, rolledBack := true, false
+
+	tests := []struct {
+		name string
+		args args
+	}{
+		{
+			name: "Run a batch of operations one after the other",
+			args: args{
+				body: `{"operations": [` +
+					`{"id": "order", "method": "POST", "path": "/v1/orders/", "body": {"diner_id": 1, "menu_id": 1, "quantity": 2}},` +
+					`{"id": "menu", "method": "GET", "path": "/v1/menus/1"},` +
+					`{"id": "missing", "method": "GET", "path": "/v1/menus/9"}]}`,
+				mockMenusFn: func() repository.Menus {
+					mRepository := mockRepository.NewMockMenus(gomock.NewController(t))
+					mRepository.EXPECT().GetByID(gomock.Any(), int64(1)).Times(1).Return(&menu, nil)
+					mRepository.EXPECT().GetByID(gomock.Any(), int64(9)).Times(1).Return(nil, appErr.NewAppErrorWithType(appErr.NotFound))
+					return mRepository
+				},
+				mockOrdersFn:     createOrders(1, false),
+				outputStatus:     http.StatusOK,
+				outputStatuses:   []int{http.StatusCreated, http.StatusOK, http.StatusNotFound},
+				outputFirstOrder: true,
+			},
+		},
+		{
+			name: "Run a transactional batch committed",
+			args: args{
+				body:             `{"transactional": true, "operations": [` + fmt.Sprintf(order, 1) + `,` + fmt.Sprintf(order, 2) + `]}`,
+				mockMenusFn:      noMenus,
+				mockOrdersFn:     createOrders(2, true),
+				outputStatus:     http.StatusOK,
+				outputStatuses:   []int{http.StatusCreated, http.StatusCreated},
+				outputCommitted:  &committed,
+				outputCommits:    1,
+				outputFirstOrder: true,
+			},
+		},
+		{
+			name: "Run a transactional batch rolled back at its first failed operation",
+			args: args{
+				body: `{"transactional": true, "operations": [` + fmt.Sprintf(order, 1) + `,` +
+					`{"method": "GET", "path": "/v1/menus/9"},` + fmt.Sprintf(order, 3) + `]}`,
+				mockMenusFn: func() repository.Menus {
+					mRepository := mockRepository.NewMockMenus(gomock.NewController(t))
+					mRepository.EXPECT().GetByID(gomock.Any(), int64(9)).Times(1).Return(nil, appErr.NewAppErrorWithType(appErr.NotFound))
+					return mRepository
+				},
+				mockOrdersFn:     createOrders(1, true),
+				outputStatus:     http.StatusOK,
+				outputStatuses:   []int{http.StatusCreated, http.StatusNotFound, http.StatusFailedDependency},
+				outputCommitted:  &rolledBack,
+				outputRollbacks:  1,
+				outputFirstOrder: true,
+			},
+		},
+		{
+			name: "Run a transactional batch rolled back once it held its transaction for too long",
+			args: args{
+				body:            `{"transactional": true, "operations": [` + fmt.Sprintf(order, 1) + `,` + fmt.Sprintf(order, 2) + `]}`,
+				maxDuration:     time.Nanosecond,
+				mockMenusFn:     noMenus,
+				mockOrdersFn:    noOrders,
+				outputStatus:    http.StatusOK,
+				outputStatuses:  []int{http.StatusFailedDependency, http.StatusFailedDependency},
+				outputCommitted: &rolledBack,
+				outputRollbacks: 1,
+			},
+		},
+		{
+			name: "Run a batch without a token failed due to unauthorized",
+			args: args{
+				body:         `{"operations": [` + fmt.Sprintf(order, 1) + `]}`,
+				noToken:      true,
+				mockMenusFn:  noMenus,
+				mockOrdersFn: noOrders,
+				outputStatus: http.StatusUnauthorized,
+			},
+		},
+		{
+			name: "Run an operation with an invalid body answers with its problem",
+			args: args{
+				body:            `{"operations": [{"method": "POST", "path": "/v1/orders/", "body": {"menu_id": 1}}]}`,
+				mockMenusFn:     noMenus,
+				mockOrdersFn:    noOrders,
+				outputStatus:    http.StatusOK,
+				outputStatuses:  []int{http.StatusBadRequest},
+				outputFirstBody: `"field":"diner_id"`,
+			},
+		},
+		{
+			name: "Run a transactional batch failed due to the database",
+			args: args{
+				body:          `{"transactional": true, "operations": [` + fmt.Sprintf(order, 1) + `]}`,
+				transactorErr: errors.New("connection refused"),
+				mockMenusFn:   noMenus,
+				mockOrdersFn:  noOrders,
+				outputStatus:  http.StatusInternalServerError,
+			},
+		},
+		{
+			name: "Run a batch without operations failed due to validation error",
+			args: args{
+				body:         `{"operations": []}`,
+				mockMenusFn:  noMenus,
+				mockOrdersFn: noOrders,
+				outputStatus: http.StatusBadRequest,
+			},
+		},
+		{
+			name: "Run an operation with an unknown method failed due to validation error",
+			args: args{
+				body:         `{"operations": [{"method": "TRACE", "path": "/v1/menus/1"}]}`,
+				mockMenusFn:  noMenus,
+				mockOrdersFn: noOrders,
+				outputStatus: http.StatusBadRequest,
+			},
+		},
+		{
+			name: "Run an operation outside of the API failed due to validation error",
+			args: args{
+				body:         `{"operations": [{"method": "GET", "path": "/swagger/index.html"}]}`,
+				mockMenusFn:  noMenus,
+				mockOrdersFn: noOrders,
+				outputStatus: http.StatusBadRequest,
+			},
+		},
+		{
+			name: "Run a batch within a batch failed due to validation error",
+			args: args{
+				body:         `{"operations": [{"method": "POST", "path": "/v1/batch/", "body": {"operations": []}}]}`,
+				mockMenusFn:  noMenus,
+				mockOrdersFn: noOrders,
+				outputStatus: http.StatusBadRequest,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest("POST", "/v1/batch", bytes.NewBufferString(tt.args.body))
+			if err != nil {
+				t.Errorf("Error creating a new request: %v", err)
+			}
+			if !tt.args.noToken {
+				req.Header.Set("Authorization", "Bearer "+adminToken(t, "tablet-1"))
+			}
+			if tt.args.maxDuration > 0 {
+				defer func(maxDuration time.Duration) { batchController.MaxTransactionDuration = maxDuration }(batchController.MaxTransactionDuration)
+				batchController.MaxTransactionDuration = tt.args.maxDuration
+			}
+			rr := httptest.NewRecorder()
+			router, routerV1 := getTestRouter()
+			routes.MenuRoutes(routerV1, &menuController.Controller{MenuService: menuService.Service{MenuRepository: tt.args.mockMenusFn()}})
+			routes.OrderRoutes(routerV1, &orderController.Controller{OrderService: orderService.Service{OrderRepository: tt.args.mockOrdersFn()}})
+			transactor := &fakeTransactor{err: tt.args.transactorErr}
+			routes.BatchRoutes(routerV1, &batchController.Controller{Handler: router, Transactor: transactor}, middlewares.AuthJWTMiddleware(testJWTSecret))
+			router.ServeHTTP(rr, req)
+			assertContract(t, req, rr)
+
+			if status := rr.Code; status != tt.args.outputStatus {
+				t.Fatalf("Handler returned wrong status code. Expected: %d. Got: %d. Body: %s", tt.args.outputStatus, status, rr.Body.String())
+			}
+			if transactor.commits != tt.args.outputCommits || transactor.rollbacks != tt.args.outputRollbacks {
+				t.Errorf("Handler ended the transaction wrongly. Expected: %d commits, %d rollbacks. Got: %d commits, %d rollbacks.",
+					tt.args.outputCommits, tt.args.outputRollbacks, transactor.commits, transactor.rollbacks)
+			}
+			if rr.Code != http.StatusOK {
+				return
+			}
+
+			var result batchResult
+			if err := json.Unmarshal(rr.Body.Bytes(), &result); err != nil {
+				t.Fatalf("Handler returned an invalid batch: %v", err)
+			}
+			if got := result.statuses(); !equalStatuses(got, tt.args.outputStatuses) {
+				t.Errorf("Handler returned wrong statuses. Expected: %v. Got: %v. Body: %s", tt.args.outputStatuses, got, rr.Body.String())
+			}
+			if (result.Committed == nil) != (tt.args.outputCommitted == nil) ||
+				(result.Committed != nil && *result.Committed != *tt.args.outputCommitted) {
+				t.Errorf("Handler returned wrong committed. Body: %s", rr.Body.String())
+			}
+			if tt.args.outputFirstOrder {
+				var order domainOrder.Request
+				if err := json.Unmarshal(result.Results[0].Body, &order); err != nil || order.ID != 7 {
+					t.Errorf("Handler returned wrong body for the first operation: %s", result.Results[0].Body)
+				}
+			}
+			if tt.args.outputFirstBody != "" && !bytes.Contains(result.Results[0].Body, []byte(tt.args.outputFirstBody)) {
+				t.Errorf("Handler returned wrong body for the first operation. Expected: %s. Got: %s", tt.args.outputFirstBody, result.Results[0].Body)
+			}
+		})
+	}
+}
+
+func TestBatchAuthAndRateLimits(t *testing.T) {
+
+	// the router lets each tablet send three requests, the batch included, and the batch requires the token of a tablet
+	testLogger, _, _ := logger.NewTestLogger()
+	router := gin.New()
+	router.Use(ratelimiter.GinMemRatelimiter(ratelimiter.GinRatelimiterConfig{
+		LimitKey: func(c *gin.Context) string {
+			return c.ClientIP()
+		},
+		LimitedHandler: func(c *gin.Context) {
+			errorsController.AbortWithProblem(c, http.StatusTooManyRequests, "exceeds request rate limit")
+		},
+		TokenBucketConfig: func(*gin.Context) (time.Duration, int) {
+			return time.Hour, 3
+		},
+	}, testLogger))
+	router.Use(errorsController.Handler)
+	routerV1 := router.Group("/v1")
+
+	oRepository := mockRepository.NewMockOrders(gomock.NewController(t))
+	oRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Times(2).Return(&domainOrder.Request{ID: 7, DinnerID: 1, MenuID: 1, Quantity: 2}, nil)
+	routes.OrderRoutes(routerV1, &orderController.Controller{OrderService: orderService.Service{OrderRepository: oRepository}})
+	routes.BatchRoutes(routerV1, &batchController.Controller{Handler: router, Transactor: &fakeTransactor{}}, middlewares.AuthJWTMiddleware(testJWTSecret))
+
+	send := func(remoteAddr, token string) (*http.Request, *httptest.ResponseRecorder) {
+		order := `{"method": "POST", "path": "/v1/orders/", "body": {"diner_id": 1, "menu_id": 1, "quantity": 2}}`
+		req, err := http.NewRequest("POST", "/v1/batch", bytes.NewBufferString(`{"operations": [`+order+`,`+order+`,`+order+`]}`))
+		if err != nil {
+			t.Errorf("Error creating a new request: %v", err)
+		}
+		req.RemoteAddr = remoteAddr
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, req)
+		return req, rr
+	}
+
+	// the operations are sent with the token of the batch, and the third is over the rate limit
+	req, rr := send("192.0.2.10:4321", adminToken(t, "tablet-1"))
+	assertContract(t, req, rr)
+	var result batchResult
+	if err := json.Unmarshal(rr.Body.Bytes(), &result); rr.Code != http.StatusOK || err != nil {
+		t.Fatalf("Handler returned wrong status code. Expected: %d. Got: %d. Body: %s", http.StatusOK, rr.Code, rr.Body.String())
+	}
+	expected := []int{http.StatusCreated, http.StatusCreated, http.StatusTooManyRequests}
+	if got := result.statuses(); !equalStatuses(got, expected) {
+		t.Errorf("Handler returned wrong statuses. Expected: %v. Got: %v. Body: %s", expected, got, rr.Body.String())
+	}
+	var problem controllers.ProblemDetails
+	if err := json.Unmarshal(result.Results[2].Body, &problem); err != nil || problem.Status != http.StatusTooManyRequests {
+		t.Errorf("Handler returned wrong body for the limited operation: %s", result.Results[2].Body)
+	}
+
+	// another tablet is limited apart, and not let in without its token
+	if _, rr := send("192.0.2.11:4321", ""); rr.Code != http.StatusUnauthorized {
+		t.Errorf("Handler returned wrong status code. Expected: %d. Got: %d. Body: %s", http.StatusUnauthorized, rr.Code, rr.Body.String())
+	}
+}
+
+func equalStatuses(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
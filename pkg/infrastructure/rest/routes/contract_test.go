@@ -0,0 +1,179 @@
+package routes_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/logger"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers"
+	errorsController "github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers/errors"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/middlewares"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/openapi"
+	"github.com/gin-gonic/gin"
+)
+
+// assertContract checks a request served by a route test, and its response, against the OpenAPI document
+func assertContract(t *testing.T, req *http.Request, rr *httptest.ResponseRecorder) {
+	t.Helper()
+	err := openapi.CheckRecorded(req, rr)
+	// an unknown route is not documented, by design
+	if errors.Is(err, openapi.ErrNotDocumented) && rr.Code == http.StatusNotFound {
+		return
+	}
+	if err != nil {
+		t.Errorf("Handler broke the OpenAPI contract of %s %s: %v", req.Method, req.URL.Path, err)
+	}
+}
+
+func TestOpenAPIDocument(t *testing.T) {
+	router := gin.Default()
+	router.GET("/openapi.json", openapi.Handler)
+	req, err := http.NewRequest("GET", "/openapi.json", nil)
+	if err != nil {
+		t.Errorf("Error creating a new request: %v", err)
+	}
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	if status := rr.Code; status != http.StatusOK {
+		t.Fatalf("Handler returned wrong status code. Expected: %d. Got: %d. Body: %s", http.StatusOK, status, rr.Body.String())
+	}
+	var document struct {
+		OpenAPI string                 `json:"openapi"`
+		Paths   map[string]interface{} `json:"paths"`
+	}
+	if err := json.Unmarshal(rr.Body.Bytes(), &document); err != nil {
+		t.Fatalf("Handler returned an invalid document: %v", err)
+	}
+	if !strings.HasPrefix(document.OpenAPI, "3.") {
+		t.Errorf("Handler returned wrong OpenAPI version. Expected: 3.x. Got: %s.", document.OpenAPI)
+	}
+	for _, path := range []string{"/menus/", "/menus/{menu_id}", "/orders/{id}", "/diners/{diner_id}/orders"} {
+		if _, ok := document.Paths[path]; !ok {
+			t.Errorf("Handler returned a document without the path %s.", path)
+		}
+	}
+}
+
+func TestContractValidation(t *testing.T) {
+
+	type args struct {
+		mode     string
+		method   string
+		endpoint string
+		// handler answers the endpoint in place of the controllers
+		handler      gin.HandlerFunc
+		outputStatus int
+		// outputLogged tells whether the request or its response is logged as breaking the contract
+		outputLogged bool
+	}
+	validMenu := func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"id": 1, "name": "Dum Briyani", "description": "Hyderabadi", "category": "briyani", "price": 200.5})
+	}
+	invalidMenu := func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"id": "one", "name": "Dum Briyani"})
+	}
+
+	tests := []struct {
+		name string
+		args args
+	}{
+		{
+			name: "Response keeping the contract is let through",
+			args: args{
+				mode:         middlewares.ContractReject,
+				method:       "GET",
+				endpoint:     "/v1/menus/1",
+				handler:      validMenu,
+				outputStatus: http.StatusOK,
+			},
+		},
+		{
+			name: "Response breaking the contract is replaced by a problem when rejecting",
+			args: args{
+				mode:         middlewares.ContractReject,
+				method:       "GET",
+				endpoint:     "/v1/menus/1",
+				handler:      invalidMenu,
+				outputStatus: http.StatusInternalServerError,
+				outputLogged: true,
+			},
+		},
+		{
+			name: "Response breaking the contract is logged and let through when logging",
+			args: args{
+				mode:         middlewares.ContractLog,
+				method:       "GET",
+				endpoint:     "/v1/menus/1",
+				handler:      invalidMenu,
+				outputStatus: http.StatusOK,
+				outputLogged: true,
+			},
+		},
+		{
+			name: "Request with a parameter of the wrong type is answered with a problem when rejecting",
+			args: args{
+				mode:         middlewares.ContractReject,
+				method:       "GET",
+				endpoint:     "/v1/menus/one",
+				handler:      validMenu,
+				outputStatus: http.StatusBadRequest,
+				outputLogged: true,
+			},
+		},
+		{
+			name: "Request with a parameter of the wrong type is logged and let through when logging",
+			args: args{
+				mode:         middlewares.ContractLog,
+				method:       "GET",
+				endpoint:     "/v1/menus/one",
+				handler:      validMenu,
+				outputStatus: http.StatusOK,
+				outputLogged: true,
+			},
+		},
+		{
+			name: "Route not documented is not checked",
+			args: args{
+				mode:         middlewares.ContractReject,
+				method:       "GET",
+				endpoint:     "/v1/menus/1/undocumented",
+				handler:      invalidMenu,
+				outputStatus: http.StatusOK,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(tt.args.method, tt.args.endpoint, bytes.NewBufferString(""))
+			if err != nil {
+				t.Errorf("Error creating a new request: %v", err)
+			}
+			rr := httptest.NewRecorder()
+			testLogger, logs, logWriter := logger.NewTestLogger()
+			router := gin.Default()
+			router.Use(middlewares.Compress(middlewares.CompressMinSize))
+			router.Use(middlewares.ContractValidation(tt.args.mode, testLogger))
+			router.Use(errorsController.Handler)
+			router.GET("/v1/menus/:id", tt.args.handler)
+			router.GET("/v1/menus/:id/undocumented", tt.args.handler)
+			router.ServeHTTP(rr, req)
+			_ = logWriter.Flush()
+
+			if status := rr.Code; status != tt.args.outputStatus {
+				t.Errorf("Handler returned wrong status code. Expected: %d. Got: %d. Body: %s", tt.args.outputStatus, status, rr.Body.String())
+			}
+			if status := rr.Code; status >= http.StatusBadRequest && rr.Header().Get("Content-Type") != controllers.ProblemMIME {
+				t.Errorf("Handler returned wrong Content-Type. Expected: %s. Got: %s.", controllers.ProblemMIME, rr.Header().Get("Content-Type"))
+			}
+			if logged := strings.Contains(logs.String(), "breaks the OpenAPI contract"); logged != tt.args.outputLogged {
+				t.Errorf("Handler logged wrong. Expected logged: %v. Got: %q.", tt.args.outputLogged, logs.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,24 @@
+// Package routes contains all routes of the application
+package routes
+
+import (
+	customerController "github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers/customer"
+	"github.com/gin-gonic/gin"
+)
+
+// CustomerRoutes is a function that contains all customer routes
+func CustomerRoutes(router *gin.RouterGroup, controller *customerController.Controller) {
+
+	routerCustomer := router.Group("/customers")
+	{
+		routerCustomer.POST("/", controller.NewCustomer)
+		routerCustomer.GET("/tiers", controller.GetTiers)
+		routerCustomer.GET("/:id", controller.GetCustomerByID)
+		routerCustomer.PATCH("/:id", controller.UpdateCustomer)
+		routerCustomer.POST("/:id/sessions", controller.LinkSession)
+		routerCustomer.GET("/:id/points", controller.GetPoints)
+		routerCustomer.GET("/:id/ledger", controller.GetLedger)
+		routerCustomer.POST("/:id/redemptions", controller.RedeemPoints)
+	}
+
+}
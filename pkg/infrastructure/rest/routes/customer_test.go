@@ -0,0 +1,277 @@
+// Package routes contains all routes of the application
+package routes_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	customerService "github.com/Raj63/golang-rest-api/pkg/app/usecases/customer"
+	domainCustomer "github.com/Raj63/golang-rest-api/pkg/domain/customer"
+	appErr "github.com/Raj63/golang-rest-api/pkg/domain/errors"
+	mockRepository "github.com/Raj63/golang-rest-api/pkg/infrastructure/mocks/repository"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/repository"
+	customerController "github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers/customer"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/routes"
+	"github.com/brianvoe/gofakeit"
+	"github.com/golang/mock/gomock"
+)
+
+func TestCustomerRoutes(t *testing.T) {
+
+	customerName := gofakeit.Name()
+	customerEmail := gofakeit.Email()
+	customer := domainCustomer.Customer{
+		ID:          1,
+		Name:        customerName,
+		Email:       customerEmail,
+		Preferences: "window seat",
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+	balance := domainCustomer.Balance{CustomerID: 1, Points: 340, LifetimePoints: 740}
+	type args struct {
+		method       string
+		endpoint     string
+		body         interface{}
+		mockrepoFn   func() repository.Customers
+		outputStatus int
+	}
+	existing := func() *mockRepository.MockCustomers {
+		cRepository := mockRepository.NewMockCustomers(gomock.NewController(t))
+		current := customer
+		cRepository.EXPECT().GetByID(gomock.Any(), customer.ID).AnyTimes().Return(&current, nil)
+		currentBalance := balance
+		cRepository.EXPECT().GetBalance(gomock.Any(), customer.ID).AnyTimes().Return(&currentBalance, nil)
+		return cRepository
+	}
+
+	tests := []struct {
+		name string
+		args args
+	}{
+		{
+			name: "Add new Customer successfully",
+			args: args{
+				method:   "POST",
+				endpoint: "/v1/customers/",
+				body: customerController.NewCustomerRequest{
+					Name:  customerName,
+					Email: customerEmail,
+				},
+				outputStatus: http.StatusCreated,
+				mockrepoFn: func() repository.Customers {
+					cRepository := mockRepository.NewMockCustomers(gomock.NewController(t))
+					created := customer
+					cRepository.EXPECT().Create(gomock.Any(), gomock.Any()).AnyTimes().Return(&created, nil)
+					return cRepository
+				},
+			},
+		},
+		{
+			name: "Add new Customer failed due to missing contact validation error",
+			args: args{
+				method:       "POST",
+				endpoint:     "/v1/customers/",
+				body:         customerController.NewCustomerRequest{Name: customerName},
+				outputStatus: http.StatusBadRequest,
+				mockrepoFn: func() repository.Customers {
+					return mockRepository.NewMockCustomers(gomock.NewController(t))
+				},
+			},
+		},
+		{
+			name: "Add new Customer failed due to duplicate email",
+			args: args{
+				method:   "POST",
+				endpoint: "/v1/customers/",
+				body: customerController.NewCustomerRequest{
+					Name:  customerName,
+					Email: customerEmail,
+				},
+				outputStatus: http.StatusConflict,
+				mockrepoFn: func() repository.Customers {
+					cRepository := mockRepository.NewMockCustomers(gomock.NewController(t))
+					cRepository.EXPECT().Create(gomock.Any(), gomock.Any()).AnyTimes().Return(nil, appErr.NewAppErrorWithType(appErr.ResourceAlreadyExists))
+					return cRepository
+				},
+			},
+		},
+		{
+			name: "Fetch Customer by ID successfully",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v1/customers/1",
+				outputStatus: http.StatusOK,
+				mockrepoFn: func() repository.Customers {
+					return existing()
+				},
+			},
+		},
+		{
+			name: "Failed to fetch Customer by ID due to missing record",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v1/customers/2",
+				outputStatus: http.StatusNotFound,
+				mockrepoFn: func() repository.Customers {
+					cRepository := mockRepository.NewMockCustomers(gomock.NewController(t))
+					cRepository.EXPECT().GetByID(gomock.Any(), gomock.Any()).AnyTimes().Return(nil, appErr.NewAppErrorWithType(appErr.NotFound))
+					return cRepository
+				},
+			},
+		},
+		{
+			name: "Update Customer preferences successfully",
+			args: args{
+				method:       "PATCH",
+				endpoint:     "/v1/customers/1",
+				body:         map[string]string{"preferences": "no peanuts"},
+				outputStatus: http.StatusOK,
+				mockrepoFn: func() repository.Customers {
+					cRepository := existing()
+					cRepository.EXPECT().Update(gomock.Any(), gomock.Any()).AnyTimes().Return(nil)
+					return cRepository
+				},
+			},
+		},
+		{
+			name: "Link Diner session to Customer successfully",
+			args: args{
+				method:       "POST",
+				endpoint:     "/v1/customers/1/sessions",
+				body:         customerController.LinkSessionRequest{DinerID: 3},
+				outputStatus: http.StatusOK,
+				mockrepoFn: func() repository.Customers {
+					cRepository := existing()
+					cRepository.EXPECT().LinkSession(gomock.Any(), customer.ID, int64(3)).AnyTimes().Return(nil)
+					return cRepository
+				},
+			},
+		},
+		{
+			name: "Failed to link Diner session due to no open session",
+			args: args{
+				method:       "POST",
+				endpoint:     "/v1/customers/1/sessions",
+				body:         customerController.LinkSessionRequest{DinerID: 3},
+				outputStatus: http.StatusNotFound,
+				mockrepoFn: func() repository.Customers {
+					cRepository := existing()
+					cRepository.EXPECT().LinkSession(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(appErr.NewAppErrorWithType(appErr.NotFound))
+					return cRepository
+				},
+			},
+		},
+		{
+			name: "Fetch Customer points balance successfully",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v1/customers/1/points",
+				outputStatus: http.StatusOK,
+				mockrepoFn: func() repository.Customers {
+					return existing()
+				},
+			},
+		},
+		{
+			name: "Fetch Customer points ledger successfully",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v1/customers/1/ledger",
+				outputStatus: http.StatusOK,
+				mockrepoFn: func() repository.Customers {
+					cRepository := existing()
+					cRepository.EXPECT().GetLedger(gomock.Any(), customer.ID).AnyTimes().Return([]domainCustomer.LedgerEntry{
+						{ID: 2, CustomerID: 1, SessionID: 4, Kind: domainCustomer.KindRedemption, Points: -100, Amount: 50, CreatedAt: time.Now()},
+						{ID: 1, CustomerID: 1, SessionID: 3, Kind: domainCustomer.KindAccrual, Points: 440, Amount: 4400, CreatedAt: time.Now()},
+					}, nil)
+					return cRepository
+				},
+			},
+		},
+		{
+			name: "Redeem Customer points successfully",
+			args: args{
+				method:       "POST",
+				endpoint:     "/v1/customers/1/redemptions",
+				body:         customerController.RedeemRequest{DinerID: 3, Points: 100},
+				outputStatus: http.StatusCreated,
+				mockrepoFn: func() repository.Customers {
+					cRepository := mockRepository.NewMockCustomers(gomock.NewController(t))
+					cRepository.EXPECT().Redeem(gomock.Any(), gomock.Any(), int64(3)).AnyTimes().Return(&domainCustomer.LedgerEntry{
+						ID: 2, CustomerID: 1, SessionID: 4, Kind: domainCustomer.KindRedemption, Points: -100, Amount: 50, CreatedAt: time.Now(),
+					}, nil)
+					return cRepository
+				},
+			},
+		},
+		{
+			name: "Failed to redeem Customer points due to too few points",
+			args: args{
+				method:       "POST",
+				endpoint:     "/v1/customers/1/redemptions",
+				body:         customerController.RedeemRequest{DinerID: 3, Points: 10},
+				outputStatus: http.StatusBadRequest,
+				mockrepoFn: func() repository.Customers {
+					return mockRepository.NewMockCustomers(gomock.NewController(t))
+				},
+			},
+		},
+		{
+			name: "Failed to redeem Customer points due to a previous redemption on the bill",
+			args: args{
+				method:       "POST",
+				endpoint:     "/v1/customers/1/redemptions",
+				body:         customerController.RedeemRequest{DinerID: 3, Points: 100},
+				outputStatus: http.StatusConflict,
+				mockrepoFn: func() repository.Customers {
+					cRepository := mockRepository.NewMockCustomers(gomock.NewController(t))
+					cRepository.EXPECT().Redeem(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(nil, appErr.NewAppErrorWithType(appErr.ResourceAlreadyExists))
+					return cRepository
+				},
+			},
+		},
+		{
+			name: "Fetch membership tiers successfully",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v1/customers/tiers",
+				outputStatus: http.StatusOK,
+				mockrepoFn: func() repository.Customers {
+					return mockRepository.NewMockCustomers(gomock.NewController(t))
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if tt.args.body != nil {
+				err := json.NewEncoder(&buf).Encode(tt.args.body)
+				if err != nil {
+					log.Fatal(err)
+				}
+			}
+
+			req, err := http.NewRequest(tt.args.method, tt.args.endpoint, &buf)
+			if err != nil {
+				t.Errorf("Error creating a new request: %v", err)
+			}
+			rr := httptest.NewRecorder()
+			router, routerV1 := getTestRouter()
+			routes.CustomerRoutes(routerV1, &customerController.Controller{CustomerService: customerService.Service{CustomerRepository: tt.args.mockrepoFn()}})
+			router.ServeHTTP(rr, req)
+			assertContract(t, req, rr)
+
+			if status := rr.Code; status != tt.args.outputStatus {
+				t.Errorf("Handler returned wrong status code. Expected: %d. Got: %d.", tt.args.outputStatus, status)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package routes
 
 import (
 	dinerController "github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers/diner"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/middlewares"
 	"github.com/gin-gonic/gin"
 )
 
@@ -12,9 +13,13 @@ func DinerRoutes(router *gin.RouterGroup, controller *dinerController.Controller
 	routerDiner := router.Group("/diners")
 	{
 		routerDiner.POST("/", controller.NewDiner)
-		routerDiner.GET("/:id", controller.GetDinersByID)
-		routerDiner.GET("/", controller.GetAllDiners)
+		routerDiner.GET("/:id", middlewares.ConditionalGET(middlewares.CachePrivate), controller.GetDinersByID)
+		routerDiner.GET("/", middlewares.ConditionalGET(middlewares.CachePrivate), controller.GetAllDiners)
+		routerDiner.PATCH("/:id", controller.UpdateDiner)
 		routerDiner.DELETE("/:id", controller.DeleteDiner)
+		routerDiner.POST("/:id/sessions", controller.CheckInDiner)
+		routerDiner.GET("/:id/sessions", controller.GetDinerSessions)
+		routerDiner.POST("/:id/checkout", controller.CheckoutDiner)
 	}
 
 }
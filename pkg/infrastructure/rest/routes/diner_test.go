@@ -2,6 +2,7 @@ package routes_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"log"
 	"net/http"
@@ -9,13 +10,16 @@ import (
 	"testing"
 	"time"
 
+	customerService "github.com/Raj63/golang-rest-api/pkg/app/usecases/customer"
 	dinerService "github.com/Raj63/golang-rest-api/pkg/app/usecases/diner"
+	domainCustomer "github.com/Raj63/golang-rest-api/pkg/domain/customer"
 	domainDiner "github.com/Raj63/golang-rest-api/pkg/domain/diner"
 	appErr "github.com/Raj63/golang-rest-api/pkg/domain/errors"
 	mockRepository "github.com/Raj63/golang-rest-api/pkg/infrastructure/mocks/repository"
 	"github.com/Raj63/golang-rest-api/pkg/infrastructure/repository"
 	dinerController "github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers/diner"
 	errorsController "github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers/errors"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/middlewares"
 	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/routes"
 	"github.com/brianvoe/gofakeit"
 	"github.com/gin-gonic/gin"
@@ -25,6 +29,7 @@ import (
 func getTestRouter() (*gin.Engine, *gin.RouterGroup) {
 	// initialize the router
 	router := gin.Default()
+	router.Use(middlewares.Compress(middlewares.CompressMinSize))
 	// the application errors will be processed here before returning to the caller
 	router.Use(errorsController.Handler)
 
@@ -133,6 +138,101 @@ func TestDinerRoutes(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Search Diners by name and table successfully",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v1/diners/?page=1&limit=10&name=SMI&table_no=3",
+				outputStatus: http.StatusOK,
+				mockrepoFn: func() repository.Diners {
+					mRepository := mockRepository.NewMockDiners(gomock.NewController(t))
+					mRepository.EXPECT().Search(gomock.Any(), &repository.DinerFilter{Name: "SMI", TableNumber: 3}, int64(1), int64(10)).AnyTimes().Return(&repository.PaginationResultDiner{
+						Data: &[]domainDiner.Diner{
+							{
+								ID:          gofakeit.Int64(),
+								Name:        "Mr. Smith",
+								TableNumber: 3,
+								CreatedAt:   time.Now(),
+								UpdatedAt:   time.Now(),
+							},
+						},
+						Total:   1,
+						Limit:   10,
+						Current: 1,
+					}, nil)
+					return mRepository
+				},
+			},
+		},
+		{
+			name: "Search a keyset page of Diners by name successfully",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v1/diners/?limit=10&name=SMI&cursor=" + repository.Cursor{Keys: []string{"Mr. Adams"}, ID: 4}.Encode(),
+				outputStatus: http.StatusOK,
+				mockrepoFn: func() repository.Diners {
+					mRepository := mockRepository.NewMockDiners(gomock.NewController(t))
+					mRepository.EXPECT().SearchPage(gomock.Any(), &repository.DinerFilter{Name: "SMI"}, &repository.PageRequest{
+						Cursor: &repository.Cursor{Keys: []string{"Mr. Adams"}, ID: 4},
+						Limit:  10,
+					}).Times(1).Return(&repository.PaginationResultDiner{
+						Data: &[]domainDiner.Diner{
+							{
+								ID:          5,
+								Name:        "Mr. Smith",
+								TableNumber: 3,
+								CreatedAt:   time.Now(),
+								UpdatedAt:   time.Now(),
+							},
+						},
+						Limit:      10,
+						PrevCursor: repository.Cursor{Keys: []string{"Mr. Smith"}, ID: 5, Backward: true}.Encode(),
+					}, nil)
+					return mRepository
+				},
+			},
+		},
+		{
+			name: "Search Diners sorted by table number and trimmed to fields successfully",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v1/diners/?limit=10&table_no=3&sort=table_no,-created_at&fields=id,name",
+				outputStatus: http.StatusOK,
+				mockrepoFn: func() repository.Diners {
+					mRepository := mockRepository.NewMockDiners(gomock.NewController(t))
+					mRepository.EXPECT().SearchPage(gomock.Any(), &repository.DinerFilter{TableNumber: 3}, &repository.PageRequest{
+						Limit: 10,
+						Sort:  []repository.SortField{{Field: "table_no"}, {Field: "created_at", Desc: true}},
+					}).Times(1).Return(&repository.PaginationResultDiner{
+						Data:  &[]domainDiner.Diner{{ID: 5, Name: "Mr. Smith", TableNumber: 3}},
+						Limit: 10,
+					}, nil)
+					return mRepository
+				},
+			},
+		},
+		{
+			name: "Failed to search Diners sorted by a repeated field",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v1/diners/?sort=name,-name",
+				outputStatus: http.StatusBadRequest,
+				mockrepoFn: func() repository.Diners {
+					return mockRepository.NewMockDiners(gomock.NewController(t))
+				},
+			},
+		},
+		{
+			name: "Failed to search Diners due to invalid table number",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v1/diners/?page=1&limit=10&table_no=window",
+				outputStatus: http.StatusBadRequest,
+				mockrepoFn: func() repository.Diners {
+					return mockRepository.NewMockDiners(gomock.NewController(t))
+				},
+			},
+		},
 		{
 			name: "Fetch Diner by ID successfully",
 			args: args{
@@ -165,6 +265,67 @@ func TestDinerRoutes(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Move Diner to another table successfully",
+			args: args{
+				method:       "PATCH",
+				endpoint:     "/v1/diners/1",
+				body:         map[string]int{"table_no": dinertable2},
+				outputStatus: http.StatusOK,
+				mockrepoFn: func() repository.Diners {
+					mRepository := mockRepository.NewMockDiners(gomock.NewController(t))
+					mRepository.EXPECT().GetByID(gomock.Any(), int64(1)).AnyTimes().Return(&domainDiner.Diner{ID: 1, Name: dinerName, TableNumber: dinertable1}, nil)
+					mRepository.EXPECT().Update(gomock.Any(), &domainDiner.Diner{ID: 1, Name: dinerName, TableNumber: dinertable2}).AnyTimes().Return(&domainDiner.Diner{
+						ID:          1,
+						Name:        dinerName,
+						TableNumber: dinertable2,
+						UpdatedAt:   time.Now(),
+					}, nil)
+					return mRepository
+				},
+			},
+		},
+		{
+			name: "Failed to update Diner due to same name seated at the table",
+			args: args{
+				method:       "PATCH",
+				endpoint:     "/v1/diners/1",
+				body:         map[string]string{"name": dinerName2},
+				outputStatus: http.StatusConflict,
+				mockrepoFn: func() repository.Diners {
+					mRepository := mockRepository.NewMockDiners(gomock.NewController(t))
+					mRepository.EXPECT().GetByID(gomock.Any(), int64(1)).AnyTimes().Return(&domainDiner.Diner{ID: 1, Name: dinerName, TableNumber: dinertable1}, nil)
+					mRepository.EXPECT().Update(gomock.Any(), gomock.Any()).AnyTimes().Return(nil, appErr.NewAppErrorWithType(appErr.ResourceAlreadyExists))
+					return mRepository
+				},
+			},
+		},
+		{
+			name: "Failed to update Diner due to missing record",
+			args: args{
+				method:       "PATCH",
+				endpoint:     "/v1/diners/1",
+				body:         map[string]string{"name": dinerName2},
+				outputStatus: http.StatusNotFound,
+				mockrepoFn: func() repository.Diners {
+					mRepository := mockRepository.NewMockDiners(gomock.NewController(t))
+					mRepository.EXPECT().GetByID(gomock.Any(), gomock.Any()).AnyTimes().Return(nil, appErr.NewAppErrorWithType(appErr.NotFound))
+					return mRepository
+				},
+			},
+		},
+		{
+			name: "Failed to update Diner due to no fields to change",
+			args: args{
+				method:       "PATCH",
+				endpoint:     "/v1/diners/1",
+				body:         map[string]string{},
+				outputStatus: http.StatusBadRequest,
+				mockrepoFn: func() repository.Diners {
+					return mockRepository.NewMockDiners(gomock.NewController(t))
+				},
+			},
+		},
 		{
 			name: "Deleted Diner by ID successfully",
 			args: args{
@@ -191,6 +352,98 @@ func TestDinerRoutes(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Check in returning Diner successfully",
+			args: args{
+				method:       "POST",
+				endpoint:     "/v1/diners/1/sessions",
+				body:         dinerController.CheckInRequest{TableNumber: dinertable2},
+				outputStatus: http.StatusCreated,
+				mockrepoFn: func() repository.Diners {
+					mRepository := mockRepository.NewMockDiners(gomock.NewController(t))
+					mRepository.EXPECT().CheckIn(gomock.Any(), int64(1), dinertable2).AnyTimes().Return(&domainDiner.Session{
+						ID:          gofakeit.Int64(),
+						DinerID:     1,
+						TableNumber: dinertable2,
+						CheckedInAt: time.Now(),
+					}, nil)
+					return mRepository
+				},
+			},
+		},
+		{
+			name: "Failed to check in Diner due to open session",
+			args: args{
+				method:       "POST",
+				endpoint:     "/v1/diners/1/sessions",
+				body:         dinerController.CheckInRequest{TableNumber: dinertable2},
+				outputStatus: http.StatusConflict,
+				mockrepoFn: func() repository.Diners {
+					mRepository := mockRepository.NewMockDiners(gomock.NewController(t))
+					mRepository.EXPECT().CheckIn(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(nil, appErr.NewAppErrorWithType(appErr.ResourceAlreadyExists))
+					return mRepository
+				},
+			},
+		},
+		{
+			name: "Failed to check in Diner due to missing table number validation error",
+			args: args{
+				method:       "POST",
+				endpoint:     "/v1/diners/1/sessions",
+				body:         dinerController.CheckInRequest{},
+				outputStatus: http.StatusBadRequest,
+				mockrepoFn: func() repository.Diners {
+					return mockRepository.NewMockDiners(gomock.NewController(t))
+				},
+			},
+		},
+		{
+			name: "Fetch Diner sessions successfully",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v1/diners/1/sessions",
+				outputStatus: http.StatusOK,
+				mockrepoFn: func() repository.Diners {
+					mRepository := mockRepository.NewMockDiners(gomock.NewController(t))
+					checkedOutAt := time.Now().Add(-time.Hour)
+					mRepository.EXPECT().GetSessions(gomock.Any(), int64(1)).AnyTimes().Return([]domainDiner.Session{
+						{ID: 2, DinerID: 1, TableNumber: dinertable2, CheckedInAt: time.Now()},
+						{ID: 1, DinerID: 1, TableNumber: dinertable1, CheckedInAt: checkedOutAt.Add(-time.Hour), CheckedOutAt: &checkedOutAt},
+					}, nil)
+					return mRepository
+				},
+			},
+		},
+		{
+			name: "Checkout Diner successfully",
+			args: args{
+				method:       "POST",
+				endpoint:     "/v1/diners/1/checkout",
+				outputStatus: http.StatusCreated,
+				mockrepoFn: func() repository.Diners {
+					mRepository := mockRepository.NewMockDiners(gomock.NewController(t))
+					checkedOutAt := time.Now()
+					mRepository.EXPECT().Checkout(gomock.Any(), int64(1)).AnyTimes().Return(
+						&domainDiner.Session{ID: 1, DinerID: 1, TableNumber: dinertable1, CheckedInAt: checkedOutAt.Add(-time.Hour), CheckedOutAt: &checkedOutAt},
+						&domainDiner.Payment{ID: 1, SessionID: 1, Amount: gofakeit.Price(10, 200), PaidAt: checkedOutAt},
+						nil)
+					return mRepository
+				},
+			},
+		},
+		{
+			name: "Failed to checkout Diner due to no open session",
+			args: args{
+				method:       "POST",
+				endpoint:     "/v1/diners/1/checkout",
+				outputStatus: http.StatusNotFound,
+				mockrepoFn: func() repository.Diners {
+					mRepository := mockRepository.NewMockDiners(gomock.NewController(t))
+					mRepository.EXPECT().Checkout(gomock.Any(), gomock.Any()).AnyTimes().Return(nil, nil, appErr.NewAppErrorWithType(appErr.NotFound))
+					return mRepository
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -211,10 +464,91 @@ func TestDinerRoutes(t *testing.T) {
 			router, routerV1 := getTestRouter()
 			routes.DinerRoutes(routerV1, &dinerController.Controller{DinerService: dinerService.Service{DinerRepository: tt.args.mockrepoFn()}})
 			router.ServeHTTP(rr, req)
+			assertContract(t, req, rr)
+
+			if status := rr.Code; status != tt.args.outputStatus {
+				t.Errorf("Handler returned wrong status code. Expected: %d. Got: %d.", tt.args.outputStatus, status)
+			}
+		})
+	}
+}
+
+func TestDinerCheckoutSettlement(t *testing.T) {
+
+	checkedOutAt := time.Now()
+	session := domainDiner.Session{ID: 1, DinerID: 1, CustomerID: 7, TableNumber: 2, CheckedInAt: checkedOutAt.Add(-time.Hour), CheckedOutAt: &checkedOutAt}
+	payment := domainDiner.Payment{ID: 1, SessionID: 1, Subtotal: 400, Amount: 400, PaidAt: checkedOutAt}
+	type args struct {
+		accrueErr       error
+		outputStatus    int
+		outputCommits   int
+		outputRollbacks int
+	}
+
+	tests := []struct {
+		name string
+		args args
+	}{
+		{
+			name: "Checkout Diner with the loyalty points credited in the same transaction",
+			args: args{
+				outputStatus:  http.StatusCreated,
+				outputCommits: 1,
+			},
+		},
+		{
+			name: "Checkout Diner rolled back due to the loyalty points not credited",
+			args: args{
+				accrueErr:       appErr.NewAppErrorWithType(appErr.RepositoryError),
+				outputStatus:    http.StatusInternalServerError,
+				outputRollbacks: 1,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dRepository := mockRepository.NewMockDiners(gomock.NewController(t))
+			dRepository.EXPECT().Checkout(gomock.Any(), int64(1)).Times(1).
+				DoAndReturn(func(ctx context.Context, _ int64) (*domainDiner.Session, *domainDiner.Payment, error) {
+					if !inTransaction(ctx) {
+						t.Errorf("Diner checked out outside of the transaction")
+					}
+					checkedOut, paid := session, payment
+					return &checkedOut, &paid, nil
+				})
+			cRepository := mockRepository.NewMockCustomers(gomock.NewController(t))
+			cRepository.EXPECT().GetBalance(gomock.Any(), int64(7)).Times(1).Return(&domainCustomer.Balance{CustomerID: 7}, nil)
+			cRepository.EXPECT().Accrue(gomock.Any(), gomock.Any()).Times(1).
+				DoAndReturn(func(ctx context.Context, entry *domainCustomer.LedgerEntry) (*domainCustomer.LedgerEntry, error) {
+					if !inTransaction(ctx) || entry.SessionID != session.ID || entry.Points <= 0 {
+						t.Errorf("Loyalty points credited wrongly: %+v, in the transaction: %t", entry, inTransaction(ctx))
+					}
+					return entry, tt.args.accrueErr
+				})
+			transactor := &fakeTransactor{}
+
+			req, err := http.NewRequest("POST", "/v1/diners/1/checkout", nil)
+			if err != nil {
+				t.Errorf("Error creating a new request: %v", err)
+			}
+			rr := httptest.NewRecorder()
+			router, routerV1 := getTestRouter()
+			routes.DinerRoutes(routerV1, &dinerController.Controller{DinerService: dinerService.Service{
+				DinerRepository: dRepository,
+				BillSettler:     &customerService.Service{CustomerRepository: cRepository},
+				Transactor:      transactor,
+			}})
+			router.ServeHTTP(rr, req)
+			assertContract(t, req, rr)
 
 			if status := rr.Code; status != tt.args.outputStatus {
 				t.Errorf("Handler returned wrong status code. Expected: %d. Got: %d.", tt.args.outputStatus, status)
 			}
+			if transactor.commits != tt.args.outputCommits || transactor.rollbacks != tt.args.outputRollbacks {
+				t.Errorf("Handler ended the transaction wrongly. Expected: %d commits, %d rollbacks. Got: %d commits, %d rollbacks.",
+					tt.args.outputCommits, tt.args.outputRollbacks, transactor.commits, transactor.rollbacks)
+			}
 		})
 	}
 }
@@ -0,0 +1,181 @@
+package routes_test
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	menuService "github.com/Raj63/golang-rest-api/pkg/app/usecases/menu"
+	domainMenu "github.com/Raj63/golang-rest-api/pkg/domain/menu"
+	mockRepository "github.com/Raj63/golang-rest-api/pkg/infrastructure/mocks/repository"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/repository"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers"
+	errorsController "github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers/errors"
+	menuController "github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers/menu"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/routes"
+	"github.com/golang/mock/gomock"
+)
+
+func TestErrorProblemDetails(t *testing.T) {
+
+	type args struct {
+		method         string
+		endpoint       string
+		body           string
+		requestID      string
+		mockrepoFn     func() repository.Menus
+		outputStatus   int
+		outputCode     string
+		outputInstance string
+		// outputFields are the invalid fields of the problem, in order
+		outputFields []string
+		// outputTraceID is the expected trace id, any non empty one when empty
+		outputTraceID string
+	}
+	noMocks := func() repository.Menus {
+		return mockRepository.NewMockMenus(gomock.NewController(t))
+	}
+
+	tests := []struct {
+		name string
+		args args
+	}{
+		{
+			name: "Missing row not wrapped in an application error is a not found problem",
+			args: args{
+				method:   "GET",
+				endpoint: "/v1/menus/9",
+				mockrepoFn: func() repository.Menus {
+					mRepository := mockRepository.NewMockMenus(gomock.NewController(t))
+					mRepository.EXPECT().GetByID(gomock.Any(), int64(9)).Times(1).Return(nil, sql.ErrNoRows)
+					return mRepository
+				},
+				outputStatus:   http.StatusNotFound,
+				outputCode:     errorsController.CodeNotFound,
+				outputInstance: "/v1/menus/9",
+			},
+		},
+		{
+			name: "Unknown error is an internal problem traced by the X-Request-ID",
+			args: args{
+				method:    "GET",
+				endpoint:  "/v1/menus/9",
+				requestID: "req-42",
+				mockrepoFn: func() repository.Menus {
+					mRepository := mockRepository.NewMockMenus(gomock.NewController(t))
+					mRepository.EXPECT().GetByID(gomock.Any(), int64(9)).Times(1).Return(nil, errors.New("connection refused"))
+					return mRepository
+				},
+				outputStatus:   http.StatusInternalServerError,
+				outputCode:     errorsController.CodeInternal,
+				outputInstance: "/v1/menus/9",
+				outputTraceID:  "req-42",
+			},
+		},
+		{
+			name: "Missing required fields are a validation problem keyed by field",
+			args: args{
+				method:         "POST",
+				endpoint:       "/v1/menus/",
+				body:           `{"category": "briyani"}`,
+				mockrepoFn:     noMocks,
+				outputStatus:   http.StatusBadRequest,
+				outputCode:     errorsController.CodeValidation,
+				outputInstance: "/v1/menus/",
+				outputFields:   []string{"name", "description", "price"},
+			},
+		},
+		{
+			name: "Field of the wrong type is a validation problem keyed by field",
+			args: args{
+				method:         "POST",
+				endpoint:       "/v1/menus/",
+				body:           `{"name": "Briyani", "description": "Dum", "price": "cheap"}`,
+				mockrepoFn:     noMocks,
+				outputStatus:   http.StatusBadRequest,
+				outputCode:     errorsController.CodeValidation,
+				outputInstance: "/v1/menus/",
+				outputFields:   []string{"price"},
+			},
+		},
+		{
+			name: "Unknown route is a not found problem",
+			args: args{
+				method:         "GET",
+				endpoint:       "/v1/nothing",
+				mockrepoFn:     noMocks,
+				outputStatus:   http.StatusNotFound,
+				outputCode:     errorsController.CodeNotFound,
+				outputInstance: "/v1/nothing",
+			},
+		},
+		{
+			name: "Panic of a handler is an internal problem",
+			args: args{
+				method:   "GET",
+				endpoint: "/v1/menus/export",
+				mockrepoFn: func() repository.Menus {
+					mRepository := mockRepository.NewMockMenus(gomock.NewController(t))
+					mRepository.EXPECT().Iterate(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(func(context.Context, func(*domainMenu.Menu) error) error {
+						panic("iterator broken")
+					})
+					return mRepository
+				},
+				outputStatus:   http.StatusInternalServerError,
+				outputCode:     errorsController.CodeInternal,
+				outputInstance: "/v1/menus/export",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(tt.args.method, tt.args.endpoint, bytes.NewBufferString(tt.args.body))
+			if err != nil {
+				t.Errorf("Error creating a new request: %v", err)
+			}
+			if tt.args.requestID != "" {
+				req.Header.Set("X-Request-ID", tt.args.requestID)
+			}
+			rr := httptest.NewRecorder()
+			router, routerV1 := getTestRouter()
+			routes.MenuRoutes(routerV1, &menuController.Controller{MenuService: menuService.Service{MenuRepository: tt.args.mockrepoFn()}})
+			router.ServeHTTP(rr, req)
+			assertContract(t, req, rr)
+
+			if status := rr.Code; status != tt.args.outputStatus {
+				t.Errorf("Handler returned wrong status code. Expected: %d. Got: %d.", tt.args.outputStatus, status)
+			}
+			if contentType := rr.Header().Get("Content-Type"); contentType != controllers.ProblemMIME {
+				t.Errorf("Handler returned wrong Content-Type. Expected: %s. Got: %s.", controllers.ProblemMIME, contentType)
+			}
+
+			var problem controllers.ProblemDetails
+			if err := json.Unmarshal(rr.Body.Bytes(), &problem); err != nil {
+				t.Fatalf("Handler returned invalid problem details: %v", err)
+			}
+			if problem.Status != tt.args.outputStatus || problem.Code != tt.args.outputCode || problem.Instance != tt.args.outputInstance || problem.Title == "" {
+				t.Errorf("Handler returned wrong problem details. Got: %s.", rr.Body.String())
+			}
+			if problem.TraceID == "" || (tt.args.outputTraceID != "" && problem.TraceID != tt.args.outputTraceID) {
+				t.Errorf("Handler returned wrong trace id. Expected: %q. Got: %q.", tt.args.outputTraceID, problem.TraceID)
+			}
+			fields := make([]string, len(problem.Errors))
+			for i, fieldErr := range problem.Errors {
+				fields[i] = fieldErr.Field
+			}
+			if len(fields) != len(tt.args.outputFields) {
+				t.Fatalf("Handler returned wrong fields. Expected: %v. Got: %v.", tt.args.outputFields, fields)
+			}
+			for i := range fields {
+				if fields[i] != tt.args.outputFields[i] {
+					t.Errorf("Handler returned wrong fields. Expected: %v. Got: %v.", tt.args.outputFields, fields)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,15 @@
+// Package routes contains all routes of the application
+package routes
+
+import (
+	graphqlController "github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers/graphql"
+	"github.com/gin-gonic/gin"
+)
+
+// GraphQLRoutes is a function that contains the GraphQL routes
+func GraphQLRoutes(router *gin.RouterGroup, controller *graphqlController.Controller) {
+
+	router.GET("/graphql", controller.Query)
+	router.POST("/graphql", controller.Query)
+
+}
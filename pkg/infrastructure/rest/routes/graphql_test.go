@@ -0,0 +1,222 @@
+// Package routes contains all routes of the application
+package routes_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	dinerService "github.com/Raj63/golang-rest-api/pkg/app/usecases/diner"
+	menuService "github.com/Raj63/golang-rest-api/pkg/app/usecases/menu"
+	orderService "github.com/Raj63/golang-rest-api/pkg/app/usecases/order"
+	domainDiner "github.com/Raj63/golang-rest-api/pkg/domain/diner"
+	appErr "github.com/Raj63/golang-rest-api/pkg/domain/errors"
+	domainMenu "github.com/Raj63/golang-rest-api/pkg/domain/menu"
+	domainOrder "github.com/Raj63/golang-rest-api/pkg/domain/order"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/repository"
+	graphqlController "github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers/graphql"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/routes"
+	"github.com/brianvoe/gofakeit"
+	"github.com/golang/mock/gomock"
+)
+
+func TestGraphQLRoutes(t *testing.T) {
+
+	diners := []domainDiner.Diner{
+		{ID: 1, Name: gofakeit.Name(), TableNumber: 1, CreatedAt: time.Now()},
+		{ID: 2, Name: gofakeit.Name(), TableNumber: 2, CreatedAt: time.Now()},
+	}
+	menus := []domainMenu.Menu{
+		{ID: 1, Name: gofakeit.BeerHop(), Description: gofakeit.BeerName(), Category: "briyani", Price: 200},
+		{ID: 2, Name: gofakeit.BeerHop(), Description: gofakeit.BeerName(), Category: "briyani", Price: 280.5},
+	}
+	orders := []domainOrder.Response{
+		{ID: 1, SessionID: 1, DinerID: 1, MenuID: 1, Quantity: 2, CreatedAt: time.Now()},
+		{ID: 2, SessionID: 1, DinerID: 1, MenuID: 2, Quantity: 1, CreatedAt: time.Now()},
+		{ID: 3, SessionID: 2, DinerID: 2, MenuID: 1, Quantity: 3, CreatedAt: time.Now()},
+	}
+	type args struct {
+		method       string
+		endpoint     string
+		body         interface{}
+		mockrepoFn   func() *graphqlController.Controller
+		outputStatus int
+		outputErrors bool
+	}
+	controller := func(r *repositoryMocks) *graphqlController.Controller {
+		return &graphqlController.Controller{
+			MenuService:  menuService.Service{MenuRepository: r.menus},
+			DinerService: dinerService.Service{DinerRepository: r.diners},
+			OrderService: orderService.Service{OrderRepository: r.orders},
+		}
+	}
+
+	tests := []struct {
+		name string
+		args args
+	}{
+		{
+			name: "Fetch Diners with their Orders and Menus in one batch per level successfully",
+			args: args{
+				method:   "POST",
+				endpoint: "/graphql",
+				body: graphqlController.Request{
+					Query: `{ diners(limit: 10) { nodes { name orders { quantity menu { name price } } } pageInfo { total } } }`,
+				},
+				outputStatus: http.StatusOK,
+				mockrepoFn: mocked(t, controller, func(r *repositoryMocks) {
+					r.diners.EXPECT().GetAll(gomock.Any(), int64(1), int64(10)).Times(1).Return(&repository.PaginationResultDiner{
+						Data: &diners, Total: 2, Limit: 10, Current: 1, NumPages: 1,
+					}, nil)
+					r.orders.EXPECT().GetByDinerIDs(gomock.Any(), []int64{1, 2}, false).Times(1).Return(orders, nil)
+					r.menus.EXPECT().GetByIDs(gomock.Any(), []int64{1, 2}).Times(1).Return(menus, nil)
+				}),
+			},
+		},
+		{
+			name: "Fetch a Diner with their Order history by query params successfully",
+			args: args{
+				method:       "GET",
+				endpoint:     "/graphql?query=" + url.QueryEscape(`query($id: ID!) { diner(id: $id) { name orders(history: true) { id diner { name } } } }`) + "&variables=" + url.QueryEscape(`{"id": "1"}`),
+				outputStatus: http.StatusOK,
+				mockrepoFn: mocked(t, controller, func(r *repositoryMocks) {
+					r.diners.EXPECT().GetByIDs(gomock.Any(), []int64{1}).Times(1).Return(diners[:1], nil)
+					r.orders.EXPECT().GetByDinerIDs(gomock.Any(), []int64{1}, true).Times(1).Return(orders[:2], nil)
+				}),
+			},
+		},
+		{
+			name: "Create a Menu by mutation successfully",
+			args: args{
+				method:   "POST",
+				endpoint: "/graphql",
+				body: graphqlController.Request{
+					Query:     `mutation($name: String!) { createMenu(name: $name, description: "Hyderabadi Chicken Dum Briyani", price: 200.5) { id category } }`,
+					Variables: map[string]interface{}{"name": menus[0].Name},
+				},
+				outputStatus: http.StatusOK,
+				mockrepoFn: mocked(t, controller, func(r *repositoryMocks) {
+					r.menus.EXPECT().Create(gomock.Any(), gomock.Any()).Times(1).Return(&menus[0], nil)
+				}),
+			},
+		},
+		{
+			name: "Failed to create an Order by mutation for a Diner without open session",
+			args: args{
+				method:   "POST",
+				endpoint: "/graphql",
+				body: graphqlController.Request{
+					Query: `mutation { createOrder(dinerId: 1, menuId: 1, quantity: 2) { id } }`,
+				},
+				outputStatus: http.StatusOK,
+				outputErrors: true,
+				mockrepoFn: mocked(t, controller, func(r *repositoryMocks) {
+					r.orders.EXPECT().Create(gomock.Any(), gomock.Any()).Times(1).Return(nil, appErr.NewAppErrorWithType(appErr.ValidationError))
+				}),
+			},
+		},
+		{
+			name: "Failed to create an Order by mutation for too many items",
+			args: args{
+				method:   "POST",
+				endpoint: "/graphql",
+				body: graphqlController.Request{
+					Query: `mutation { createOrder(dinerId: 1, menuId: 1, quantity: 51) { id } }`,
+				},
+				outputStatus: http.StatusOK,
+				outputErrors: true,
+				mockrepoFn:   mocked(t, controller, noExpectations),
+			},
+		},
+		{
+			name: "Failed to create a Menu by mutation with a price in fractions of cents",
+			args: args{
+				method:   "POST",
+				endpoint: "/graphql",
+				body: graphqlController.Request{
+					Query: `mutation { createMenu(name: "HCDB", description: "Hyderabadi Chicken Dum Briyani", price: 200.505) { id } }`,
+				},
+				outputStatus: http.StatusOK,
+				outputErrors: true,
+				mockrepoFn:   mocked(t, controller, noExpectations),
+			},
+		},
+		{
+			name: "Failed to fetch Menus due to repository error",
+			args: args{
+				method:   "POST",
+				endpoint: "/graphql",
+				body: graphqlController.Request{
+					Query: `{ menus { nodes { name } } }`,
+				},
+				outputStatus: http.StatusOK,
+				outputErrors: true,
+				mockrepoFn: mocked(t, controller, func(r *repositoryMocks) {
+					r.menus.EXPECT().GetAll(gomock.Any(), int64(1), int64(20)).Times(1).Return(nil, appErr.NewAppErrorWithType(appErr.RepositoryError))
+				}),
+			},
+		},
+		{
+			name: "Failed to run a query with an unknown field",
+			args: args{
+				method:   "POST",
+				endpoint: "/graphql",
+				body: graphqlController.Request{
+					Query: `{ tables { number } }`,
+				},
+				outputStatus: http.StatusOK,
+				outputErrors: true,
+				mockrepoFn:   mocked(t, controller, func(r *repositoryMocks) {}),
+			},
+		},
+		{
+			name: "Failed to run a query due to missing query",
+			args: args{
+				method:       "POST",
+				endpoint:     "/graphql",
+				body:         map[string]interface{}{"variables": map[string]interface{}{}},
+				outputStatus: http.StatusBadRequest,
+				mockrepoFn:   mocked(t, controller, func(r *repositoryMocks) {}),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if tt.args.body != nil {
+				err := json.NewEncoder(&buf).Encode(tt.args.body)
+				if err != nil {
+					log.Fatal(err)
+				}
+			}
+
+			req, err := http.NewRequest(tt.args.method, tt.args.endpoint, &buf)
+			if err != nil {
+				t.Errorf("Error creating a new request: %v", err)
+			}
+			rr := httptest.NewRecorder()
+			router, _ := getTestRouter()
+			routes.GraphQLRoutes(&router.RouterGroup, tt.args.mockrepoFn())
+			router.ServeHTTP(rr, req)
+
+			if status := rr.Code; status != tt.args.outputStatus {
+				t.Errorf("Handler returned wrong status code. Expected: %d. Got: %d.", tt.args.outputStatus, status)
+			}
+			if tt.args.outputStatus == http.StatusOK {
+				var response graphqlController.Response
+				if err := json.Unmarshal(rr.Body.Bytes(), &response); err != nil {
+					t.Errorf("Handler returned an invalid body: %v", err)
+				}
+				if hasErrors := len(response.Errors) > 0; hasErrors != tt.args.outputErrors {
+					t.Errorf("Handler returned wrong errors. Expected errors: %t. Got: %v.", tt.args.outputErrors, response.Errors)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package routes_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mockRepository "github.com/Raj63/golang-rest-api/pkg/infrastructure/mocks/repository"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/golang/mock/gomock"
+)
+
+// testJWTSecret is the secret the auth middleware of the route tests checks the tokens with
+const testJWTSecret = "route-tests-secret"
+
+// repositoryMocks are the mocked repositories of a route test case, for the case to set its expectations on
+type repositoryMocks struct {
+	menus     *mockRepository.MockMenus
+	diners    *mockRepository.MockDiners
+	orders    *mockRepository.MockOrders
+	customers *mockRepository.MockCustomers
+	privacy   *mockRepository.MockPrivacyRequests
+}
+
+// mocked returns the mockrepoFn of a case that needs several repositories: build sets up what the routes are given
+// from the mocked repositories, once expect has set the expectations of the case on them
+func mocked[T any](t *testing.T, build func(r *repositoryMocks) T, expect func(r *repositoryMocks)) func() T {
+	return func() T {
+		r := &repositoryMocks{
+			menus:     mockRepository.NewMockMenus(gomock.NewController(t)),
+			diners:    mockRepository.NewMockDiners(gomock.NewController(t)),
+			orders:    mockRepository.NewMockOrders(gomock.NewController(t)),
+			customers: mockRepository.NewMockCustomers(gomock.NewController(t)),
+			privacy:   mockRepository.NewMockPrivacyRequests(gomock.NewController(t)),
+		}
+		expect(r)
+		return build(r)
+	}
+}
+
+// noExpectations is the expect of the cases that reach none of the repositories
+func noExpectations(*repositoryMocks) {}
+
+type fakeTxKey struct{}
+
+// fakeTransactor runs functions in a fake transaction, committed when they succeed and rolled back otherwise, carried
+// by the context as a database transaction would be
+type fakeTransactor struct {
+	err       error
+	commits   int
+	rollbacks int
+}
+
+func (tx *fakeTransactor) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if tx.err != nil {
+		return tx.err
+	}
+	if err := fn(context.WithValue(ctx, fakeTxKey{}, tx)); err != nil {
+		tx.rollbacks++
+		return err
+	}
+	tx.commits++
+	return nil
+}
+
+// inTransaction tells whether the context carries the transaction of a fakeTransactor
+func inTransaction(ctx context.Context) bool {
+	_, ok := ctx.Value(fakeTxKey{}).(*fakeTransactor)
+	return ok
+}
+
+// adminToken returns a JWT token of subject signed with testJWTSecret, valid for an hour
+func adminToken(t *testing.T, subject string) string {
+	return signedToken(t, jwt.SigningMethodHS256, jwt.RegisteredClaims{
+		Subject:   subject,
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	}, []byte(testJWTSecret))
+}
+
+// signedToken returns a JWT token of claims signed by method with key
+func signedToken(t *testing.T, method jwt.SigningMethod, claims jwt.Claims, key interface{}) string {
+	token, err := jwt.NewWithClaims(method, claims).SignedString(key)
+	if err != nil {
+		t.Fatalf("Error signing the token: %v", err)
+	}
+	return token
+}
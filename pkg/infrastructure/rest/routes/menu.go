@@ -3,6 +3,7 @@ package routes
 
 import (
 	menuController "github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers/menu"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/middlewares"
 	"github.com/gin-gonic/gin"
 )
 
@@ -12,10 +13,15 @@ func MenuRoutes(router *gin.RouterGroup, controller *menuController.Controller)
 	routerMenu := router.Group("/menus")
 	{
 		routerMenu.POST("/", controller.NewMenu)
-		routerMenu.GET("/:id", controller.GetMenusByID)
-		routerMenu.GET("/top", controller.GetTopMenus)
-		routerMenu.GET("/", controller.GetAllMenus)
+		routerMenu.POST("/import", controller.ImportMenus)
+		routerMenu.GET("/:id", middlewares.ConditionalGET(middlewares.CachePublic), controller.GetMenusByID)
+		routerMenu.GET("/top", middlewares.ConditionalGET(middlewares.CachePublic), controller.GetTopMenus)
+		routerMenu.GET("/export", controller.ExportMenus)
+		routerMenu.GET("/", middlewares.ConditionalGET(middlewares.CachePublic), controller.GetAllMenus)
 		routerMenu.DELETE("/:id", controller.DeleteMenu)
+		routerMenu.GET("/:id/translations", controller.GetTranslations)
+		routerMenu.PUT("/:id/translations/:language", controller.PutTranslation)
+		routerMenu.DELETE("/:id/translations/:language", controller.DeleteTranslation)
 	}
 
 }
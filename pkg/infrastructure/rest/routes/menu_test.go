@@ -3,7 +3,11 @@ package routes_test
 
 import (
 	"bytes"
+	"context"
+	"crypto/sha256"
 	"encoding/json"
+	"encoding/xml"
+	"fmt"
 	"log"
 	"net/http"
 	"net/http/httptest"
@@ -18,27 +22,67 @@ import (
 	"github.com/Raj63/golang-rest-api/pkg/infrastructure/repository"
 	menuController "github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers/menu"
 	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/routes"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rpc/mappers"
 	"github.com/brianvoe/gofakeit"
 	"github.com/golang/mock/gomock"
+	"google.golang.org/protobuf/proto"
 )
 
 func TestMenuRoutes(t *testing.T) {
 
 	menuName := gofakeit.BeerHop()
 	menuDesc1 := gofakeit.BeerName()
-	menuPrice1 := gofakeit.Float64()
+	menuPrice1 := gofakeit.Price(1, 1000)
 	menuName2 := gofakeit.BeerHop()
 	menuDesc2 := gofakeit.BeerName()
-	menuPrice2 := gofakeit.Float64()
+	menuPrice2 := gofakeit.Price(1, 1000)
 	menuName3 := gofakeit.BeerHop()
 	menuDesc3 := gofakeit.BeerName()
-	menuPrice3 := gofakeit.Float64()
+	menuPrice3 := gofakeit.Price(1, 1000)
+	exportedAt := time.Date(2026, 10, 19, 12, 30, 0, 0, time.UTC)
+	exportMenus := func(menus ...domainMenu.Menu) func() repository.Menus {
+		return func() repository.Menus {
+			mRepository := mockRepository.NewMockMenus(gomock.NewController(t))
+			mRepository.EXPECT().Iterate(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(func(_ context.Context, fn func(menu *domainMenu.Menu) error) error {
+				for i := range menus {
+					if err := fn(&menus[i]); err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+			return mRepository
+		}
+	}
+	updatedMenu := domainMenu.Menu{ID: 1, Name: "Paneer Tikka", Description: "Grilled cottage cheese", Category: "starters", Price: 120.5, CreatedAt: exportedAt, UpdatedAt: exportedAt}
+	updatedMenuJSON, _ := json.Marshal(updatedMenu)
+	updatedMenuETag := fmt.Sprintf(`"%x"`, sha256.Sum256(updatedMenuJSON))
+	updatedMenuXML, _ := xml.Marshal(updatedMenu)
+	updatedMenuProto, _ := proto.Marshal(mappers.Menu(&updatedMenu))
+	manyMenus := make([]domainMenu.Menu, 30)
+	for i := range manyMenus {
+		manyMenus[i] = updatedMenu
+		manyMenus[i].ID = int64(i + 1)
+	}
+	getUpdatedMenu := func() repository.Menus {
+		mRepository := mockRepository.NewMockMenus(gomock.NewController(t))
+		mRepository.EXPECT().GetByID(gomock.Any(), int64(1)).Times(1).Return(&updatedMenu, nil)
+		return mRepository
+	}
+	nextCursor := repository.Cursor{Keys: []string{menuName2}, ID: 2}.Encode()
+	prevCursor := repository.Cursor{Keys: []string{menuName}, ID: 1, Backward: true}.Encode()
 	type args struct {
-		method       string
-		endpoint     string
-		body         interface{}
-		mockrepoFn   func() repository.Menus
-		outputStatus int
+		method        string
+		endpoint      string
+		body          interface{}
+		contentType   string
+		accept        string
+		headers       map[string]string
+		mockrepoFn    func() repository.Menus
+		outputStatus  int
+		outputLink    string
+		outputBody    string
+		outputHeaders map[string]string
 	}
 
 	tests := []struct {
@@ -135,6 +179,284 @@ func TestMenuRoutes(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Fetch the first keyset page of Menus with Link header successfully",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v1/menus/?limit=2",
+				outputStatus: http.StatusOK,
+				outputLink:   `</v1/menus/?limit=2>; rel="first", </v1/menus/?cursor=` + nextCursor + `&limit=2>; rel="next"`,
+				mockrepoFn: func() repository.Menus {
+					mRepository := mockRepository.NewMockMenus(gomock.NewController(t))
+					mRepository.EXPECT().GetPage(gomock.Any(), &repository.PageRequest{Limit: 2}).Times(1).Return(&repository.PaginationResultMenu{
+						Data: &[]domainMenu.Menu{
+							{ID: 1, Name: menuName, Description: menuDesc1, Price: menuPrice1},
+							{ID: 2, Name: menuName2, Description: menuDesc2, Price: menuPrice2},
+						},
+						Limit:      2,
+						NextCursor: nextCursor,
+					}, nil)
+					return mRepository
+				},
+			},
+		},
+		{
+			name: "Fetch the next keyset page of Menus with total successfully",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v1/menus/?limit=2&with_total=true&cursor=" + nextCursor,
+				outputStatus: http.StatusOK,
+				outputLink:   `</v1/menus/?limit=2&with_total=true>; rel="first", </v1/menus/?cursor=` + prevCursor + `&limit=2&with_total=true>; rel="prev"`,
+				mockrepoFn: func() repository.Menus {
+					mRepository := mockRepository.NewMockMenus(gomock.NewController(t))
+					mRepository.EXPECT().GetPage(gomock.Any(), &repository.PageRequest{
+						Cursor:    &repository.Cursor{Keys: []string{menuName2}, ID: 2},
+						Limit:     2,
+						WithTotal: true,
+					}).Times(1).Return(&repository.PaginationResultMenu{
+						Data:       &[]domainMenu.Menu{{ID: 3, Name: menuName3, Description: menuDesc3, Price: menuPrice3}},
+						Total:      3,
+						Limit:      2,
+						NumPages:   2,
+						PrevCursor: prevCursor,
+					}, nil)
+					return mRepository
+				},
+			},
+		},
+		{
+			name: "Failed to fetch Menus with an invalid cursor",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v1/menus/?cursor=not-a-cursor",
+				outputStatus: http.StatusBadRequest,
+				mockrepoFn: func() repository.Menus {
+					return mockRepository.NewMockMenus(gomock.NewController(t))
+				},
+			},
+		},
+		{
+			name: "Failed to fetch Menus with a limit above the maximum",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v1/menus/?limit=500",
+				outputStatus: http.StatusBadRequest,
+				mockrepoFn: func() repository.Menus {
+					return mockRepository.NewMockMenus(gomock.NewController(t))
+				},
+			},
+		},
+		{
+			name: "Fetch a page number of Menus sorted by price and trimmed to fields successfully",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v1/menus/?page=2&limit=2&sort=-price,name&fields=id,price",
+				outputStatus: http.StatusOK,
+				mockrepoFn: func() repository.Menus {
+					mRepository := mockRepository.NewMockMenus(gomock.NewController(t))
+					mRepository.EXPECT().GetPage(gomock.Any(), &repository.PageRequest{
+						Offset:    2,
+						Limit:     2,
+						Sort:      []repository.SortField{{Field: "price", Desc: true}, {Field: "name"}},
+						WithTotal: true,
+					}).Times(1).Return(&repository.PaginationResultMenu{
+						Data:     &[]domainMenu.Menu{{ID: 3, Name: menuName3, Description: menuDesc3, Price: menuPrice3}},
+						Total:    3,
+						Limit:    2,
+						Current:  2,
+						NumPages: 2,
+					}, nil)
+					return mRepository
+				},
+			},
+		},
+		{
+			name: "Failed to fetch Menus sorted by an unknown field",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v1/menus/?sort=-password",
+				outputStatus: http.StatusBadRequest,
+				mockrepoFn: func() repository.Menus {
+					return mockRepository.NewMockMenus(gomock.NewController(t))
+				},
+			},
+		},
+		{
+			name: "Failed to fetch Menus trimmed to an unknown field",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v1/menus/?fields=id,secret",
+				outputStatus: http.StatusBadRequest,
+				mockrepoFn: func() repository.Menus {
+					return mockRepository.NewMockMenus(gomock.NewController(t))
+				},
+			},
+		},
+		{
+			name: "Failed to fetch Menus with a cursor of another sort",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v1/menus/?sort=-price&cursor=" + nextCursor,
+				outputStatus: http.StatusBadRequest,
+				mockrepoFn: func() repository.Menus {
+					return mockRepository.NewMockMenus(gomock.NewController(t))
+				},
+			},
+		},
+		{
+			name: "Dry run a CSV import of Menus successfully",
+			args: args{
+				method:       "POST",
+				endpoint:     "/v1/menus/import?dry_run=true",
+				body:         "name,description,category,price\nPaneer Tikka,Grilled cottage cheese,Starters,120.50\n\"Dosa, Masala\",Rice crepe,,80\n",
+				contentType:  "text/csv",
+				outputStatus: http.StatusOK,
+				mockrepoFn: func() repository.Menus {
+					mRepository := mockRepository.NewMockMenus(gomock.NewController(t))
+					mRepository.EXPECT().GetByNames(gomock.Any(), []string{"Paneer Tikka", "Dosa, Masala"}).Times(1).Return([]domainMenu.Menu{
+						{ID: 1, Name: "paneer tikka", Description: "Grilled cottage cheese", Category: "starters", Price: 100},
+					}, nil)
+					mRepository.EXPECT().Upsert(gomock.Any(), gomock.Any()).Times(0)
+					return mRepository
+				},
+			},
+		},
+		{
+			name: "Import a JSON array of Menus successfully",
+			args: args{
+				method:   "POST",
+				endpoint: "/v1/menus/import",
+				body: []menuController.NewMenuRequest{
+					{Name: menuName, Description: menuDesc1, Category: "Starters", Price: 120.5},
+					{Name: menuName2, Description: menuDesc2, Price: 80},
+				},
+				outputStatus: http.StatusOK,
+				mockrepoFn: func() repository.Menus {
+					mRepository := mockRepository.NewMockMenus(gomock.NewController(t))
+					mRepository.EXPECT().Upsert(gomock.Any(), []domainMenu.Menu{
+						{Name: menuName, Description: menuDesc1, Category: "starters", Price: 120.5},
+						{Name: menuName2, Description: menuDesc2, Category: domainMenu.DefaultCategory, Price: 80},
+					}).Times(1).Return(&repository.UpsertResultMenu{Created: 1, Updated: 1}, nil)
+					return mRepository
+				},
+			},
+		},
+		{
+			name: "Failed to import Menus with invalid rows",
+			args: args{
+				method:       "POST",
+				endpoint:     "/v1/menus/import",
+				body:         "name,description,price\nPaneer Tikka,Grilled cottage cheese,free\npaneer tikka,,-1\n",
+				contentType:  "text/csv",
+				outputStatus: http.StatusBadRequest,
+				mockrepoFn: func() repository.Menus {
+					mRepository := mockRepository.NewMockMenus(gomock.NewController(t))
+					mRepository.EXPECT().GetByNames(gomock.Any(), gomock.Any()).Times(1).Return(nil, nil)
+					mRepository.EXPECT().Upsert(gomock.Any(), gomock.Any()).Times(0)
+					return mRepository
+				},
+			},
+		},
+		{
+			name: "Failed to import Menus due to a CSV without a price column",
+			args: args{
+				method:       "POST",
+				endpoint:     "/v1/menus/import",
+				body:         "name,description\nPaneer Tikka,Grilled cottage cheese\n",
+				contentType:  "text/csv",
+				outputStatus: http.StatusBadRequest,
+				mockrepoFn: func() repository.Menus {
+					return mockRepository.NewMockMenus(gomock.NewController(t))
+				},
+			},
+		},
+		{
+			name: "Failed to import Menus of an unsupported content type",
+			args: args{
+				method:       "POST",
+				endpoint:     "/v1/menus/import",
+				body:         "<menus/>",
+				contentType:  "application/xml",
+				outputStatus: http.StatusBadRequest,
+				mockrepoFn: func() repository.Menus {
+					return mockRepository.NewMockMenus(gomock.NewController(t))
+				},
+			},
+		},
+		{
+			name: "Failed to import Menus due to repository error",
+			args: args{
+				method:       "POST",
+				endpoint:     "/v1/menus/import",
+				body:         []menuController.NewMenuRequest{{Name: menuName, Description: menuDesc1, Price: 10}},
+				outputStatus: http.StatusInternalServerError,
+				mockrepoFn: func() repository.Menus {
+					mRepository := mockRepository.NewMockMenus(gomock.NewController(t))
+					mRepository.EXPECT().Upsert(gomock.Any(), gomock.Any()).Times(1).Return(nil, appErr.NewAppErrorWithType(appErr.RepositoryError))
+					return mRepository
+				},
+			},
+		},
+		{
+			name: "Export Menus as CSV successfully",
+			args: args{
+				method:   "GET",
+				endpoint: "/v1/menus/export?format=csv",
+				mockrepoFn: exportMenus(
+					domainMenu.Menu{ID: 2, Name: "Dosa, Masala", Description: "Rice crepe", Category: "mains", Price: 80, CreatedAt: exportedAt, UpdatedAt: exportedAt},
+					domainMenu.Menu{ID: 1, Name: "Paneer Tikka", Description: "Grilled cottage cheese", Category: "starters", Price: 120.5, CreatedAt: exportedAt, UpdatedAt: exportedAt},
+				),
+				outputStatus: http.StatusOK,
+				outputBody: "id,name,description,category,price,created_at,updated_at\n" +
+					"2,\"Dosa, Masala\",Rice crepe,mains,80.00,2026-10-19T12:30:00Z,2026-10-19T12:30:00Z\n" +
+					"1,Paneer Tikka,Grilled cottage cheese,starters,120.50,2026-10-19T12:30:00Z,2026-10-19T12:30:00Z\n",
+			},
+		},
+		{
+			name: "Export Menus as NDJSON negotiated by the Accept header successfully",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v1/menus/export",
+				accept:       "application/x-ndjson",
+				mockrepoFn:   exportMenus(domainMenu.Menu{ID: 1, Name: "Paneer Tikka", Description: "Grilled cottage cheese", Category: "starters", Price: 120.5, CreatedAt: exportedAt, UpdatedAt: exportedAt}),
+				outputStatus: http.StatusOK,
+				outputBody:   `{"id":1,"name":"Paneer Tikka","description":"Grilled cottage cheese","category":"starters","price":120.5,"created_at":"2026-10-19T12:30:00Z","updated_at":"2026-10-19T12:30:00Z"}` + "\n",
+			},
+		},
+		{
+			name: "Export no Menus as YAML successfully",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v1/menus/export?format=yaml",
+				mockrepoFn:   exportMenus(),
+				outputStatus: http.StatusOK,
+				outputBody:   "[]\n",
+			},
+		},
+		{
+			name: "Failed to export Menus in an unknown format",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v1/menus/export?format=xlsx",
+				outputStatus: http.StatusBadRequest,
+				mockrepoFn: func() repository.Menus {
+					return mockRepository.NewMockMenus(gomock.NewController(t))
+				},
+			},
+		},
+		{
+			name: "Failed to export Menus due to repository error",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v1/menus/export",
+				outputStatus: http.StatusInternalServerError,
+				mockrepoFn: func() repository.Menus {
+					mRepository := mockRepository.NewMockMenus(gomock.NewController(t))
+					mRepository.EXPECT().Iterate(gomock.Any(), gomock.Any()).Times(1).Return(appErr.NewAppErrorWithType(appErr.RepositoryError))
+					return mRepository
+				},
+			},
+		},
 		{
 			name: "Fetch Menu top 3 List successfully",
 			args: args{
@@ -188,6 +510,61 @@ func TestMenuRoutes(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Fetch Menu top 3 by revenue per category within a date window successfully",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v1/menus/top?count=3&from=2026-01-01&to=2026-01-31&rank_by=revenue&group_by=category",
+				outputStatus: http.StatusOK,
+				mockrepoFn: func() repository.Menus {
+					mRepository := mockRepository.NewMockMenus(gomock.NewController(t))
+					mRepository.EXPECT().GetByTopCount(gomock.Any(), gomock.Any()).AnyTimes().Return([]domainMenu.Menu{
+						{
+							ID:       gofakeit.Int64(),
+							Name:     menuName,
+							Category: "briyani",
+							Price:    menuPrice1,
+							Count:    4,
+							Revenue:  menuPrice1 * 4,
+						},
+					}, nil)
+					return mRepository
+				},
+			},
+		},
+		{
+			name: "Failed to fetch Menu top 3 List due to invalid date window",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v1/menus/top?count=3&from=2026-02-01&to=2026-01-01",
+				outputStatus: http.StatusBadRequest,
+				mockrepoFn: func() repository.Menus {
+					return mockRepository.NewMockMenus(gomock.NewController(t))
+				},
+			},
+		},
+		{
+			name: "Failed to fetch Menu top 3 List due to invalid date",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v1/menus/top?count=3&from=01-02-2026",
+				outputStatus: http.StatusBadRequest,
+				mockrepoFn: func() repository.Menus {
+					return mockRepository.NewMockMenus(gomock.NewController(t))
+				},
+			},
+		},
+		{
+			name: "Failed to fetch Menu top 3 List due to unknown ranking",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v1/menus/top?count=3&rank_by=margin",
+				outputStatus: http.StatusBadRequest,
+				mockrepoFn: func() repository.Menus {
+					return mockRepository.NewMockMenus(gomock.NewController(t))
+				},
+			},
+		},
 		{
 			name: "Fetch Menu by ID successfully",
 			args: args{
@@ -221,6 +598,138 @@ func TestMenuRoutes(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Fetch Menu by ID with its ETag and Last-Modified successfully",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v1/menus/1",
+				outputStatus: http.StatusOK,
+				mockrepoFn:   getUpdatedMenu,
+				outputHeaders: map[string]string{
+					"ETag":          updatedMenuETag,
+					"Last-Modified": "Mon, 19 Oct 2026 12:30:00 GMT",
+					"Cache-Control": "public, max-age=60",
+				},
+			},
+		},
+		{
+			name: "Fetch an unchanged Menu by ID with If-None-Match successfully",
+			args: args{
+				method:        "GET",
+				endpoint:      "/v1/menus/1",
+				headers:       map[string]string{"If-None-Match": `"stale", ` + updatedMenuETag},
+				outputStatus:  http.StatusNotModified,
+				mockrepoFn:    getUpdatedMenu,
+				outputHeaders: map[string]string{"ETag": updatedMenuETag},
+			},
+		},
+		{
+			name: "Fetch an unchanged Menu by ID with If-Modified-Since successfully",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v1/menus/1",
+				headers:      map[string]string{"If-Modified-Since": "Mon, 19 Oct 2026 12:30:00 GMT"},
+				outputStatus: http.StatusNotModified,
+				mockrepoFn:   getUpdatedMenu,
+			},
+		},
+		{
+			name: "Fetch a changed Menu by ID whose If-None-Match overrides If-Modified-Since successfully",
+			args: args{
+				method:   "GET",
+				endpoint: "/v1/menus/1",
+				headers: map[string]string{
+					"If-None-Match":     `"stale"`,
+					"If-Modified-Since": "Mon, 19 Oct 2026 12:30:00 GMT",
+				},
+				outputStatus: http.StatusOK,
+				mockrepoFn:   getUpdatedMenu,
+				outputBody:   string(updatedMenuJSON),
+			},
+		},
+		{
+			name: "Failed to fetch Menu by ID without an ETag due to repository error",
+			args: args{
+				method:        "GET",
+				endpoint:      "/v1/menus/1",
+				outputStatus:  http.StatusInternalServerError,
+				outputHeaders: map[string]string{"ETag": ""},
+				mockrepoFn: func() repository.Menus {
+					mRepository := mockRepository.NewMockMenus(gomock.NewController(t))
+					mRepository.EXPECT().GetByID(gomock.Any(), int64(1)).Times(1).Return(nil, appErr.NewAppErrorWithType(appErr.RepositoryError))
+					return mRepository
+				},
+			},
+		},
+		{
+			name: "Fetch Menu by ID as protobuf successfully",
+			args: args{
+				method:        "GET",
+				endpoint:      "/v1/menus/1",
+				accept:        "application/x-protobuf",
+				outputStatus:  http.StatusOK,
+				mockrepoFn:    getUpdatedMenu,
+				outputBody:    string(updatedMenuProto),
+				outputHeaders: map[string]string{"Content-Type": "application/x-protobuf"},
+			},
+		},
+		{
+			name: "Fetch Menu by ID as XML successfully",
+			args: args{
+				method:        "GET",
+				endpoint:      "/v1/menus/1",
+				accept:        "application/xml;q=0.9, application/json;q=0.5",
+				outputStatus:  http.StatusOK,
+				mockrepoFn:    getUpdatedMenu,
+				outputBody:    xml.Header + string(updatedMenuXML),
+				outputHeaders: map[string]string{"Content-Type": "application/xml; charset=utf-8"},
+			},
+		},
+		{
+			name: "Fetch Menu by ID as MessagePack successfully",
+			args: args{
+				method:        "GET",
+				endpoint:      "/v1/menus/1",
+				accept:        "application/msgpack",
+				outputStatus:  http.StatusOK,
+				mockrepoFn:    getUpdatedMenu,
+				outputHeaders: map[string]string{"Content-Type": "application/msgpack; charset=utf-8"},
+			},
+		},
+		{
+			name: "Fetch a small Menu by ID uncompressed successfully",
+			args: args{
+				method:        "GET",
+				endpoint:      "/v1/menus/1",
+				headers:       map[string]string{"Accept-Encoding": "gzip, br"},
+				outputStatus:  http.StatusOK,
+				mockrepoFn:    getUpdatedMenu,
+				outputBody:    string(updatedMenuJSON),
+				outputHeaders: map[string]string{"Content-Encoding": ""},
+			},
+		},
+		{
+			name: "Export Menus compressed with brotli successfully",
+			args: args{
+				method:        "GET",
+				endpoint:      "/v1/menus/export?format=ndjson",
+				headers:       map[string]string{"Accept-Encoding": "gzip;q=0.8, br"},
+				outputStatus:  http.StatusOK,
+				mockrepoFn:    exportMenus(manyMenus...),
+				outputHeaders: map[string]string{"Content-Encoding": "br"},
+			},
+		},
+		{
+			name: "Export Menus compressed with gzip successfully",
+			args: args{
+				method:        "GET",
+				endpoint:      "/v1/menus/export?format=csv",
+				headers:       map[string]string{"Accept-Encoding": "gzip, br;q=0"},
+				outputStatus:  http.StatusOK,
+				mockrepoFn:    exportMenus(manyMenus...),
+				outputHeaders: map[string]string{"Content-Encoding": "gzip"},
+			},
+		},
 		{
 			name: "Deleted Menu by ID successfully",
 			args: args{
@@ -252,7 +761,9 @@ func TestMenuRoutes(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			var buf bytes.Buffer
-			if tt.args.body != nil {
+			if raw, ok := tt.args.body.(string); ok {
+				buf.WriteString(raw)
+			} else if tt.args.body != nil {
 				err := json.NewEncoder(&buf).Encode(tt.args.body)
 				if err != nil {
 					log.Fatal(err)
@@ -263,14 +774,35 @@ func TestMenuRoutes(t *testing.T) {
 			if err != nil {
 				t.Errorf("Error creating a new request: %v", err)
 			}
+			if tt.args.contentType != "" {
+				req.Header.Set("Content-Type", tt.args.contentType)
+			}
+			if tt.args.accept != "" {
+				req.Header.Set("Accept", tt.args.accept)
+			}
+			for key, value := range tt.args.headers {
+				req.Header.Set(key, value)
+			}
 			rr := httptest.NewRecorder()
 			router, routerV1 := getTestRouter()
 			routes.MenuRoutes(routerV1, &menuController.Controller{MenuService: menuService.Service{MenuRepository: tt.args.mockrepoFn()}})
 			router.ServeHTTP(rr, req)
+			assertContract(t, req, rr)
 
 			if status := rr.Code; status != tt.args.outputStatus {
 				t.Errorf("Handler returned wrong status code. Expected: %d. Got: %d.", tt.args.outputStatus, status)
 			}
+			if link := rr.Header().Get("Link"); tt.args.outputLink != "" && link != tt.args.outputLink {
+				t.Errorf("Handler returned wrong Link header. Expected: %s. Got: %s.", tt.args.outputLink, link)
+			}
+			if body := rr.Body.String(); tt.args.outputBody != "" && body != tt.args.outputBody {
+				t.Errorf("Handler returned wrong body. Expected: %s. Got: %s.", tt.args.outputBody, body)
+			}
+			for key, value := range tt.args.outputHeaders {
+				if header := rr.Header().Get(key); header != value {
+					t.Errorf("Handler returned wrong %s header. Expected: %s. Got: %s.", key, value, header)
+				}
+			}
 		})
 	}
 }
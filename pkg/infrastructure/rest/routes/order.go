@@ -13,7 +13,10 @@ func OrderRoutes(router *gin.RouterGroup, controller *orderController.Controller
 	{
 		routerOrder.POST("/", controller.NewOrder)
 		routerOrder.GET("/:id", controller.GetOrdersByDinerID)
+		routerOrder.POST("/:id/serve", controller.ServeOrder)
 		routerOrder.DELETE("/:id", controller.DeleteOrder)
 	}
 
+	router.GET("/diners/:id/orders", controller.GetDinerOrders)
+
 }
@@ -23,9 +23,9 @@ import (
 
 func TestOrderRoutes(t *testing.T) {
 
-	dinerID := gofakeit.Int64()
+	dinerID := int64(gofakeit.Number(1, 100000))
 	dinerName := gofakeit.Name()
-	menuID := gofakeit.Int64()
+	menuID := int64(gofakeit.Number(1, 100000))
 	menuName := gofakeit.BeerHop()
 	menuDesc := gofakeit.BeerName()
 	quantity := gofakeit.Number(1, 20)
@@ -131,6 +131,80 @@ func TestOrderRoutes(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Fetch Orders of the current Diner session successfully",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v1/diners/1/orders",
+				outputStatus: http.StatusOK,
+				mockrepoFn: func() repository.Orders {
+					mRepository := mockRepository.NewMockOrders(gomock.NewController(t))
+					mRepository.EXPECT().GetByCurrentSession(gomock.Any(), int64(1)).AnyTimes().Return([]domainOrder.Response{
+						{
+							ID:              gofakeit.Int64(),
+							SessionID:       2,
+							DinnerName:      dinerName,
+							MenuName:        menuName,
+							MenuDescription: menuDesc,
+							Quantity:        quantity,
+							CreatedAt:       time.Now(),
+							UpdatedAt:       time.Now(),
+						},
+					}, nil)
+					return mRepository
+				},
+			},
+		},
+		{
+			name: "Fetch Order history of a Diner successfully",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v1/diners/1/orders?history=true",
+				outputStatus: http.StatusOK,
+				mockrepoFn: func() repository.Orders {
+					mRepository := mockRepository.NewMockOrders(gomock.NewController(t))
+					mRepository.EXPECT().GetByID(gomock.Any(), int64(1)).AnyTimes().Return([]domainOrder.Response{}, nil)
+					return mRepository
+				},
+			},
+		},
+		{
+			name: "Failed to fetch Orders of a Diner due to invalid history flag",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v1/diners/1/orders?history=sometimes",
+				outputStatus: http.StatusBadRequest,
+				mockrepoFn: func() repository.Orders {
+					return mockRepository.NewMockOrders(gomock.NewController(t))
+				},
+			},
+		},
+		{
+			name: "Served Order by ID successfully",
+			args: args{
+				method:       "POST",
+				endpoint:     "/v1/orders/1/serve",
+				outputStatus: http.StatusOK,
+				mockrepoFn: func() repository.Orders {
+					mRepository := mockRepository.NewMockOrders(gomock.NewController(t))
+					mRepository.EXPECT().Serve(gomock.Any(), int64(1)).AnyTimes().Return(nil)
+					return mRepository
+				},
+			},
+		},
+		{
+			name: "Failed to serve Order by ID as it is already served",
+			args: args{
+				method:       "POST",
+				endpoint:     "/v1/orders/1/serve",
+				outputStatus: http.StatusConflict,
+				mockrepoFn: func() repository.Orders {
+					mRepository := mockRepository.NewMockOrders(gomock.NewController(t))
+					mRepository.EXPECT().Serve(gomock.Any(), int64(1)).AnyTimes().Return(appErr.NewAppErrorWithType(appErr.ResourceAlreadyExists))
+					return mRepository
+				},
+			},
+		},
 		{
 			name: "Deleted Order by ID successfully",
 			args: args{
@@ -177,6 +251,7 @@ func TestOrderRoutes(t *testing.T) {
 			router, routerV1 := getTestRouter()
 			routes.OrderRoutes(routerV1, &orderController.Controller{OrderService: orderService.Service{OrderRepository: tt.args.mockrepoFn()}})
 			router.ServeHTTP(rr, req)
+			assertContract(t, req, rr)
 
 			if status := rr.Code; status != tt.args.outputStatus {
 				t.Errorf("Handler returned wrong status code. Expected: %d. Got: %d.", tt.args.outputStatus, status)
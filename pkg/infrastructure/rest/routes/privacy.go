@@ -0,0 +1,20 @@
+// Package routes contains all routes of the application
+package routes
+
+import (
+	privacyController "github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers/privacy"
+	"github.com/gin-gonic/gin"
+)
+
+// PrivacyRoutes is a function that contains all admin routes for the personal data requests of diners, which require
+// the requests to pass auth
+func PrivacyRoutes(router *gin.RouterGroup, controller *privacyController.Controller, auth gin.HandlerFunc) {
+
+	routerPrivacy := router.Group("/admin/diners", auth)
+	{
+		routerPrivacy.POST("/:id/export", controller.ExportDiner)
+		routerPrivacy.POST("/:id/anonymise", controller.AnonymiseDiner)
+		routerPrivacy.GET("/:id/privacy-requests", controller.GetPrivacyRequests)
+	}
+
+}
@@ -0,0 +1,266 @@
+// Package routes contains all routes of the application
+package routes_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	privacyService "github.com/Raj63/golang-rest-api/pkg/app/usecases/privacy"
+	domainCustomer "github.com/Raj63/golang-rest-api/pkg/domain/customer"
+	domainDiner "github.com/Raj63/golang-rest-api/pkg/domain/diner"
+	appErr "github.com/Raj63/golang-rest-api/pkg/domain/errors"
+	domainOrder "github.com/Raj63/golang-rest-api/pkg/domain/order"
+	domainPrivacy "github.com/Raj63/golang-rest-api/pkg/domain/privacy"
+	privacyController "github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers/privacy"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/middlewares"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/routes"
+	"github.com/brianvoe/gofakeit"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/golang/mock/gomock"
+)
+
+// requestedByMatcher matches the privacy requests recorded as made by requestedBy
+type requestedByMatcher string
+
+func (m requestedByMatcher) Matches(x interface{}) bool {
+	request, ok := x.(*domainPrivacy.Request)
+	return ok && request.RequestedBy == string(m)
+}
+
+func (m requestedByMatcher) String() string {
+	return "is requested by " + string(m)
+}
+
+func TestPrivacyRoutes(t *testing.T) {
+
+	dinerName := gofakeit.Name()
+	requestedBy := gofakeit.Email()
+	checkedOutAt := time.Now().Add(-time.Hour)
+	token := "Bearer " + adminToken(t, requestedBy)
+	type args struct {
+		method       string
+		endpoint     string
+		body         interface{}
+		token        string
+		secret       string
+		noSecret     bool
+		mockrepoFn   func() privacyService.Service
+		outputStatus int
+	}
+	service := func(r *repositoryMocks) privacyService.Service {
+		return privacyService.Service{
+			PrivacyRepository:  r.privacy,
+			DinerRepository:    r.diners,
+			OrderRepository:    r.orders,
+			CustomerRepository: r.customers,
+		}
+	}
+
+	tests := []struct {
+		name string
+		args args
+	}{
+		{
+			name: "Export Diner personal data successfully",
+			args: args{
+				method:       "POST",
+				endpoint:     "/v1/admin/diners/1/export",
+				token:        token,
+				body:         privacyController.PrivacyRequest{Reason: "subject access request"},
+				outputStatus: http.StatusOK,
+				mockrepoFn: mocked(t, service, func(r *repositoryMocks) {
+					r.diners.EXPECT().GetByID(gomock.Any(), int64(1)).AnyTimes().Return(&domainDiner.Diner{ID: 1, Name: dinerName, TableNumber: 2}, nil)
+					r.diners.EXPECT().GetSessions(gomock.Any(), int64(1)).AnyTimes().Return([]domainDiner.Session{
+						{ID: 1, DinerID: 1, CustomerID: 7, TableNumber: 2, CheckedInAt: checkedOutAt.Add(-time.Hour), CheckedOutAt: &checkedOutAt},
+					}, nil)
+					r.orders.EXPECT().GetByID(gomock.Any(), int64(1)).AnyTimes().Return([]domainOrder.Response{
+						{ID: 1, SessionID: 1, DinnerName: dinerName, MenuName: gofakeit.BeerHop(), Quantity: 2},
+					}, nil)
+					r.privacy.EXPECT().GetPayments(gomock.Any(), int64(1)).AnyTimes().Return([]domainDiner.Payment{
+						{ID: 1, SessionID: 1, Subtotal: 400, Amount: 400, PaidAt: checkedOutAt},
+					}, nil)
+					r.privacy.EXPECT().GetCustomerIDs(gomock.Any(), int64(1)).AnyTimes().Return([]int64{7}, nil)
+					r.customers.EXPECT().GetByID(gomock.Any(), int64(7)).AnyTimes().Return(&domainCustomer.Customer{ID: 7, Name: dinerName, Email: gofakeit.Email()}, nil)
+					r.customers.EXPECT().GetLedger(gomock.Any(), int64(7)).AnyTimes().Return([]domainCustomer.LedgerEntry{
+						{ID: 1, CustomerID: 7, SessionID: 1, Kind: domainCustomer.KindAccrual, Points: 40, Amount: 400},
+					}, nil)
+					r.privacy.EXPECT().Create(gomock.Any(), gomock.Any()).AnyTimes().Return(&domainPrivacy.Request{ID: 1, DinerID: 1, Kind: domainPrivacy.KindExport}, nil)
+				}),
+			},
+		},
+		{
+			name: "Failed to export Diner personal data due to missing record",
+			args: args{
+				method:       "POST",
+				endpoint:     "/v1/admin/diners/1/export",
+				token:        token,
+				body:         privacyController.PrivacyRequest{},
+				outputStatus: http.StatusNotFound,
+				mockrepoFn: mocked(t, service, func(r *repositoryMocks) {
+					r.diners.EXPECT().GetByID(gomock.Any(), gomock.Any()).AnyTimes().Return(nil, appErr.NewAppErrorWithType(appErr.NotFound))
+				}),
+			},
+		},
+		{
+			name: "Failed to export Diner personal data with a token without a subject",
+			args: args{
+				method:       "POST",
+				endpoint:     "/v1/admin/diners/1/export",
+				token:        adminToken(t, ""),
+				body:         privacyController.PrivacyRequest{Reason: "subject access request"},
+				outputStatus: http.StatusUnauthorized,
+				mockrepoFn:   mocked(t, service, noExpectations),
+			},
+		},
+		{
+			name: "Failed to export Diner personal data with a token signed by another method",
+			args: args{
+				method:   "POST",
+				endpoint: "/v1/admin/diners/1/export",
+				token: signedToken(t, jwt.SigningMethodNone, jwt.RegisteredClaims{
+					Subject:   requestedBy,
+					ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+				}, jwt.UnsafeAllowNoneSignatureType),
+				body:         privacyController.PrivacyRequest{Reason: "subject access request"},
+				outputStatus: http.StatusUnauthorized,
+				mockrepoFn:   mocked(t, service, noExpectations),
+			},
+		},
+		{
+			name: "Failed to export Diner personal data with a token signed by another secret",
+			args: args{
+				method:       "POST",
+				endpoint:     "/v1/admin/diners/1/export",
+				token:        token,
+				secret:       "another-secret",
+				body:         privacyController.PrivacyRequest{Reason: "subject access request"},
+				outputStatus: http.StatusUnauthorized,
+				mockrepoFn:   mocked(t, service, noExpectations),
+			},
+		},
+		{
+			name: "Failed to export Diner personal data while no secret is configured",
+			args: args{
+				method:       "POST",
+				endpoint:     "/v1/admin/diners/1/export",
+				token:        signedToken(t, jwt.SigningMethodHS256, jwt.RegisteredClaims{Subject: requestedBy}, []byte{}),
+				noSecret:     true,
+				body:         privacyController.PrivacyRequest{Reason: "subject access request"},
+				outputStatus: http.StatusUnauthorized,
+				mockrepoFn:   mocked(t, service, noExpectations),
+			},
+		},
+		{
+			name: "Anonymise Diner successfully",
+			args: args{
+				method:       "POST",
+				endpoint:     "/v1/admin/diners/1/anonymise",
+				token:        token,
+				body:         privacyController.PrivacyRequest{Reason: "erasure request"},
+				outputStatus: http.StatusOK,
+				mockrepoFn: mocked(t, service, func(r *repositoryMocks) {
+					// the request is recorded as made by the subject of the token
+					r.privacy.EXPECT().Anonymise(gomock.Any(), int64(1), requestedByMatcher(requestedBy)).AnyTimes().Return(&domainPrivacy.Request{
+						ID: 2, DinerID: 1, Kind: domainPrivacy.KindAnonymise, RequestedBy: requestedBy, Channel: domainPrivacy.ChannelAPI, CreatedAt: time.Now(),
+					}, nil)
+				}),
+			},
+		},
+		{
+			name: "Failed to anonymise Diner due to missing record",
+			args: args{
+				method:       "POST",
+				endpoint:     "/v1/admin/diners/1/anonymise",
+				token:        token,
+				body:         privacyController.PrivacyRequest{},
+				outputStatus: http.StatusNotFound,
+				mockrepoFn: mocked(t, service, func(r *repositoryMocks) {
+					r.privacy.EXPECT().Anonymise(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(nil, appErr.NewAppErrorWithType(appErr.NotFound))
+				}),
+			},
+		},
+		{
+			name: "Failed to anonymise Diner without a token",
+			args: args{
+				method:       "POST",
+				endpoint:     "/v1/admin/diners/1/anonymise",
+				body:         privacyController.PrivacyRequest{},
+				outputStatus: http.StatusUnauthorized,
+				mockrepoFn:   mocked(t, service, noExpectations),
+			},
+		},
+		{
+			name: "Failed to export Diner personal data with an invalid token",
+			args: args{
+				method:       "POST",
+				endpoint:     "/v1/admin/diners/1/export",
+				token:        "not-a-token",
+				body:         privacyController.PrivacyRequest{},
+				outputStatus: http.StatusUnauthorized,
+				mockrepoFn:   mocked(t, service, noExpectations),
+			},
+		},
+		{
+			name: "Failed to fetch Diner privacy requests without a token",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v1/admin/diners/1/privacy-requests",
+				outputStatus: http.StatusUnauthorized,
+				mockrepoFn:   mocked(t, service, noExpectations),
+			},
+		},
+		{
+			name: "Fetch Diner privacy requests successfully",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v1/admin/diners/1/privacy-requests",
+				token:        token,
+				outputStatus: http.StatusOK,
+				mockrepoFn: mocked(t, service, func(r *repositoryMocks) {
+					r.privacy.EXPECT().GetByDinerID(gomock.Any(), int64(1)).AnyTimes().Return([]domainPrivacy.Request{
+						{ID: 2, DinerID: 1, Kind: domainPrivacy.KindAnonymise, RequestedBy: requestedBy, Channel: domainPrivacy.ChannelCLI},
+						{ID: 1, DinerID: 1, Kind: domainPrivacy.KindExport, RequestedBy: requestedBy, Channel: domainPrivacy.ChannelAPI},
+					}, nil)
+				}),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if tt.args.body != nil {
+				err := json.NewEncoder(&buf).Encode(tt.args.body)
+				if err != nil {
+					log.Fatal(err)
+				}
+			}
+
+			req, err := http.NewRequest(tt.args.method, tt.args.endpoint, &buf)
+			if err != nil {
+				t.Errorf("Error creating a new request: %v", err)
+			}
+			if tt.args.token != "" {
+				req.Header.Set("Authorization", tt.args.token)
+			}
+			rr := httptest.NewRecorder()
+			router, routerV1 := getTestRouter()
+			secret := testJWTSecret
+			if tt.args.secret != "" || tt.args.noSecret {
+				secret = tt.args.secret
+			}
+			routes.PrivacyRoutes(routerV1, &privacyController.Controller{PrivacyService: tt.args.mockrepoFn()}, middlewares.AuthJWTMiddleware(secret))
+			router.ServeHTTP(rr, req)
+			assertContract(t, req, rr)
+
+			if status := rr.Code; status != tt.args.outputStatus {
+				t.Errorf("Handler returned wrong status code. Expected: %d. Got: %d.", tt.args.outputStatus, status)
+			}
+		})
+	}
+}
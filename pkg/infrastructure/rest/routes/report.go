@@ -0,0 +1,18 @@
+// Package routes contains all routes of the application
+package routes
+
+import (
+	reportController "github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers/report"
+	"github.com/gin-gonic/gin"
+)
+
+// ReportRoutes is a function that contains all routes of the management reports
+func ReportRoutes(router *gin.RouterGroup, controller *reportController.Controller) {
+
+	routerReport := router.Group("/reports")
+	{
+		routerReport.GET("/sales", controller.GetSales)
+		routerReport.GET("/service-times", controller.GetServiceTimes)
+	}
+
+}
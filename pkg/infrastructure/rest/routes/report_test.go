@@ -0,0 +1,229 @@
+// Package routes contains all routes of the application
+package routes_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	reportService "github.com/Raj63/golang-rest-api/pkg/app/usecases/report"
+	appErr "github.com/Raj63/golang-rest-api/pkg/domain/errors"
+	domainReport "github.com/Raj63/golang-rest-api/pkg/domain/report"
+	mockRepository "github.com/Raj63/golang-rest-api/pkg/infrastructure/mocks/repository"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/repository"
+	reportController "github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers/report"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/routes"
+	"github.com/brianvoe/gofakeit"
+	"github.com/golang/mock/gomock"
+)
+
+func TestReportRoutes(t *testing.T) {
+
+	sales := []domainReport.SalesRow{
+		{Group: "2026-10-18", Revenue: gofakeit.Price(100, 2000), Orders: 12, AverageTicket: 80.5, ItemsSold: 30},
+		{Group: "2026-10-19", Revenue: gofakeit.Price(100, 2000), Orders: 9, AverageTicket: 72.25, ItemsSold: 21},
+	}
+	type args struct {
+		method       string
+		endpoint     string
+		accept       string
+		mockrepoFn   func() repository.Reports
+		outputStatus int
+		outputType   string
+	}
+
+	tests := []struct {
+		name string
+		args args
+	}{
+		{
+			name: "Fetch daily sales as JSON successfully",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v1/reports/sales?from=2026-10-13&to=2026-10-19",
+				outputStatus: http.StatusOK,
+				outputType:   "application/json; charset=utf-8",
+				mockrepoFn: func() repository.Reports {
+					mRepository := mockRepository.NewMockReports(gomock.NewController(t))
+					mRepository.EXPECT().CountAggregatedDays(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(5, nil)
+					mRepository.EXPECT().GetSales(gomock.Any(), gomock.Any(), gomock.Any(), domainReport.GroupByDay).AnyTimes().Return(sales, nil)
+					return mRepository
+				},
+			},
+		},
+		{
+			name: "Fetch hourly sales as CSV by Accept header successfully",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v1/reports/sales?group_by=hour",
+				accept:       "text/csv",
+				outputStatus: http.StatusOK,
+				outputType:   "text/csv; charset=utf-8",
+				mockrepoFn: func() repository.Reports {
+					mRepository := mockRepository.NewMockReports(gomock.NewController(t))
+					mRepository.EXPECT().GetSales(gomock.Any(), gomock.Any(), gomock.Any(), domainReport.GroupByHour).AnyTimes().Return(sales, nil)
+					return mRepository
+				},
+			},
+		},
+		{
+			name: "Fetch sales per menu as CSV by format parameter successfully",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v1/reports/sales?group_by=menu&format=csv",
+				accept:       "application/json",
+				outputStatus: http.StatusOK,
+				outputType:   "text/csv; charset=utf-8",
+				mockrepoFn: func() repository.Reports {
+					mRepository := mockRepository.NewMockReports(gomock.NewController(t))
+					mRepository.EXPECT().GetSales(gomock.Any(), gomock.Any(), gomock.Any(), domainReport.GroupByMenu).AnyTimes().Return(sales, nil)
+					return mRepository
+				},
+			},
+		},
+		{
+			name: "Fetch aggregated sales per table successfully",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v1/reports/sales?from=2026-09-01&to=2026-09-30&group_by=table",
+				outputStatus: http.StatusOK,
+				outputType:   "application/json; charset=utf-8",
+				mockrepoFn: func() repository.Reports {
+					mRepository := mockRepository.NewMockReports(gomock.NewController(t))
+					mRepository.EXPECT().CountAggregatedDays(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(30, nil)
+					mRepository.EXPECT().GetAggregatedSales(gomock.Any(), gomock.Any(), gomock.Any(), domainReport.GroupByTable).AnyTimes().Return([]domainReport.SalesRow{
+						{Group: "1", Revenue: gofakeit.Price(100, 2000), Orders: 40, AverageTicket: 61.8, ItemsSold: 95},
+					}, nil)
+					return mRepository
+				},
+			},
+		},
+		{
+			name: "Failed to fetch sales due to unknown grouping",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v1/reports/sales?group_by=month",
+				outputStatus: http.StatusBadRequest,
+				mockrepoFn: func() repository.Reports {
+					return mockRepository.NewMockReports(gomock.NewController(t))
+				},
+			},
+		},
+		{
+			name: "Failed to fetch sales due to reversed date range",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v1/reports/sales?from=2026-10-19&to=2026-10-01",
+				outputStatus: http.StatusBadRequest,
+				mockrepoFn: func() repository.Reports {
+					return mockRepository.NewMockReports(gomock.NewController(t))
+				},
+			},
+		},
+		{
+			name: "Failed to fetch sales due to unknown format",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v1/reports/sales?format=xlsx",
+				outputStatus: http.StatusBadRequest,
+				mockrepoFn: func() repository.Reports {
+					return mockRepository.NewMockReports(gomock.NewController(t))
+				},
+			},
+		},
+		{
+			name: "Failed to fetch sales due to repository error",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v1/reports/sales",
+				outputStatus: http.StatusInternalServerError,
+				mockrepoFn: func() repository.Reports {
+					mRepository := mockRepository.NewMockReports(gomock.NewController(t))
+					mRepository.EXPECT().GetSales(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(nil, appErr.NewAppErrorWithType(appErr.RepositoryError))
+					return mRepository
+				},
+			},
+		},
+		{
+			name: "Fetch service times per daypart successfully",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v1/reports/service-times?from=2026-10-01&to=2026-10-19&group_by=daypart",
+				outputStatus: http.StatusOK,
+				outputType:   "application/json; charset=utf-8",
+				mockrepoFn: func() repository.Reports {
+					mRepository := mockRepository.NewMockReports(gomock.NewController(t))
+					mRepository.EXPECT().GetServiceTimes(gomock.Any(), gomock.Any(), gomock.Any(), domainReport.GroupByDaypart).AnyTimes().Return([]domainReport.ServiceTimeRow{
+						{Group: "lunch", Sessions: 48, SeatToFirstOrder: 6.5, OrderToServe: 17.2, Occupancy: 52.1, TotalOccupancy: 2500.8},
+						{Group: "dinner", Sessions: 61, SeatToFirstOrder: 9.1, OrderToServe: 21.4, Occupancy: 74.3, TotalOccupancy: 4532.3},
+					}, nil)
+					return mRepository
+				},
+			},
+		},
+		{
+			name: "Fetch service times per table as CSV successfully",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v1/reports/service-times?format=csv",
+				outputStatus: http.StatusOK,
+				outputType:   "text/csv; charset=utf-8",
+				mockrepoFn: func() repository.Reports {
+					mRepository := mockRepository.NewMockReports(gomock.NewController(t))
+					mRepository.EXPECT().GetServiceTimes(gomock.Any(), gomock.Any(), gomock.Any(), domainReport.GroupByTable).AnyTimes().Return([]domainReport.ServiceTimeRow{
+						{Group: "1", Sessions: 12, SeatToFirstOrder: 5.4, OrderToServe: 15.9, Occupancy: 48.2, TotalOccupancy: 578.4},
+					}, nil)
+					return mRepository
+				},
+			},
+		},
+		{
+			name: "Failed to fetch service times due to unknown grouping",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v1/reports/service-times?group_by=menu",
+				outputStatus: http.StatusBadRequest,
+				mockrepoFn: func() repository.Reports {
+					return mockRepository.NewMockReports(gomock.NewController(t))
+				},
+			},
+		},
+		{
+			name: "Failed to fetch service times due to repository error",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v1/reports/service-times?group_by=section",
+				outputStatus: http.StatusInternalServerError,
+				mockrepoFn: func() repository.Reports {
+					mRepository := mockRepository.NewMockReports(gomock.NewController(t))
+					mRepository.EXPECT().GetServiceTimes(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(nil, appErr.NewAppErrorWithType(appErr.RepositoryError))
+					return mRepository
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(tt.args.method, tt.args.endpoint, nil)
+			if err != nil {
+				t.Errorf("Error creating a new request: %v", err)
+			}
+			if tt.args.accept != "" {
+				req.Header.Set("Accept", tt.args.accept)
+			}
+			rr := httptest.NewRecorder()
+			router, routerV1 := getTestRouter()
+			routes.ReportRoutes(routerV1, &reportController.Controller{ReportService: reportService.Service{ReportRepository: tt.args.mockrepoFn()}})
+			router.ServeHTTP(rr, req)
+			assertContract(t, req, rr)
+
+			if status := rr.Code; status != tt.args.outputStatus {
+				t.Errorf("Handler returned wrong status code. Expected: %d. Got: %d.", tt.args.outputStatus, status)
+			}
+			if contentType := rr.Header().Get("Content-Type"); tt.args.outputType != "" && contentType != tt.args.outputType {
+				t.Errorf("Handler returned wrong content type. Expected: %s. Got: %s.", tt.args.outputType, contentType)
+			}
+		})
+	}
+}
@@ -0,0 +1,21 @@
+// Package routes contains all routes of the application
+package routes
+
+import (
+	reservationController "github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers/reservation"
+	"github.com/gin-gonic/gin"
+)
+
+// ReservationRoutes is a function that contains all reservation routes
+func ReservationRoutes(router *gin.RouterGroup, controller *reservationController.Controller) {
+
+	routerReservation := router.Group("/reservations")
+	{
+		routerReservation.POST("/", controller.NewReservation)
+		routerReservation.GET("/availability", controller.GetAvailability)
+		routerReservation.GET("/:id", controller.GetReservationByID)
+		routerReservation.GET("/", controller.GetAllReservations)
+		routerReservation.PATCH("/:id/status", controller.UpdateReservationStatus)
+	}
+
+}
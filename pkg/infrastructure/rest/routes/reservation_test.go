@@ -0,0 +1,288 @@
+// Package routes contains all routes of the application
+package routes_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	reservationService "github.com/Raj63/golang-rest-api/pkg/app/usecases/reservation"
+	appErr "github.com/Raj63/golang-rest-api/pkg/domain/errors"
+	domainReservation "github.com/Raj63/golang-rest-api/pkg/domain/reservation"
+	domainTable "github.com/Raj63/golang-rest-api/pkg/domain/table"
+	mockRepository "github.com/Raj63/golang-rest-api/pkg/infrastructure/mocks/repository"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/repository"
+	reservationController "github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers/reservation"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/routes"
+	"github.com/brianvoe/gofakeit"
+	"github.com/golang/mock/gomock"
+)
+
+func TestReservationRoutes(t *testing.T) {
+
+	guestName := gofakeit.Name()
+	partySize := gofakeit.Number(1, 4)
+	reservedAt := time.Now().Add(48 * time.Hour)
+	tomorrow := time.Now().AddDate(0, 0, 1).Format("2006-01-02")
+	tables := []domainTable.Table{
+		{TableNumber: 3, Capacity: 4, Section: "main"},
+		{TableNumber: 5, Capacity: 6, Section: "main"},
+	}
+	booked := &domainReservation.Reservation{
+		ID:              gofakeit.Int64(),
+		Name:            guestName,
+		PartySize:       partySize,
+		TableNumber:     3,
+		ReservedAt:      reservedAt,
+		DurationMinutes: domainReservation.DefaultDurationMinutes,
+		Status:          domainReservation.StatusBooked,
+		CreatedAt:       time.Now(),
+		UpdatedAt:       time.Now(),
+	}
+	type args struct {
+		method       string
+		endpoint     string
+		body         interface{}
+		mockrepoFn   func() (repository.Reservations, repository.Tables)
+		outputStatus int
+	}
+
+	tests := []struct {
+		name string
+		args args
+	}{
+		{
+			name: "Add new Reservation successfully",
+			args: args{
+				method:   "POST",
+				endpoint: "/v1/reservations/",
+				body: reservationController.NewReservationRequest{
+					Name:       guestName,
+					PartySize:  partySize,
+					ReservedAt: reservedAt,
+				},
+				outputStatus: http.StatusCreated,
+				mockrepoFn: func() (repository.Reservations, repository.Tables) {
+					rRepository := mockRepository.NewMockReservations(gomock.NewController(t))
+					tRepository := mockRepository.NewMockTables(gomock.NewController(t))
+					tRepository.EXPECT().GetByMinCapacity(gomock.Any(), partySize).AnyTimes().Return(tables, nil)
+					rRepository.EXPECT().Create(gomock.Any(), gomock.Any(), []int{3, 5}).AnyTimes().Return(booked, nil)
+					return rRepository, tRepository
+				},
+			},
+		},
+		{
+			name: "Add new Reservation failed due to missing name validation error",
+			args: args{
+				method:   "POST",
+				endpoint: "/v1/reservations/",
+				body: reservationController.NewReservationRequest{
+					PartySize:  partySize,
+					ReservedAt: reservedAt,
+				},
+				outputStatus: http.StatusBadRequest,
+				mockrepoFn: func() (repository.Reservations, repository.Tables) {
+					return mockRepository.NewMockReservations(gomock.NewController(t)), mockRepository.NewMockTables(gomock.NewController(t))
+				},
+			},
+		},
+		{
+			name: "Add new Reservation failed due to reservation time in the past",
+			args: args{
+				method:   "POST",
+				endpoint: "/v1/reservations/",
+				body: reservationController.NewReservationRequest{
+					Name:       guestName,
+					PartySize:  partySize,
+					ReservedAt: time.Now().Add(-time.Hour),
+				},
+				outputStatus: http.StatusBadRequest,
+				mockrepoFn: func() (repository.Reservations, repository.Tables) {
+					return mockRepository.NewMockReservations(gomock.NewController(t)), mockRepository.NewMockTables(gomock.NewController(t))
+				},
+			},
+		},
+		{
+			name: "Add new Reservation failed due to no table seating the party",
+			args: args{
+				method:   "POST",
+				endpoint: "/v1/reservations/",
+				body: reservationController.NewReservationRequest{
+					Name:       guestName,
+					PartySize:  40,
+					ReservedAt: reservedAt,
+				},
+				outputStatus: http.StatusBadRequest,
+				mockrepoFn: func() (repository.Reservations, repository.Tables) {
+					rRepository := mockRepository.NewMockReservations(gomock.NewController(t))
+					tRepository := mockRepository.NewMockTables(gomock.NewController(t))
+					tRepository.EXPECT().GetByMinCapacity(gomock.Any(), 40).AnyTimes().Return([]domainTable.Table{}, nil)
+					return rRepository, tRepository
+				},
+			},
+		},
+		{
+			name: "Add new Reservation failed due to conflicting booking",
+			args: args{
+				method:   "POST",
+				endpoint: "/v1/reservations/",
+				body: reservationController.NewReservationRequest{
+					Name:        guestName,
+					PartySize:   partySize,
+					TableNumber: 5,
+					ReservedAt:  reservedAt,
+				},
+				outputStatus: http.StatusConflict,
+				mockrepoFn: func() (repository.Reservations, repository.Tables) {
+					rRepository := mockRepository.NewMockReservations(gomock.NewController(t))
+					tRepository := mockRepository.NewMockTables(gomock.NewController(t))
+					tRepository.EXPECT().GetByMinCapacity(gomock.Any(), partySize).AnyTimes().Return(tables, nil)
+					rRepository.EXPECT().Create(gomock.Any(), gomock.Any(), []int{5}).AnyTimes().Return(nil, appErr.NewAppErrorWithType(appErr.ResourceAlreadyExists))
+					return rRepository, tRepository
+				},
+			},
+		},
+		{
+			name: "Fetch Reservation List successfully",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v1/reservations/?date=" + tomorrow,
+				outputStatus: http.StatusOK,
+				mockrepoFn: func() (repository.Reservations, repository.Tables) {
+					rRepository := mockRepository.NewMockReservations(gomock.NewController(t))
+					rRepository.EXPECT().GetAllBetween(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return([]domainReservation.Reservation{*booked}, nil)
+					return rRepository, mockRepository.NewMockTables(gomock.NewController(t))
+				},
+			},
+		},
+		{
+			name: "Failed to fetch Reservation List due to invalid date",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v1/reservations/?date=tomorrow",
+				outputStatus: http.StatusBadRequest,
+				mockrepoFn: func() (repository.Reservations, repository.Tables) {
+					return mockRepository.NewMockReservations(gomock.NewController(t)), mockRepository.NewMockTables(gomock.NewController(t))
+				},
+			},
+		},
+		{
+			name: "Search availability successfully",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v1/reservations/availability?date=" + tomorrow + "&party_size=4",
+				outputStatus: http.StatusOK,
+				mockrepoFn: func() (repository.Reservations, repository.Tables) {
+					rRepository := mockRepository.NewMockReservations(gomock.NewController(t))
+					tRepository := mockRepository.NewMockTables(gomock.NewController(t))
+					tRepository.EXPECT().GetByMinCapacity(gomock.Any(), 4).AnyTimes().Return(tables, nil)
+					rRepository.EXPECT().GetOccupancies(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return([]domainReservation.Occupancy{}, nil)
+					return rRepository, tRepository
+				},
+			},
+		},
+		{
+			name: "Failed to search availability due to missing party size",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v1/reservations/availability?date=" + tomorrow,
+				outputStatus: http.StatusBadRequest,
+				mockrepoFn: func() (repository.Reservations, repository.Tables) {
+					return mockRepository.NewMockReservations(gomock.NewController(t)), mockRepository.NewMockTables(gomock.NewController(t))
+				},
+			},
+		},
+		{
+			name: "Fetch Reservation by ID successfully",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v1/reservations/1",
+				outputStatus: http.StatusOK,
+				mockrepoFn: func() (repository.Reservations, repository.Tables) {
+					rRepository := mockRepository.NewMockReservations(gomock.NewController(t))
+					rRepository.EXPECT().GetByID(gomock.Any(), gomock.Any()).AnyTimes().Return(booked, nil)
+					return rRepository, mockRepository.NewMockTables(gomock.NewController(t))
+				},
+			},
+		},
+		{
+			name: "Failed to fetch Reservation by ID due to missing record",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v1/reservations/1",
+				outputStatus: http.StatusNotFound,
+				mockrepoFn: func() (repository.Reservations, repository.Tables) {
+					rRepository := mockRepository.NewMockReservations(gomock.NewController(t))
+					rRepository.EXPECT().GetByID(gomock.Any(), gomock.Any()).AnyTimes().Return(nil, appErr.NewAppErrorWithType(appErr.NotFound))
+					return rRepository, mockRepository.NewMockTables(gomock.NewController(t))
+				},
+			},
+		},
+		{
+			name: "Update Reservation status successfully",
+			args: args{
+				method:       "PATCH",
+				endpoint:     "/v1/reservations/1/status",
+				body:         reservationController.UpdateStatusRequest{Status: domainReservation.StatusSeated},
+				outputStatus: http.StatusOK,
+				mockrepoFn: func() (repository.Reservations, repository.Tables) {
+					rRepository := mockRepository.NewMockReservations(gomock.NewController(t))
+					current := *booked
+					rRepository.EXPECT().GetByID(gomock.Any(), gomock.Any()).AnyTimes().Return(&current, nil)
+					rRepository.EXPECT().UpdateStatus(gomock.Any(), gomock.Any(), domainReservation.StatusSeated).AnyTimes().Return(nil)
+					return rRepository, mockRepository.NewMockTables(gomock.NewController(t))
+				},
+			},
+		},
+		{
+			name: "Failed to update Reservation status due to invalid transition",
+			args: args{
+				method:       "PATCH",
+				endpoint:     "/v1/reservations/1/status",
+				body:         reservationController.UpdateStatusRequest{Status: domainReservation.StatusBooked},
+				outputStatus: http.StatusBadRequest,
+				mockrepoFn: func() (repository.Reservations, repository.Tables) {
+					rRepository := mockRepository.NewMockReservations(gomock.NewController(t))
+					cancelled := *booked
+					cancelled.Status = domainReservation.StatusCancelled
+					rRepository.EXPECT().GetByID(gomock.Any(), gomock.Any()).AnyTimes().Return(&cancelled, nil)
+					return rRepository, mockRepository.NewMockTables(gomock.NewController(t))
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if tt.args.body != nil {
+				err := json.NewEncoder(&buf).Encode(tt.args.body)
+				if err != nil {
+					log.Fatal(err)
+				}
+			}
+
+			req, err := http.NewRequest(tt.args.method, tt.args.endpoint, &buf)
+			if err != nil {
+				t.Errorf("Error creating a new request: %v", err)
+			}
+			rr := httptest.NewRecorder()
+			router, routerV1 := getTestRouter()
+			reservationRepository, tableRepository := tt.args.mockrepoFn()
+			routes.ReservationRoutes(routerV1, &reservationController.Controller{ReservationService: reservationService.Service{
+				ReservationRepository: reservationRepository,
+				TableRepository:       tableRepository,
+			}})
+			router.ServeHTTP(rr, req)
+			assertContract(t, req, rr)
+
+			if status := rr.Code; status != tt.args.outputStatus {
+				t.Errorf("Handler returned wrong status code. Expected: %d. Got: %d.", tt.args.outputStatus, status)
+			}
+		})
+	}
+}
@@ -7,6 +7,8 @@ import (
 	"github.com/Raj63/golang-rest-api/pkg/infrastructure/logger"
 	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/adapter"
 	errorsController "github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers/errors"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/envelope"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/middlewares"
 	sdksql "github.com/Raj63/golang-rest-api/pkg/infrastructure/sql"
 	"github.com/gin-gonic/gin"
 )
@@ -23,18 +25,52 @@ import (
 //	@license.name	Apache 2.0
 //	@license.url	http://www.apache.org/licenses/LICENSE-2.0.html
 
-// ApplicationV1Router is a function that contains all routes of the application
+// ApplicationV1Router is a function that contains all routes of the application. The requests and responses are
+// checked against the OpenAPI document in the contractValidation mode of middlewares.ContractValidation, if any, and
+// the admin, webhook and batch routes require a token signed with jwtSecret.
 //
 //	@host		localhost:8080
 //	@BasePath	/v1
-func ApplicationV1Router(router *gin.Engine, db *sdksql.DB, logger *logger.Logger) {
+func ApplicationV1Router(router *gin.Engine, db *sdksql.DB, logger *logger.Logger, contractValidation string, jwtSecret string) {
+	// the responses, the errors included, are compressed when they are large enough
+	router.Use(middlewares.Compress(middlewares.CompressMinSize))
+	// the contract is checked on the uncompressed bodies, the problems of the errors handler included
+	switch contractValidation {
+	case middlewares.ContractLog, middlewares.ContractReject:
+		router.Use(middlewares.ContractValidation(contractValidation, logger))
+	case "":
+	default:
+		logger.Warnf("unknown contract validation mode %q, the contract is not checked", contractValidation)
+	}
 	// the application errors will be processed here before returning to the caller
 	router.Use(errorsController.Handler)
 
+	auth := middlewares.AuthJWTMiddleware(jwtSecret)
+
 	routerV1 := router.Group("/v1")
 	{
 		MenuRoutes(routerV1, adapter.MenuAdapter(db, logger))
 		DinerRoutes(routerV1, adapter.DinerAdapter(db, logger))
 		OrderRoutes(routerV1, adapter.OrderAdapter(db, logger))
+		ReservationRoutes(routerV1, adapter.ReservationAdapter(db, logger))
+		WaitlistRoutes(routerV1, adapter.WaitlistAdapter(db, logger))
+		CustomerRoutes(routerV1, adapter.CustomerAdapter(db, logger))
+		PrivacyRoutes(routerV1, adapter.PrivacyAdapter(db, logger), auth)
+		ReportRoutes(routerV1, adapter.ReportAdapter(db, logger))
+		WebhookRoutes(routerV1, adapter.WebhookAdapter(db, logger), auth)
+		// the operations of a batch are dispatched through the whole router, its middlewares included
+		BatchRoutes(routerV1, adapter.BatchAdapter(router, db), auth)
+	}
+
+	GraphQLRoutes(&router.RouterGroup, adapter.GraphQLAdapter(db, logger))
+}
+
+// ApplicationV2Router is a function that contains the v2 routes of the application, answered in an envelope of
+// their data, meta, links and errors. It is set up after ApplicationV1Router, whose middlewares it shares.
+func ApplicationV2Router(router *gin.Engine, db *sdksql.DB, logger *logger.Logger) {
+	// the errors of v2 are written in an envelope before the v1 errors handler sees them
+	routerV2 := router.Group("/v2", envelope.ErrorHandler)
+	{
+		V2Routes(routerV2, adapter.V2Adapter(db, logger))
 	}
 }
@@ -0,0 +1,200 @@
+package routes_test
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	menuService "github.com/Raj63/golang-rest-api/pkg/app/usecases/menu"
+	domainMenu "github.com/Raj63/golang-rest-api/pkg/domain/menu"
+	mockRepository "github.com/Raj63/golang-rest-api/pkg/infrastructure/mocks/repository"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/repository"
+	menuController "github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers/menu"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/routes"
+	"github.com/golang/mock/gomock"
+)
+
+func TestMenuTranslations(t *testing.T) {
+
+	menus := func() *repository.PaginationResultMenu {
+		return &repository.PaginationResultMenu{
+			Data: &[]domainMenu.Menu{
+				{ID: 1, Name: "Dum Briyani", Description: "Slow cooked rice", Price: 200.5},
+				{ID: 2, Name: "Masala Dosa", Description: "Rice crepe", Price: 80},
+			},
+			Limit: 20,
+		}
+	}
+
+	type args struct {
+		method         string
+		endpoint       string
+		body           string
+		acceptLanguage string
+		mockrepoFn     func() (repository.Menus, repository.MenuTranslations)
+		outputStatus   int
+		// outputNames and outputLanguages are the expected names and languages of the menus listed, in order
+		outputNames     []string
+		outputLanguages []string
+		// outputContentLanguage is the expected Content-Language, none when empty
+		outputContentLanguage string
+	}
+
+	tests := []struct {
+		name string
+		args args
+	}{
+		{
+			name: "Menus are translated in the preferred language and fall back to its base and the default language",
+			args: args{
+				method:         "GET",
+				endpoint:       "/v1/menus/",
+				acceptLanguage: "fr-CA, en;q=0.8, de;q=0.5",
+				mockrepoFn: func() (repository.Menus, repository.MenuTranslations) {
+					mRepository := mockRepository.NewMockMenus(gomock.NewController(t))
+					mRepository.EXPECT().GetPage(gomock.Any(), gomock.Any()).Times(1).Return(menus(), nil)
+					tRepository := mockRepository.NewMockMenuTranslations(gomock.NewController(t))
+					// german is less preferred than the default language and never looked up
+					tRepository.EXPECT().GetByMenuIDs(gomock.Any(), []int64{1, 2}, []string{"fr-ca", "fr"}).Times(1).Return([]domainMenu.Translation{
+						{MenuID: 1, Language: "fr", Name: "Biryani Dum"},
+					}, nil)
+					return mRepository, tRepository
+				},
+				outputStatus:          http.StatusOK,
+				outputNames:           []string{"Biryani Dum", "Masala Dosa"},
+				outputLanguages:       []string{"fr", domainMenu.DefaultLanguage},
+				outputContentLanguage: "fr, en",
+			},
+		},
+		{
+			name: "Menus are not translated without an Accept-Language",
+			args: args{
+				method:   "GET",
+				endpoint: "/v1/menus/",
+				mockrepoFn: func() (repository.Menus, repository.MenuTranslations) {
+					mRepository := mockRepository.NewMockMenus(gomock.NewController(t))
+					mRepository.EXPECT().GetPage(gomock.Any(), gomock.Any()).Times(1).Return(menus(), nil)
+					return mRepository, mockRepository.NewMockMenuTranslations(gomock.NewController(t))
+				},
+				outputStatus:    http.StatusOK,
+				outputNames:     []string{"Dum Briyani", "Masala Dosa"},
+				outputLanguages: []string{"", ""},
+			},
+		},
+		{
+			name: "Translate a menu successfully",
+			args: args{
+				method:   "PUT",
+				endpoint: "/v1/menus/1/translations/FR",
+				body:     `{"name": "Biryani Dum", "description": "Riz cuit à l'étouffée"}`,
+				mockrepoFn: func() (repository.Menus, repository.MenuTranslations) {
+					mRepository := mockRepository.NewMockMenus(gomock.NewController(t))
+					mRepository.EXPECT().GetByID(gomock.Any(), int64(1)).Times(1).Return(&domainMenu.Menu{ID: 1}, nil)
+					tRepository := mockRepository.NewMockMenuTranslations(gomock.NewController(t))
+					tRepository.EXPECT().Upsert(gomock.Any(), &domainMenu.Translation{MenuID: 1, Language: "fr", Name: "Biryani Dum", Description: "Riz cuit à l'étouffée"}).Times(1).
+						DoAndReturn(func(_ interface{}, translation *domainMenu.Translation) (*domainMenu.Translation, error) {
+							return translation, nil
+						})
+					return mRepository, tRepository
+				},
+				outputStatus: http.StatusOK,
+			},
+		},
+		{
+			name: "Translate a menu in the default language fails",
+			args: args{
+				method:   "PUT",
+				endpoint: "/v1/menus/1/translations/en",
+				body:     `{"name": "Dum Briyani"}`,
+				mockrepoFn: func() (repository.Menus, repository.MenuTranslations) {
+					return mockRepository.NewMockMenus(gomock.NewController(t)), mockRepository.NewMockMenuTranslations(gomock.NewController(t))
+				},
+				outputStatus: http.StatusBadRequest,
+			},
+		},
+		{
+			name: "Translate a menu in a malformed language fails",
+			args: args{
+				method:   "PUT",
+				endpoint: "/v1/menus/1/translations/french_1",
+				body:     `{"name": "Biryani Dum"}`,
+				mockrepoFn: func() (repository.Menus, repository.MenuTranslations) {
+					return mockRepository.NewMockMenus(gomock.NewController(t)), mockRepository.NewMockMenuTranslations(gomock.NewController(t))
+				},
+				outputStatus: http.StatusBadRequest,
+			},
+		},
+		{
+			name: "Translate a missing menu fails",
+			args: args{
+				method:   "PUT",
+				endpoint: "/v1/menus/9/translations/fr",
+				body:     `{"name": "Biryani Dum"}`,
+				mockrepoFn: func() (repository.Menus, repository.MenuTranslations) {
+					mRepository := mockRepository.NewMockMenus(gomock.NewController(t))
+					mRepository.EXPECT().GetByID(gomock.Any(), int64(9)).Times(1).Return(nil, sql.ErrNoRows)
+					return mRepository, mockRepository.NewMockMenuTranslations(gomock.NewController(t))
+				},
+				outputStatus: http.StatusNotFound,
+			},
+		},
+		{
+			name: "Delete the translation of a menu successfully",
+			args: args{
+				method:   "DELETE",
+				endpoint: "/v1/menus/1/translations/fr",
+				mockrepoFn: func() (repository.Menus, repository.MenuTranslations) {
+					tRepository := mockRepository.NewMockMenuTranslations(gomock.NewController(t))
+					tRepository.EXPECT().Delete(gomock.Any(), int64(1), "fr").Times(1).Return(nil)
+					return mockRepository.NewMockMenus(gomock.NewController(t)), tRepository
+				},
+				outputStatus: http.StatusNoContent,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(tt.args.method, tt.args.endpoint, bytes.NewBufferString(tt.args.body))
+			if err != nil {
+				t.Errorf("Error creating a new request: %v", err)
+			}
+			if tt.args.acceptLanguage != "" {
+				req.Header.Set("Accept-Language", tt.args.acceptLanguage)
+			}
+			rr := httptest.NewRecorder()
+			router, routerV1 := getTestRouter()
+			mRepository, tRepository := tt.args.mockrepoFn()
+			routes.MenuRoutes(routerV1, &menuController.Controller{MenuService: menuService.Service{MenuRepository: mRepository, TranslationRepository: tRepository}})
+			router.ServeHTTP(rr, req)
+			assertContract(t, req, rr)
+
+			if status := rr.Code; status != tt.args.outputStatus {
+				t.Errorf("Handler returned wrong status code. Expected: %d. Got: %d. Body: %s", tt.args.outputStatus, status, rr.Body.String())
+			}
+			if contentLanguage := rr.Header().Get("Content-Language"); contentLanguage != tt.args.outputContentLanguage {
+				t.Errorf("Handler returned wrong Content-Language. Expected: %q. Got: %q.", tt.args.outputContentLanguage, contentLanguage)
+			}
+			if tt.args.outputNames == nil {
+				return
+			}
+
+			var page struct {
+				Data []domainMenu.Menu `json:"data"`
+			}
+			if err := json.Unmarshal(rr.Body.Bytes(), &page); err != nil {
+				t.Fatalf("Handler returned invalid menus: %v", err)
+			}
+			if len(page.Data) != len(tt.args.outputNames) {
+				t.Fatalf("Handler returned wrong menus. Got: %s.", rr.Body.String())
+			}
+			for i, menu := range page.Data {
+				if menu.Name != tt.args.outputNames[i] || menu.Language != tt.args.outputLanguages[i] {
+					t.Errorf("Handler returned wrong translation of menu %d. Expected: %s (%s). Got: %s (%s).", menu.ID, tt.args.outputNames[i], tt.args.outputLanguages[i], menu.Name, menu.Language)
+				}
+			}
+		})
+	}
+}
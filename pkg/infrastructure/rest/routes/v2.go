@@ -0,0 +1,34 @@
+// Package routes contains all routes of the application
+package routes
+
+import (
+	v2Controller "github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers/v2"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/middlewares"
+	"github.com/gin-gonic/gin"
+)
+
+// V2Routes is a function that contains all v2 routes
+func V2Routes(router *gin.RouterGroup, controller *v2Controller.Controller) {
+
+	routerMenu := router.Group("/menus")
+	{
+		routerMenu.POST("/", controller.NewMenu)
+		routerMenu.GET("/:id", middlewares.ConditionalGET(middlewares.CachePublic), controller.GetMenu)
+		routerMenu.GET("/", middlewares.ConditionalGET(middlewares.CachePublic), controller.GetMenus)
+		routerMenu.DELETE("/:id", controller.DeleteMenu)
+	}
+
+	routerDiner := router.Group("/diners")
+	{
+		routerDiner.POST("/", controller.NewDiner)
+		routerDiner.GET("/:id", middlewares.ConditionalGET(middlewares.CachePrivate), controller.GetDiner)
+		routerDiner.GET("/", middlewares.ConditionalGET(middlewares.CachePrivate), controller.GetDiners)
+		routerDiner.GET("/:id/orders", controller.GetDinerOrders)
+	}
+
+	routerOrder := router.Group("/orders")
+	{
+		routerOrder.POST("/", controller.NewOrder)
+	}
+
+}
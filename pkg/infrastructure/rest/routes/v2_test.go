@@ -0,0 +1,318 @@
+package routes_test
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	dinerService "github.com/Raj63/golang-rest-api/pkg/app/usecases/diner"
+	menuService "github.com/Raj63/golang-rest-api/pkg/app/usecases/menu"
+	orderService "github.com/Raj63/golang-rest-api/pkg/app/usecases/order"
+	domainDiner "github.com/Raj63/golang-rest-api/pkg/domain/diner"
+	appErr "github.com/Raj63/golang-rest-api/pkg/domain/errors"
+	domainMenu "github.com/Raj63/golang-rest-api/pkg/domain/menu"
+	domainOrder "github.com/Raj63/golang-rest-api/pkg/domain/order"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/repository"
+	v2Controller "github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers/v2"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/envelope"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/routes"
+	"github.com/brianvoe/gofakeit"
+	"github.com/golang/mock/gomock"
+)
+
+func TestV2Routes(t *testing.T) {
+
+	menus := []domainMenu.Menu{
+		{ID: 1, Name: gofakeit.BeerHop(), Description: gofakeit.BeerName(), Category: "briyani", Price: 200, CreatedAt: time.Now(), UpdatedAt: time.Now()},
+		{ID: 2, Name: gofakeit.BeerHop(), Description: gofakeit.BeerName(), Category: "briyani", Price: 280.5, CreatedAt: time.Now(), UpdatedAt: time.Now()},
+	}
+	diner := domainDiner.Diner{ID: 1, Name: gofakeit.Name(), TableNumber: 4, CreatedAt: time.Now(), UpdatedAt: time.Now()}
+	type args struct {
+		method       string
+		endpoint     string
+		body         interface{}
+		mockrepoFn   func() *v2Controller.Controller
+		outputStatus int
+		// outputError is the code of the first error of the envelope, none when empty
+		outputError string
+		outputField string
+		outputTotal bool
+		outputNext  bool
+	}
+	controller := func(r *repositoryMocks) *v2Controller.Controller {
+		return &v2Controller.Controller{
+			MenuService:  menuService.Service{MenuRepository: r.menus},
+			DinerService: dinerService.Service{DinerRepository: r.diners},
+			OrderService: orderService.Service{OrderRepository: r.orders},
+		}
+	}
+	noMocks := mocked(t, controller, noExpectations)
+
+	tests := []struct {
+		name string
+		args args
+	}{
+		{
+			name: "Add new Menu successfully",
+			args: args{
+				method:   "POST",
+				endpoint: "/v2/menus/",
+				body: v2Controller.NewMenuRequest{
+					Name:        menus[0].Name,
+					Description: menus[0].Description,
+					Price:       menus[0].Price,
+				},
+				outputStatus: http.StatusCreated,
+				mockrepoFn: mocked(t, controller, func(r *repositoryMocks) {
+					r.menus.EXPECT().Create(gomock.Any(), gomock.Any()).Times(1).Return(&menus[0], nil)
+				}),
+			},
+		},
+		{
+			name: "Add new Menu failed due to missing name validation error",
+			args: args{
+				method:   "POST",
+				endpoint: "/v2/menus/",
+				body: v2Controller.NewMenuRequest{
+					Description: menus[0].Description,
+					Price:       menus[0].Price,
+				},
+				outputStatus: http.StatusBadRequest,
+				outputError:  envelope.CodeValidation,
+				outputField:  "name",
+				mockrepoFn:   noMocks,
+			},
+		},
+		{
+			name: "Fetch first keyset page of Menus with the link to the next page successfully",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v2/menus/?limit=2",
+				outputStatus: http.StatusOK,
+				outputNext:   true,
+				mockrepoFn: mocked(t, controller, func(r *repositoryMocks) {
+					r.menus.EXPECT().GetPage(gomock.Any(), gomock.Any()).Times(1).Return(&repository.PaginationResultMenu{
+						Data:       &menus,
+						Limit:      2,
+						NextCursor: "next",
+					}, nil)
+				}),
+			},
+		},
+		{
+			name: "Fetch Menus by page number with the total successfully",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v2/menus/?page=1&limit=10",
+				outputStatus: http.StatusOK,
+				outputTotal:  true,
+				mockrepoFn: mocked(t, controller, func(r *repositoryMocks) {
+					r.menus.EXPECT().GetPage(gomock.Any(), gomock.Any()).Times(1).Return(&repository.PaginationResultMenu{
+						Data:     &menus,
+						Total:    2,
+						Limit:    10,
+						Current:  1,
+						NumPages: 1,
+					}, nil)
+				}),
+			},
+		},
+		{
+			name: "failed to fetch Menus due to negative limit",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v2/menus/?limit=-1",
+				outputStatus: http.StatusBadRequest,
+				outputError:  envelope.CodeValidation,
+				mockrepoFn:   noMocks,
+			},
+		},
+		{
+			name: "failed to fetch Menus due to repository error without disclosing it",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v2/menus/",
+				outputStatus: http.StatusInternalServerError,
+				outputError:  envelope.CodeInternal,
+				mockrepoFn: mocked(t, controller, func(r *repositoryMocks) {
+					r.menus.EXPECT().GetPage(gomock.Any(), gomock.Any()).Times(1).Return(nil, appErr.NewAppErrorWithType(appErr.RepositoryError))
+				}),
+			},
+		},
+		{
+			name: "Fetch Menu by ID successfully",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v2/menus/1",
+				outputStatus: http.StatusOK,
+				mockrepoFn: mocked(t, controller, func(r *repositoryMocks) {
+					r.menus.EXPECT().GetByID(gomock.Any(), int64(1)).Times(1).Return(&menus[0], nil)
+				}),
+			},
+		},
+		{
+			name: "failed to fetch Menu by ID due to invalid id",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v2/menus/abc",
+				outputStatus: http.StatusBadRequest,
+				outputError:  envelope.CodeValidation,
+				outputField:  "id",
+				mockrepoFn:   noMocks,
+			},
+		},
+		{
+			name: "failed to fetch Menu by ID due to missing menu",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v2/menus/9",
+				outputStatus: http.StatusNotFound,
+				outputError:  envelope.CodeNotFound,
+				mockrepoFn: mocked(t, controller, func(r *repositoryMocks) {
+					r.menus.EXPECT().GetByID(gomock.Any(), int64(9)).Times(1).Return(nil, sql.ErrNoRows)
+				}),
+			},
+		},
+		{
+			name: "Delete Menu with no content successfully",
+			args: args{
+				method:       "DELETE",
+				endpoint:     "/v2/menus/1",
+				outputStatus: http.StatusNoContent,
+				mockrepoFn: mocked(t, controller, func(r *repositoryMocks) {
+					r.menus.EXPECT().Delete(gomock.Any(), int64(1)).Times(1).Return(nil)
+				}),
+			},
+		},
+		{
+			name: "Fetch Diners searched by table successfully",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v2/diners/?table_no=4&with_total=true",
+				outputStatus: http.StatusOK,
+				outputTotal:  true,
+				mockrepoFn: mocked(t, controller, func(r *repositoryMocks) {
+					r.diners.EXPECT().SearchPage(gomock.Any(), &repository.DinerFilter{TableNumber: 4}, gomock.Any()).Times(1).Return(&repository.PaginationResultDiner{
+						Data:  &[]domainDiner.Diner{diner},
+						Total: 1,
+						Limit: 20,
+					}, nil)
+				}),
+			},
+		},
+		{
+			name: "failed to fetch Diners due to invalid table",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v2/diners/?table_no=four",
+				outputStatus: http.StatusBadRequest,
+				outputError:  envelope.CodeValidation,
+				outputField:  "table_no",
+				mockrepoFn:   noMocks,
+			},
+		},
+		{
+			name: "failed to fetch Diner by ID due to missing diner",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v2/diners/9",
+				outputStatus: http.StatusNotFound,
+				outputError:  envelope.CodeNotFound,
+				mockrepoFn: mocked(t, controller, func(r *repositoryMocks) {
+					r.diners.EXPECT().GetByID(gomock.Any(), int64(9)).Times(1).Return(nil, appErr.NewAppErrorWithType(appErr.NotFound))
+				}),
+			},
+		},
+		{
+			name: "Add new Order successfully",
+			args: args{
+				method:       "POST",
+				endpoint:     "/v2/orders/",
+				body:         v2Controller.NewOrderRequest{DinerID: 1, MenuID: 1, Quantity: 2},
+				outputStatus: http.StatusCreated,
+				mockrepoFn: mocked(t, controller, func(r *repositoryMocks) {
+					r.orders.EXPECT().Create(gomock.Any(), &domainOrder.Request{DinnerID: 1, MenuID: 1, Quantity: 2}).Times(1).Return(&domainOrder.Request{
+						ID: 1, DinnerID: 1, MenuID: 1, Quantity: 2, CreatedAt: time.Now(),
+					}, nil)
+				}),
+			},
+		},
+		{
+			name: "Fetch the Orders of every session of a Diner successfully",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v2/diners/1/orders?history=true",
+				outputStatus: http.StatusOK,
+				mockrepoFn: mocked(t, controller, func(r *repositoryMocks) {
+					r.orders.EXPECT().GetByID(gomock.Any(), int64(1)).Times(1).Return([]domainOrder.Response{
+						{ID: 1, SessionID: 1, DinerID: 1, MenuID: 1, Quantity: 2, CreatedAt: time.Now()},
+					}, nil)
+				}),
+			},
+		},
+		{
+			name: "failed to fetch the Orders of a Diner due to invalid history",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v2/diners/1/orders?history=maybe",
+				outputStatus: http.StatusBadRequest,
+				outputError:  envelope.CodeValidation,
+				outputField:  "history",
+				mockrepoFn:   noMocks,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if tt.args.body != nil {
+				err := json.NewEncoder(&buf).Encode(tt.args.body)
+				if err != nil {
+					log.Fatal(err)
+				}
+			}
+
+			req, err := http.NewRequest(tt.args.method, tt.args.endpoint, &buf)
+			if err != nil {
+				t.Errorf("Error creating a new request: %v", err)
+			}
+			rr := httptest.NewRecorder()
+			router, _ := getTestRouter()
+			routes.V2Routes(router.Group("/v2", envelope.ErrorHandler), tt.args.mockrepoFn())
+			router.ServeHTTP(rr, req)
+
+			if status := rr.Code; status != tt.args.outputStatus {
+				t.Errorf("Handler returned wrong status code. Expected: %d. Got: %d.", tt.args.outputStatus, status)
+			}
+			if tt.args.outputStatus == http.StatusNoContent {
+				if rr.Body.Len() > 0 {
+					t.Errorf("Handler returned a body with no content: %s", rr.Body.String())
+				}
+				return
+			}
+
+			var response envelope.Envelope
+			if err := json.Unmarshal(rr.Body.Bytes(), &response); err != nil {
+				t.Fatalf("Handler returned an invalid envelope: %v", err)
+			}
+			if tt.args.outputError == "" {
+				if len(response.Errors) > 0 || response.Data == nil || response.Links == nil {
+					t.Errorf("Handler returned wrong envelope. Expected data and links. Got: %s.", rr.Body.String())
+				}
+			} else if len(response.Errors) != 1 || response.Errors[0].Code != tt.args.outputError || response.Errors[0].Field != tt.args.outputField || response.Data != nil {
+				t.Errorf("Handler returned wrong errors. Expected: %s %q. Got: %s.", tt.args.outputError, tt.args.outputField, rr.Body.String())
+			}
+			if hasTotal := response.Meta != nil && response.Meta.Total != nil; hasTotal != tt.args.outputTotal {
+				t.Errorf("Handler returned wrong meta. Expected total: %t. Got: %s.", tt.args.outputTotal, rr.Body.String())
+			}
+			if hasNext := response.Links != nil && response.Links.Next != ""; hasNext != tt.args.outputNext {
+				t.Errorf("Handler returned wrong links. Expected next: %t. Got: %s.", tt.args.outputNext, rr.Body.String())
+			}
+		})
+	}
+}
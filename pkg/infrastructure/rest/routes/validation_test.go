@@ -0,0 +1,267 @@
+package routes_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	dinerService "github.com/Raj63/golang-rest-api/pkg/app/usecases/diner"
+	menuService "github.com/Raj63/golang-rest-api/pkg/app/usecases/menu"
+	orderService "github.com/Raj63/golang-rest-api/pkg/app/usecases/order"
+	domainDiner "github.com/Raj63/golang-rest-api/pkg/domain/diner"
+	domainErrors "github.com/Raj63/golang-rest-api/pkg/domain/errors"
+	domainMenu "github.com/Raj63/golang-rest-api/pkg/domain/menu"
+	domainOrder "github.com/Raj63/golang-rest-api/pkg/domain/order"
+	domainTable "github.com/Raj63/golang-rest-api/pkg/domain/table"
+	mockRepository "github.com/Raj63/golang-rest-api/pkg/infrastructure/mocks/repository"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers"
+	dinerController "github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers/diner"
+	menuController "github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers/menu"
+	orderController "github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers/order"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/routes"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+)
+
+func TestRequestValidation(t *testing.T) {
+
+	// the dining tables are numbered from 1 to 10
+	tables := make([]domainTable.Table, 10)
+	for i := range tables {
+		tables[i] = domainTable.Table{TableNumber: i + 1, Capacity: 4}
+	}
+
+	type args struct {
+		method   string
+		endpoint string
+		body     string
+		// acceptLanguage is the Accept-Language of the request, none when empty
+		acceptLanguage string
+		routesFn       func(router *gin.RouterGroup)
+		outputStatus   int
+		// outputErrors are the expected invalid fields and their messages, in order
+		outputErrors []domainErrors.FieldError
+	}
+	menuRoutes := func(created bool) func(router *gin.RouterGroup) {
+		return func(router *gin.RouterGroup) {
+			mRepository := mockRepository.NewMockMenus(gomock.NewController(t))
+			if created {
+				mRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Times(1).Return(&domainMenu.Menu{ID: 1}, nil)
+			}
+			routes.MenuRoutes(router, &menuController.Controller{MenuService: menuService.Service{MenuRepository: mRepository}})
+		}
+	}
+	orderRoutes := func(created bool) func(router *gin.RouterGroup) {
+		return func(router *gin.RouterGroup) {
+			oRepository := mockRepository.NewMockOrders(gomock.NewController(t))
+			if created {
+				oRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Times(1).Return(&domainOrder.Request{ID: 1}, nil)
+			}
+			routes.OrderRoutes(router, &orderController.Controller{OrderService: orderService.Service{OrderRepository: oRepository}})
+		}
+	}
+	dinerRoutes := func(created bool) func(router *gin.RouterGroup) {
+		return func(router *gin.RouterGroup) {
+			dRepository := mockRepository.NewMockDiners(gomock.NewController(t))
+			if created {
+				dRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Times(1).Return(&domainDiner.Diner{ID: 1}, nil)
+			}
+			tRepository := mockRepository.NewMockTables(gomock.NewController(t))
+			tRepository.EXPECT().GetAll(gomock.Any()).AnyTimes().Return(tables, nil)
+			routes.DinerRoutes(router, &dinerController.Controller{DinerService: dinerService.Service{DinerRepository: dRepository, TableRepository: tRepository}})
+		}
+	}
+
+	tests := []struct {
+		name string
+		args args
+	}{
+		{
+			name: "Menu priced in whole cents is valid",
+			args: args{
+				method:       "POST",
+				endpoint:     "/v1/menus/",
+				body:         `{"name": "Dum Briyani", "description": "Hyderabadi", "price": 200.5}`,
+				routesFn:     menuRoutes(true),
+				outputStatus: http.StatusCreated,
+			},
+		},
+		{
+			name: "Menu priced with fractions of a cent is invalid",
+			args: args{
+				method:       "POST",
+				endpoint:     "/v1/menus/",
+				body:         `{"name": "Dum Briyani", "description": "Hyderabadi", "price": 200.555}`,
+				routesFn:     menuRoutes(false),
+				outputStatus: http.StatusBadRequest,
+				outputErrors: []domainErrors.FieldError{{Field: "price", Message: "price must be a positive amount with at most 2 decimals"}},
+			},
+		},
+		{
+			name: "Menu with a negative price and no name is invalid on both fields",
+			args: args{
+				method:       "POST",
+				endpoint:     "/v1/menus/",
+				body:         `{"description": "Hyderabadi", "price": -10}`,
+				routesFn:     menuRoutes(false),
+				outputStatus: http.StatusBadRequest,
+				outputErrors: []domainErrors.FieldError{
+					{Field: "name", Message: "name is required"},
+					{Field: "price", Message: "price must be a positive amount with at most 2 decimals"},
+				},
+			},
+		},
+		{
+			name: "Order of a negative quantity is invalid",
+			args: args{
+				method:       "POST",
+				endpoint:     "/v1/orders/",
+				body:         `{"diner_id": 1, "menu_id": 3, "quantity": -2}`,
+				routesFn:     orderRoutes(false),
+				outputStatus: http.StatusBadRequest,
+				outputErrors: []domainErrors.FieldError{{Field: "quantity", Message: "quantity must be at least 1"}},
+			},
+		},
+		{
+			name: "Order of more than 50 of a menu is invalid",
+			args: args{
+				method:       "POST",
+				endpoint:     "/v1/orders/",
+				body:         `{"diner_id": 1, "menu_id": 3, "quantity": 51}`,
+				routesFn:     orderRoutes(false),
+				outputStatus: http.StatusBadRequest,
+				outputErrors: []domainErrors.FieldError{{Field: "quantity", Message: "quantity must be at most 50"}},
+			},
+		},
+		{
+			name: "Order of 50 of a menu is valid",
+			args: args{
+				method:       "POST",
+				endpoint:     "/v1/orders/",
+				body:         `{"diner_id": 1, "menu_id": 3, "quantity": 50}`,
+				routesFn:     orderRoutes(true),
+				outputStatus: http.StatusCreated,
+			},
+		},
+		{
+			name: "Diner at an existing table is valid",
+			args: args{
+				method:       "POST",
+				endpoint:     "/v1/diners/",
+				body:         `{"name": "Mr. Smith", "table_no": 4}`,
+				routesFn:     dinerRoutes(true),
+				outputStatus: http.StatusCreated,
+			},
+		},
+		{
+			name: "Diner at a missing table is invalid",
+			args: args{
+				method:       "POST",
+				endpoint:     "/v1/diners/",
+				body:         `{"name": "Mr. Smith", "table_no": 42}`,
+				routesFn:     dinerRoutes(false),
+				outputStatus: http.StatusBadRequest,
+				outputErrors: []domainErrors.FieldError{{Field: "table_no", Message: "table_no 42 does not exist"}},
+			},
+		},
+		{
+			name: "Diner update without name nor table is invalid",
+			args: args{
+				method:       "PATCH",
+				endpoint:     "/v1/diners/1",
+				body:         `{}`,
+				routesFn:     dinerRoutes(false),
+				outputStatus: http.StatusBadRequest,
+				outputErrors: []domainErrors.FieldError{{Field: "name", Message: "name is required when table_no is not given"}},
+			},
+		},
+		{
+			name: "Diner update to a missing table is invalid",
+			args: args{
+				method:       "PATCH",
+				endpoint:     "/v1/diners/1",
+				body:         `{"table_no": 11}`,
+				routesFn:     dinerRoutes(false),
+				outputStatus: http.StatusBadRequest,
+				outputErrors: []domainErrors.FieldError{{Field: "table_no", Message: "table_no 11 does not exist"}},
+			},
+		},
+		{
+			name: "Messages are in the language of the request",
+			args: args{
+				method:         "POST",
+				endpoint:       "/v1/menus/",
+				body:           `{"description": "Hyderabadi", "price": -10}`,
+				acceptLanguage: "fr-CH, fr;q=0.9, en;q=0.8",
+				routesFn:       menuRoutes(false),
+				outputStatus:   http.StatusBadRequest,
+				outputErrors: []domainErrors.FieldError{
+					{Field: "name", Message: "name est obligatoire"},
+					{Field: "price", Message: "price doit être un montant positif avec au plus 2 décimales"},
+				},
+			},
+		},
+		{
+			name: "Messages of a value of the wrong type are in the language of the request",
+			args: args{
+				method:         "POST",
+				endpoint:       "/v1/orders/",
+				body:           `{"diner_id": 1, "menu_id": 3, "quantity": "two"}`,
+				acceptLanguage: "de",
+				routesFn:       orderRoutes(false),
+				outputStatus:   http.StatusBadRequest,
+				outputErrors:   []domainErrors.FieldError{{Field: "quantity", Message: "quantity muss eine ganze Zahl sein"}},
+			},
+		},
+		{
+			name: "Messages fall back to english for the languages without a catalog",
+			args: args{
+				method:         "PATCH",
+				endpoint:       "/v1/diners/1",
+				body:           `{"table_no": 11}`,
+				acceptLanguage: "ja, *;q=0.5",
+				routesFn:       dinerRoutes(false),
+				outputStatus:   http.StatusBadRequest,
+				outputErrors:   []domainErrors.FieldError{{Field: "table_no", Message: "table_no 11 does not exist"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(tt.args.method, tt.args.endpoint, bytes.NewBufferString(tt.args.body))
+			if err != nil {
+				t.Errorf("Error creating a new request: %v", err)
+			}
+			if tt.args.acceptLanguage != "" {
+				req.Header.Set("Accept-Language", tt.args.acceptLanguage)
+			}
+			rr := httptest.NewRecorder()
+			router, routerV1 := getTestRouter()
+			tt.args.routesFn(routerV1)
+			router.ServeHTTP(rr, req)
+			assertContract(t, req, rr)
+
+			if status := rr.Code; status != tt.args.outputStatus {
+				t.Errorf("Handler returned wrong status code. Expected: %d. Got: %d.", tt.args.outputStatus, status)
+			}
+			if tt.args.outputStatus != http.StatusBadRequest {
+				return
+			}
+
+			var problem controllers.ProblemDetails
+			if err := json.Unmarshal(rr.Body.Bytes(), &problem); err != nil {
+				t.Fatalf("Handler returned invalid problem details: %v", err)
+			}
+			if len(problem.Errors) != len(tt.args.outputErrors) {
+				t.Fatalf("Handler returned wrong errors. Expected: %v. Got: %v.", tt.args.outputErrors, problem.Errors)
+			}
+			for i := range problem.Errors {
+				if problem.Errors[i] != tt.args.outputErrors[i] {
+					t.Errorf("Handler returned wrong errors. Expected: %v. Got: %v.", tt.args.outputErrors, problem.Errors)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,21 @@
+// Package routes contains all routes of the application
+package routes
+
+import (
+	waitlistController "github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers/waitlist"
+	"github.com/gin-gonic/gin"
+)
+
+// WaitlistRoutes is a function that contains all waitlist routes
+func WaitlistRoutes(router *gin.RouterGroup, controller *waitlistController.Controller) {
+
+	routerWaitlist := router.Group("/waitlist")
+	{
+		routerWaitlist.POST("/", controller.NewEntry)
+		routerWaitlist.GET("/", controller.GetWaitlist)
+		routerWaitlist.GET("/:id", controller.GetEntryByID)
+		routerWaitlist.PATCH("/:id/status", controller.UpdateEntryStatus)
+		routerWaitlist.POST("/tables/:table_no/free", controller.TableFreed)
+	}
+
+}
@@ -0,0 +1,310 @@
+// Package routes contains all routes of the application
+package routes_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	waitlistService "github.com/Raj63/golang-rest-api/pkg/app/usecases/waitlist"
+	appErr "github.com/Raj63/golang-rest-api/pkg/domain/errors"
+	domainReservation "github.com/Raj63/golang-rest-api/pkg/domain/reservation"
+	domainTable "github.com/Raj63/golang-rest-api/pkg/domain/table"
+	domainWaitlist "github.com/Raj63/golang-rest-api/pkg/domain/waitlist"
+	mockRepository "github.com/Raj63/golang-rest-api/pkg/infrastructure/mocks/repository"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/repository"
+	waitlistController "github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers/waitlist"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/routes"
+	"github.com/brianvoe/gofakeit"
+	"github.com/golang/mock/gomock"
+)
+
+type nopNotifier struct{}
+
+func (nopNotifier) Notify(context.Context, *domainWaitlist.Entry) error {
+	return nil
+}
+
+// failingNotifier cannot reach the guests
+type failingNotifier struct{}
+
+func (failingNotifier) Notify(context.Context, *domainWaitlist.Entry) error {
+	return errors.New("sms gateway unavailable")
+}
+
+func TestWaitlistRoutes(t *testing.T) {
+
+	guestName := gofakeit.Name()
+	guestContact := gofakeit.Phone()
+	tables := []domainTable.Table{
+		{TableNumber: 1, Capacity: 2, Section: "window"},
+		{TableNumber: 3, Capacity: 4, Section: "main"},
+	}
+	waiting := domainWaitlist.Entry{
+		ID:        1,
+		Name:      guestName,
+		Contact:   guestContact,
+		PartySize: 2,
+		Status:    domainWaitlist.StatusWaiting,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	waiting2 := domainWaitlist.Entry{
+		ID:        2,
+		Name:      gofakeit.Name(),
+		Contact:   gofakeit.Phone(),
+		PartySize: 4,
+		Status:    domainWaitlist.StatusWaiting,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	type repositories struct {
+		waitlist     repository.Waitlist
+		reservations repository.Reservations
+		tables       repository.Tables
+	}
+	type args struct {
+		method       string
+		endpoint     string
+		body         interface{}
+		mockrepoFn   func() repositories
+		notifier     domainWaitlist.Notifier
+		outputStatus int
+	}
+	estimating := func(w *mockRepository.MockWaitlist) repositories {
+		rRepository := mockRepository.NewMockReservations(gomock.NewController(t))
+		tRepository := mockRepository.NewMockTables(gomock.NewController(t))
+		tRepository.EXPECT().GetAll(gomock.Any()).AnyTimes().Return(tables, nil)
+		tRepository.EXPECT().GetByMinCapacity(gomock.Any(), gomock.Any()).AnyTimes().Return(tables, nil)
+		w.EXPECT().GetTurnDurations(gomock.Any(), gomock.Any()).AnyTimes().Return([]domainWaitlist.TurnDuration{{Capacity: 4, AverageMinutes: 60}}, nil)
+		rRepository.EXPECT().GetOccupancies(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return([]domainReservation.Occupancy{
+			{TableNumber: 3, StartAt: time.Now().Add(-30 * time.Minute), EndAt: time.Now().Add(time.Hour)},
+		}, nil)
+		return repositories{waitlist: w, reservations: rRepository, tables: tRepository}
+	}
+
+	tests := []struct {
+		name string
+		args args
+	}{
+		{
+			name: "Add new Waitlist entry successfully",
+			args: args{
+				method:   "POST",
+				endpoint: "/v1/waitlist/",
+				body: waitlistController.NewEntryRequest{
+					Name:      guestName,
+					Contact:   guestContact,
+					PartySize: 2,
+				},
+				outputStatus: http.StatusCreated,
+				mockrepoFn: func() repositories {
+					wRepository := mockRepository.NewMockWaitlist(gomock.NewController(t))
+					wRepository.EXPECT().Create(gomock.Any(), gomock.Any()).AnyTimes().Return(&waiting, nil)
+					wRepository.EXPECT().GetByID(gomock.Any(), waiting.ID).AnyTimes().Return(&waiting, nil)
+					wRepository.EXPECT().GetActive(gomock.Any()).AnyTimes().Return([]domainWaitlist.Entry{waiting}, nil)
+					return estimating(wRepository)
+				},
+			},
+		},
+		{
+			name: "Add new Waitlist entry failed due to missing contact validation error",
+			args: args{
+				method:   "POST",
+				endpoint: "/v1/waitlist/",
+				body: waitlistController.NewEntryRequest{
+					Name:      guestName,
+					PartySize: 2,
+				},
+				outputStatus: http.StatusBadRequest,
+				mockrepoFn: func() repositories {
+					return estimating(mockRepository.NewMockWaitlist(gomock.NewController(t)))
+				},
+			},
+		},
+		{
+			name: "Fetch Waitlist successfully",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v1/waitlist/",
+				outputStatus: http.StatusOK,
+				mockrepoFn: func() repositories {
+					wRepository := mockRepository.NewMockWaitlist(gomock.NewController(t))
+					wRepository.EXPECT().GetActive(gomock.Any()).AnyTimes().Return([]domainWaitlist.Entry{waiting, waiting2}, nil)
+					return estimating(wRepository)
+				},
+			},
+		},
+		{
+			name: "Failed to fetch Waitlist due to repository error",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v1/waitlist/",
+				outputStatus: http.StatusInternalServerError,
+				mockrepoFn: func() repositories {
+					wRepository := mockRepository.NewMockWaitlist(gomock.NewController(t))
+					wRepository.EXPECT().GetActive(gomock.Any()).AnyTimes().Return(nil, appErr.NewAppErrorWithType(appErr.RepositoryError))
+					return estimating(wRepository)
+				},
+			},
+		},
+		{
+			name: "Fetch Waitlist entry by ID successfully",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v1/waitlist/2",
+				outputStatus: http.StatusOK,
+				mockrepoFn: func() repositories {
+					wRepository := mockRepository.NewMockWaitlist(gomock.NewController(t))
+					wRepository.EXPECT().GetByID(gomock.Any(), waiting2.ID).AnyTimes().Return(&waiting2, nil)
+					wRepository.EXPECT().GetActive(gomock.Any()).AnyTimes().Return([]domainWaitlist.Entry{waiting, waiting2}, nil)
+					return estimating(wRepository)
+				},
+			},
+		},
+		{
+			name: "Failed to fetch Waitlist entry by ID due to missing record",
+			args: args{
+				method:       "GET",
+				endpoint:     "/v1/waitlist/3",
+				outputStatus: http.StatusNotFound,
+				mockrepoFn: func() repositories {
+					wRepository := mockRepository.NewMockWaitlist(gomock.NewController(t))
+					wRepository.EXPECT().GetByID(gomock.Any(), gomock.Any()).AnyTimes().Return(nil, appErr.NewAppErrorWithType(appErr.NotFound))
+					return estimating(wRepository)
+				},
+			},
+		},
+		{
+			name: "Update Waitlist entry status successfully",
+			args: args{
+				method:       "PATCH",
+				endpoint:     "/v1/waitlist/1/status",
+				body:         waitlistController.UpdateStatusRequest{Status: domainWaitlist.StatusLeft},
+				outputStatus: http.StatusOK,
+				mockrepoFn: func() repositories {
+					wRepository := mockRepository.NewMockWaitlist(gomock.NewController(t))
+					current := waiting
+					wRepository.EXPECT().GetByID(gomock.Any(), waiting.ID).AnyTimes().Return(&current, nil)
+					wRepository.EXPECT().UpdateStatus(gomock.Any(), waiting.ID, domainWaitlist.StatusLeft).AnyTimes().Return(nil)
+					return estimating(wRepository)
+				},
+			},
+		},
+		{
+			name: "Failed to update Waitlist entry status due to invalid status",
+			args: args{
+				method:       "PATCH",
+				endpoint:     "/v1/waitlist/1/status",
+				body:         waitlistController.UpdateStatusRequest{Status: domainWaitlist.StatusNotified},
+				outputStatus: http.StatusBadRequest,
+				mockrepoFn: func() repositories {
+					wRepository := mockRepository.NewMockWaitlist(gomock.NewController(t))
+					current := waiting
+					wRepository.EXPECT().GetByID(gomock.Any(), waiting.ID).AnyTimes().Return(&current, nil)
+					return estimating(wRepository)
+				},
+			},
+		},
+		{
+			name: "Notify the next party of a freed table successfully",
+			args: args{
+				method:       "POST",
+				endpoint:     "/v1/waitlist/tables/3/free",
+				outputStatus: http.StatusOK,
+				mockrepoFn: func() repositories {
+					wRepository := mockRepository.NewMockWaitlist(gomock.NewController(t))
+					wRepository.EXPECT().GetActive(gomock.Any()).AnyTimes().Return([]domainWaitlist.Entry{waiting, waiting2}, nil)
+					wRepository.EXPECT().MarkNotified(gomock.Any(), waiting.ID, 3).Times(1).Return(nil)
+					return estimating(wRepository)
+				},
+			},
+		},
+		{
+			name: "Notify the party after the one claimed by another freed table",
+			args: args{
+				method:       "POST",
+				endpoint:     "/v1/waitlist/tables/3/free",
+				outputStatus: http.StatusOK,
+				mockrepoFn: func() repositories {
+					wRepository := mockRepository.NewMockWaitlist(gomock.NewController(t))
+					wRepository.EXPECT().GetActive(gomock.Any()).AnyTimes().Return([]domainWaitlist.Entry{waiting, waiting2}, nil)
+					wRepository.EXPECT().MarkNotified(gomock.Any(), waiting.ID, 3).Times(1).Return(appErr.NewAppErrorWithType(appErr.NotFound))
+					wRepository.EXPECT().MarkNotified(gomock.Any(), waiting2.ID, 3).Times(1).Return(nil)
+					return estimating(wRepository)
+				},
+			},
+		},
+		{
+			name: "Failed to notify the next party puts it back in the queue",
+			args: args{
+				method:       "POST",
+				endpoint:     "/v1/waitlist/tables/3/free",
+				notifier:     failingNotifier{},
+				outputStatus: http.StatusInternalServerError,
+				mockrepoFn: func() repositories {
+					wRepository := mockRepository.NewMockWaitlist(gomock.NewController(t))
+					wRepository.EXPECT().GetActive(gomock.Any()).AnyTimes().Return([]domainWaitlist.Entry{waiting, waiting2}, nil)
+					gomock.InOrder(
+						wRepository.EXPECT().MarkNotified(gomock.Any(), waiting.ID, 3).Times(1).Return(nil),
+						wRepository.EXPECT().ReleaseNotified(gomock.Any(), waiting.ID).Times(1).Return(nil),
+					)
+					return estimating(wRepository)
+				},
+			},
+		},
+		{
+			name: "Failed to notify the next party due to unknown table",
+			args: args{
+				method:       "POST",
+				endpoint:     "/v1/waitlist/tables/42/free",
+				outputStatus: http.StatusNotFound,
+				mockrepoFn: func() repositories {
+					return estimating(mockRepository.NewMockWaitlist(gomock.NewController(t)))
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if tt.args.body != nil {
+				err := json.NewEncoder(&buf).Encode(tt.args.body)
+				if err != nil {
+					log.Fatal(err)
+				}
+			}
+
+			req, err := http.NewRequest(tt.args.method, tt.args.endpoint, &buf)
+			if err != nil {
+				t.Errorf("Error creating a new request: %v", err)
+			}
+			rr := httptest.NewRecorder()
+			router, routerV1 := getTestRouter()
+			repositories := tt.args.mockrepoFn()
+			notifier := tt.args.notifier
+			if notifier == nil {
+				notifier = nopNotifier{}
+			}
+			routes.WaitlistRoutes(routerV1, &waitlistController.Controller{WaitlistService: waitlistService.Service{
+				WaitlistRepository:    repositories.waitlist,
+				ReservationRepository: repositories.reservations,
+				TableRepository:       repositories.tables,
+				Notifier:              notifier,
+			}})
+			router.ServeHTTP(rr, req)
+			assertContract(t, req, rr)
+
+			if status := rr.Code; status != tt.args.outputStatus {
+				t.Errorf("Handler returned wrong status code. Expected: %d. Got: %d.", tt.args.outputStatus, status)
+			}
+		})
+	}
+}
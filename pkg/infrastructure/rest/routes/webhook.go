@@ -0,0 +1,23 @@
+// Package routes contains all routes of the application
+package routes
+
+import (
+	webhookController "github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers/webhook"
+	"github.com/gin-gonic/gin"
+)
+
+// WebhookRoutes is a function that contains all webhook routes, which require the requests to pass auth
+func WebhookRoutes(router *gin.RouterGroup, controller *webhookController.Controller, auth gin.HandlerFunc) {
+
+	routerWebhook := router.Group("/webhooks", auth)
+	{
+		routerWebhook.POST("/", controller.NewSubscription)
+		routerWebhook.GET("/", controller.GetAllSubscriptions)
+		routerWebhook.GET("/:id", controller.GetSubscriptionByID)
+		routerWebhook.DELETE("/:id", controller.DeleteSubscription)
+		routerWebhook.GET("/:id/deliveries", controller.GetDeliveries)
+		routerWebhook.GET("/deliveries/:id", controller.GetDelivery)
+		routerWebhook.POST("/deliveries/:id/replay", controller.ReplayDelivery)
+	}
+
+}
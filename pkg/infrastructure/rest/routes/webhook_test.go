@@ -0,0 +1,779 @@
+package routes_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	menuService "github.com/Raj63/golang-rest-api/pkg/app/usecases/menu"
+	orderService "github.com/Raj63/golang-rest-api/pkg/app/usecases/order"
+	webhookService "github.com/Raj63/golang-rest-api/pkg/app/usecases/webhook"
+	appErr "github.com/Raj63/golang-rest-api/pkg/domain/errors"
+	domainMenu "github.com/Raj63/golang-rest-api/pkg/domain/menu"
+	domainOrder "github.com/Raj63/golang-rest-api/pkg/domain/order"
+	domainWebhook "github.com/Raj63/golang-rest-api/pkg/domain/webhook"
+	mockRepository "github.com/Raj63/golang-rest-api/pkg/infrastructure/mocks/repository"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/repository"
+	menuController "github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers/menu"
+	orderController "github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers/order"
+	webhookController "github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/controllers/webhook"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/middlewares"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/routes"
+	"github.com/golang/mock/gomock"
+)
+
+// webhookReceiver is a local partner endpoint that checks the signature of the deliveries and answers them with its
+// statuses in turn, the last one over and over
+type webhookReceiver struct {
+	*httptest.Server
+	mu       sync.Mutex
+	statuses []int
+	// received are the notifications of the deliveries correctly signed, unsigned counts the others
+	received []domainWebhook.Notification
+	unsigned int
+}
+
+func newWebhookReceiver(t *testing.T, secret string, statuses ...int) *webhookReceiver {
+	receiver := &webhookReceiver{statuses: statuses}
+	receiver.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receiver.mu.Lock()
+		defer receiver.mu.Unlock()
+
+		body, _ := io.ReadAll(r.Body)
+		timestamp, err := strconv.ParseInt(r.Header.Get(domainWebhook.HeaderTimestamp), 10, 64)
+		if err != nil || time.Since(time.Unix(timestamp, 0)) > 5*time.Minute ||
+			!domainWebhook.Verify(secret, timestamp, body, r.Header.Get(domainWebhook.HeaderSignature)) {
+			receiver.unsigned++
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		var notification domainWebhook.Notification
+		if err := json.Unmarshal(body, &notification); err != nil || notification.Event != r.Header.Get(domainWebhook.HeaderEvent) {
+			t.Errorf("Receiver got an invalid notification: %s", body)
+		}
+		receiver.received = append(receiver.received, notification)
+
+		status := receiver.statuses[0]
+		if len(receiver.statuses) > 1 {
+			receiver.statuses = receiver.statuses[1:]
+		}
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(receiver.Close)
+	return receiver
+}
+
+func (r *webhookReceiver) count() (int, int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.received), r.unsigned
+}
+
+// partnerResolver resolves the hosts of the partners the tests subscribe to, public or not, without a DNS server
+type partnerResolver map[string]string
+
+func (r partnerResolver) LookupIPAddr(_ context.Context, host string) ([]net.IPAddr, error) {
+	ip, ok := r[host]
+	if !ok {
+		return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+	}
+	return []net.IPAddr{{IP: net.ParseIP(ip)}}, nil
+}
+
+var testPartners = partnerResolver{
+	"partner.example.com":  "93.184.216.34",
+	"intranet.example.com": "10.0.0.12",
+}
+
+func TestWebhookRoutes(t *testing.T) {
+
+	secret := "0123456789abcdef0123456789abcdef"
+	subscription := func(url string) *domainWebhook.Subscription {
+		return &domainWebhook.Subscription{
+			ID:        1,
+			URL:       url,
+			Events:    []string{domainWebhook.EventOrderCreated, domainWebhook.EventOrderServed},
+			Secret:    secret,
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+		}
+	}
+	payload := json.RawMessage(`{"event":"order.created","occurred_at":"2026-10-19T18:00:00Z","data":{"id":7}}`)
+	dead := func() *domainWebhook.Delivery {
+		return &domainWebhook.Delivery{
+			ID:             5,
+			SubscriptionID: 1,
+			Event:          domainWebhook.EventOrderCreated,
+			Payload:        payload,
+			Status:         domainWebhook.StatusDead,
+			Attempts:       webhookService.MaxAttempts,
+			LastStatusCode: http.StatusServiceUnavailable,
+			LastError:      "unexpected status 503",
+			CreatedAt:      time.Now(),
+			UpdatedAt:      time.Now(),
+			Log:            []domainWebhook.Attempt{{ID: 1, DeliveryID: 5, StatusCode: http.StatusServiceUnavailable, Error: "unexpected status 503", DurationMs: 12}},
+		}
+	}
+
+	type args struct {
+		method   string
+		endpoint string
+		body     string
+		// receiverStatuses are the statuses the partner answers with, in turn
+		receiverStatuses []int
+		mockrepoFn       func(receiverURL string) repository.Webhooks
+		outputStatus     int
+		// outputReceived is the number of signed deliveries the partner receives
+		outputReceived int
+		// outputSecret tells whether the response shows the secret
+		outputSecret bool
+		// noToken sends the request without a token
+		noToken bool
+	}
+
+	tests := []struct {
+		name string
+		args args
+	}{
+		{
+			name: "Subscribe to events successfully with a random secret",
+			args: args{
+				method:   "POST",
+				endpoint: "/v1/webhooks/",
+				body:     `{"url": "https://partner.example.com/webhooks", "events": ["order.created", "menu.deleted", "order.created"]}`,
+				mockrepoFn: func(string) repository.Webhooks {
+					wRepository := mockRepository.NewMockWebhooks(gomock.NewController(t))
+					wRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Times(1).
+						DoAndReturn(func(_ context.Context, subscription *domainWebhook.Subscription) (*domainWebhook.Subscription, error) {
+							if len(subscription.Events) != 2 || len(subscription.Secret) != 64 {
+								t.Errorf("Subscription created with wrong events or secret: %v, %q", subscription.Events, subscription.Secret)
+							}
+							subscription.ID = 1
+							return subscription, nil
+						})
+					return wRepository
+				},
+				outputStatus: http.StatusCreated,
+				outputSecret: true,
+			},
+		},
+		{
+			name: "Subscribe to an unknown event failed due to validation error",
+			args: args{
+				method:   "POST",
+				endpoint: "/v1/webhooks/",
+				body:     `{"url": "https://partner.example.com/webhooks", "events": ["order.eaten"]}`,
+				mockrepoFn: func(string) repository.Webhooks {
+					return mockRepository.NewMockWebhooks(gomock.NewController(t))
+				},
+				outputStatus: http.StatusBadRequest,
+			},
+		},
+		{
+			name: "Subscribe a URL other than http failed due to validation error",
+			args: args{
+				method:   "POST",
+				endpoint: "/v1/webhooks/",
+				body:     `{"url": "ftp://partner.example.com/webhooks", "events": ["order.created"]}`,
+				mockrepoFn: func(string) repository.Webhooks {
+					return mockRepository.NewMockWebhooks(gomock.NewController(t))
+				},
+				outputStatus: http.StatusBadRequest,
+			},
+		},
+		{
+			name: "Subscribe a loopback URL failed due to validation error",
+			args: args{
+				method:   "POST",
+				endpoint: "/v1/webhooks/",
+				body:     `{"url": "http://127.0.0.1:8080/v1/menus/", "events": ["order.created"]}`,
+				mockrepoFn: func(string) repository.Webhooks {
+					return mockRepository.NewMockWebhooks(gomock.NewController(t))
+				},
+				outputStatus: http.StatusBadRequest,
+			},
+		},
+		{
+			name: "Subscribe a link-local URL failed due to validation error",
+			args: args{
+				method:   "POST",
+				endpoint: "/v1/webhooks/",
+				body:     `{"url": "http://169.254.169.254/latest/meta-data/", "events": ["order.created"]}`,
+				mockrepoFn: func(string) repository.Webhooks {
+					return mockRepository.NewMockWebhooks(gomock.NewController(t))
+				},
+				outputStatus: http.StatusBadRequest,
+			},
+		},
+		{
+			name: "Subscribe a host resolving to a private address failed due to validation error",
+			args: args{
+				method:   "POST",
+				endpoint: "/v1/webhooks/",
+				body:     `{"url": "https://intranet.example.com/webhooks", "events": ["order.created"]}`,
+				mockrepoFn: func(string) repository.Webhooks {
+					return mockRepository.NewMockWebhooks(gomock.NewController(t))
+				},
+				outputStatus: http.StatusBadRequest,
+			},
+		},
+		{
+			name: "Subscribe without a token failed due to unauthorized",
+			args: args{
+				method:   "POST",
+				endpoint: "/v1/webhooks/",
+				body:     `{"url": "https://partner.example.com/webhooks", "events": ["order.created"]}`,
+				noToken:  true,
+				mockrepoFn: func(string) repository.Webhooks {
+					return mockRepository.NewMockWebhooks(gomock.NewController(t))
+				},
+				outputStatus: http.StatusUnauthorized,
+			},
+		},
+		{
+			name: "Subscribe with a short secret failed due to validation error",
+			args: args{
+				method:   "POST",
+				endpoint: "/v1/webhooks/",
+				body:     `{"url": "https://partner.example.com/webhooks", "events": ["order.created"], "secret": "short"}`,
+				mockrepoFn: func(string) repository.Webhooks {
+					return mockRepository.NewMockWebhooks(gomock.NewController(t))
+				},
+				outputStatus: http.StatusBadRequest,
+			},
+		},
+		{
+			name: "Get all subscriptions without their secret",
+			args: args{
+				method:   "GET",
+				endpoint: "/v1/webhooks/",
+				mockrepoFn: func(string) repository.Webhooks {
+					wRepository := mockRepository.NewMockWebhooks(gomock.NewController(t))
+					wRepository.EXPECT().GetAll(gomock.Any()).Times(1).Return([]domainWebhook.Subscription{*subscription("https://partner.example.com/webhooks")}, nil)
+					return wRepository
+				},
+				outputStatus: http.StatusOK,
+			},
+		},
+		{
+			name: "Get subscription by id failed due to not found",
+			args: args{
+				method:   "GET",
+				endpoint: "/v1/webhooks/9",
+				mockrepoFn: func(string) repository.Webhooks {
+					wRepository := mockRepository.NewMockWebhooks(gomock.NewController(t))
+					wRepository.EXPECT().GetByID(gomock.Any(), int64(9)).Times(1).Return(nil, appErr.NewAppErrorWithType(appErr.NotFound))
+					return wRepository
+				},
+				outputStatus: http.StatusNotFound,
+			},
+		},
+		{
+			name: "Delete subscription successfully",
+			args: args{
+				method:   "DELETE",
+				endpoint: "/v1/webhooks/1",
+				mockrepoFn: func(string) repository.Webhooks {
+					wRepository := mockRepository.NewMockWebhooks(gomock.NewController(t))
+					wRepository.EXPECT().Delete(gomock.Any(), int64(1)).Times(1).Return(nil)
+					return wRepository
+				},
+				outputStatus: http.StatusNoContent,
+			},
+		},
+		{
+			name: "Get the dead deliveries of a subscription",
+			args: args{
+				method:   "GET",
+				endpoint: "/v1/webhooks/1/deliveries?status=dead",
+				mockrepoFn: func(string) repository.Webhooks {
+					wRepository := mockRepository.NewMockWebhooks(gomock.NewController(t))
+					wRepository.EXPECT().GetByID(gomock.Any(), int64(1)).Times(1).Return(subscription("https://partner.example.com/webhooks"), nil)
+					delivery := dead()
+					delivery.Log = nil
+					wRepository.EXPECT().GetDeliveries(gomock.Any(), int64(1), domainWebhook.StatusDead).Times(1).Return([]domainWebhook.Delivery{*delivery}, nil)
+					return wRepository
+				},
+				outputStatus: http.StatusOK,
+			},
+		},
+		{
+			name: "Get the deliveries of a subscription failed due to unknown status",
+			args: args{
+				method:   "GET",
+				endpoint: "/v1/webhooks/1/deliveries?status=lost",
+				mockrepoFn: func(string) repository.Webhooks {
+					return mockRepository.NewMockWebhooks(gomock.NewController(t))
+				},
+				outputStatus: http.StatusBadRequest,
+			},
+		},
+		{
+			name: "Get a delivery with the log of its attempts",
+			args: args{
+				method:   "GET",
+				endpoint: "/v1/webhooks/deliveries/5",
+				mockrepoFn: func(string) repository.Webhooks {
+					wRepository := mockRepository.NewMockWebhooks(gomock.NewController(t))
+					wRepository.EXPECT().GetDelivery(gomock.Any(), int64(5)).Times(1).Return(dead(), nil)
+					return wRepository
+				},
+				outputStatus: http.StatusOK,
+			},
+		},
+		{
+			name: "Replay a dead delivery successfully",
+			args: args{
+				method:           "POST",
+				endpoint:         "/v1/webhooks/deliveries/5/replay",
+				receiverStatuses: []int{http.StatusNoContent},
+				mockrepoFn: func(receiverURL string) repository.Webhooks {
+					wRepository := mockRepository.NewMockWebhooks(gomock.NewController(t))
+					wRepository.EXPECT().GetByID(gomock.Any(), int64(1)).Times(1).Return(subscription(receiverURL), nil)
+					replayed := dead()
+					wRepository.EXPECT().RecordAttempt(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).
+						DoAndReturn(func(_ context.Context, delivery *domainWebhook.Delivery, attempt *domainWebhook.Attempt) error {
+							if delivery.Status != domainWebhook.StatusSucceeded || delivery.Attempts != 1 || delivery.DeliveredAt == nil {
+								t.Errorf("Replay recorded a wrong delivery: %s after %d attempts", delivery.Status, delivery.Attempts)
+							}
+							if attempt.StatusCode != http.StatusNoContent || attempt.Error != "" {
+								t.Errorf("Replay recorded a wrong attempt: %d %q", attempt.StatusCode, attempt.Error)
+							}
+							replayed.Status, replayed.Attempts = delivery.Status, delivery.Attempts
+							replayed.Log = append(replayed.Log, *attempt)
+							return nil
+						})
+					gomock.InOrder(
+						wRepository.EXPECT().ClaimDelivery(gomock.Any(), int64(5), gomock.Any(), gomock.Any()).Times(1).Return(dead(), nil),
+						wRepository.EXPECT().GetDelivery(gomock.Any(), int64(5)).Times(1).Return(replayed, nil),
+					)
+					return wRepository
+				},
+				outputStatus:   http.StatusOK,
+				outputReceived: 1,
+			},
+		},
+		{
+			name: "Replay a delivery the partner fails again puts it back in the retries",
+			args: args{
+				method:           "POST",
+				endpoint:         "/v1/webhooks/deliveries/5/replay",
+				receiverStatuses: []int{http.StatusBadGateway},
+				mockrepoFn: func(receiverURL string) repository.Webhooks {
+					wRepository := mockRepository.NewMockWebhooks(gomock.NewController(t))
+					wRepository.EXPECT().ClaimDelivery(gomock.Any(), int64(5), gomock.Any(), gomock.Any()).Times(1).Return(dead(), nil)
+					wRepository.EXPECT().GetDelivery(gomock.Any(), int64(5)).Times(1).Return(dead(), nil)
+					wRepository.EXPECT().GetByID(gomock.Any(), int64(1)).Times(1).Return(subscription(receiverURL), nil)
+					wRepository.EXPECT().RecordAttempt(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).
+						DoAndReturn(func(_ context.Context, delivery *domainWebhook.Delivery, attempt *domainWebhook.Attempt) error {
+							if delivery.Status != domainWebhook.StatusPending || delivery.Attempts != 1 || delivery.NextAttemptAt == nil {
+								t.Errorf("Replay recorded a wrong delivery: %s after %d attempts", delivery.Status, delivery.Attempts)
+							}
+							if attempt.StatusCode != http.StatusBadGateway || attempt.Error == "" {
+								t.Errorf("Replay recorded a wrong attempt: %d %q", attempt.StatusCode, attempt.Error)
+							}
+							return nil
+						})
+					return wRepository
+				},
+				outputStatus:   http.StatusOK,
+				outputReceived: 1,
+			},
+		},
+		{
+			name: "Replay delivery failed due to not found",
+			args: args{
+				method:   "POST",
+				endpoint: "/v1/webhooks/deliveries/6/replay",
+				mockrepoFn: func(string) repository.Webhooks {
+					wRepository := mockRepository.NewMockWebhooks(gomock.NewController(t))
+					wRepository.EXPECT().ClaimDelivery(gomock.Any(), int64(6), gomock.Any(), gomock.Any()).Times(1).Return(nil, appErr.NewAppErrorWithType(appErr.NotFound))
+					return wRepository
+				},
+				outputStatus: http.StatusNotFound,
+			},
+		},
+		{
+			name: "Replay delivery failed due to an attempt in progress",
+			args: args{
+				method:   "POST",
+				endpoint: "/v1/webhooks/deliveries/5/replay",
+				mockrepoFn: func(string) repository.Webhooks {
+					wRepository := mockRepository.NewMockWebhooks(gomock.NewController(t))
+					wRepository.EXPECT().ClaimDelivery(gomock.Any(), int64(5), gomock.Any(), gomock.Any()).Times(1).Return(nil, appErr.NewAppErrorWithType(appErr.ResourceAlreadyExists))
+					return wRepository
+				},
+				outputStatus: http.StatusConflict,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			receiver := newWebhookReceiver(t, secret, append(tt.args.receiverStatuses, http.StatusOK)...)
+			req, err := http.NewRequest(tt.args.method, tt.args.endpoint, bytes.NewBufferString(tt.args.body))
+			if err != nil {
+				t.Errorf("Error creating a new request: %v", err)
+			}
+			if !tt.args.noToken {
+				req.Header.Set("Authorization", "Bearer "+adminToken(t, "partners@example.com"))
+			}
+			rr := httptest.NewRecorder()
+			router, routerV1 := getTestRouter()
+			// the local partner is reached with its own client, the default one only connecting to public addresses
+			routes.WebhookRoutes(routerV1, &webhookController.Controller{WebhookService: webhookService.Service{
+				WebhookRepository: tt.args.mockrepoFn(receiver.URL),
+				Client:            receiver.Client(),
+				Resolver:          testPartners,
+			}}, middlewares.AuthJWTMiddleware(testJWTSecret))
+			router.ServeHTTP(rr, req)
+			assertContract(t, req, rr)
+
+			if status := rr.Code; status != tt.args.outputStatus {
+				t.Errorf("Handler returned wrong status code. Expected: %d. Got: %d. Body: %s", tt.args.outputStatus, status, rr.Body.String())
+			}
+			if received, unsigned := receiver.count(); received != tt.args.outputReceived || unsigned != 0 {
+				t.Errorf("Partner received wrong deliveries. Expected: %d signed. Got: %d signed, %d unsigned.", tt.args.outputReceived, received, unsigned)
+			}
+			if showsSecret := bytes.Contains(rr.Body.Bytes(), []byte(`"secret":`)); showsSecret != tt.args.outputSecret {
+				t.Errorf("Handler returned wrong secret. Expected shown: %t. Got: %s", tt.args.outputSecret, rr.Body.String())
+			}
+		})
+	}
+}
+
+func TestWebhookDeliveries(t *testing.T) {
+
+	ok := newWebhookReceiver(t, "partner-secret-crm-0001", http.StatusOK)
+	failing := newWebhookReceiver(t, "partner-secret-delivery-0002", http.StatusServiceUnavailable)
+	subscriptions := map[int64]*domainWebhook.Subscription{
+		1: {ID: 1, URL: ok.URL, Events: []string{domainWebhook.EventOrderCreated}, Secret: "partner-secret-crm-0001"},
+		2: {ID: 2, URL: failing.URL, Events: []string{domainWebhook.EventOrderCreated, domainWebhook.EventMenuCreated}, Secret: "partner-secret-delivery-0002"},
+	}
+
+	// the repository keeps the deliveries and their claims in memory, as the database would
+	var deliveries []domainWebhook.Delivery
+	claims := map[int64]time.Time{}
+	var attempts []domainWebhook.Attempt
+	wRepository := mockRepository.NewMockWebhooks(gomock.NewController(t))
+	wRepository.EXPECT().GetByEvent(gomock.Any(), domainWebhook.EventOrderCreated).Times(1).
+		Return([]domainWebhook.Subscription{*subscriptions[1], *subscriptions[2]}, nil)
+	wRepository.EXPECT().CreateDeliveries(gomock.Any(), gomock.Any()).Times(1).
+		DoAndReturn(func(_ context.Context, created []domainWebhook.Delivery) ([]domainWebhook.Delivery, error) {
+			for i := range created {
+				created[i].ID = int64(len(deliveries) + 1)
+				deliveries = append(deliveries, created[i])
+			}
+			return created, nil
+		})
+	wRepository.EXPECT().ClaimDueDeliveries(gomock.Any(), gomock.Any(), gomock.Any(), webhookService.DueBatchSize).AnyTimes().
+		DoAndReturn(func(_ context.Context, until time.Time, claimedUntil time.Time, _ int) ([]domainWebhook.Delivery, error) {
+			var due []domainWebhook.Delivery
+			for _, delivery := range deliveries {
+				claim, claimed := claims[delivery.ID]
+				if delivery.Status == domainWebhook.StatusPending && !delivery.NextAttemptAt.After(until) && (!claimed || !claim.After(until)) {
+					claims[delivery.ID] = claimedUntil
+					due = append(due, delivery)
+				}
+			}
+			return due, nil
+		})
+	wRepository.EXPECT().GetByID(gomock.Any(), gomock.Any()).AnyTimes().
+		DoAndReturn(func(_ context.Context, id int64) (*domainWebhook.Subscription, error) {
+			subscription := *subscriptions[id]
+			return &subscription, nil
+		})
+	wRepository.EXPECT().RecordAttempt(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().
+		DoAndReturn(func(_ context.Context, delivery *domainWebhook.Delivery, attempt *domainWebhook.Attempt) error {
+			deliveries[delivery.ID-1] = *delivery
+			delete(claims, delivery.ID)
+			attempts = append(attempts, *attempt)
+			return nil
+		})
+	service := webhookService.Service{WebhookRepository: wRepository, Client: ok.Client()}
+
+	// an order placed is published to the partners subscribed to it
+	oRepository := mockRepository.NewMockOrders(gomock.NewController(t))
+	oRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Times(1).Return(&domainOrder.Request{ID: 7, DinnerID: 1, MenuID: 3, Quantity: 2}, nil)
+	req, err := http.NewRequest("POST", "/v1/orders/", bytes.NewBufferString(`{"diner_id": 1, "menu_id": 3, "quantity": 2}`))
+	if err != nil {
+		t.Errorf("Error creating a new request: %v", err)
+	}
+	rr := httptest.NewRecorder()
+	router, routerV1 := getTestRouter()
+	routes.OrderRoutes(routerV1, &orderController.Controller{OrderService: orderService.Service{OrderRepository: oRepository, Events: webhookService.Events{Publisher: &service}}})
+	router.ServeHTTP(rr, req)
+	assertContract(t, req, rr)
+	if rr.Code != http.StatusCreated || len(deliveries) != 2 {
+		t.Fatalf("Order placed with wrong deliveries. Status: %d. Deliveries: %d.", rr.Code, len(deliveries))
+	}
+
+	// the first attempt reaches both partners, the failing one is retried after the backoff
+	attempted, err := service.DeliverDue(context.Background())
+	if err != nil || attempted != 2 {
+		t.Fatalf("DeliverDue attempted %d deliveries: %v", attempted, err)
+	}
+	if received, unsigned := ok.count(); received != 1 || unsigned != 0 {
+		t.Errorf("Partner received wrong deliveries. Expected: 1 signed. Got: %d signed, %d unsigned.", received, unsigned)
+	}
+	if notification := ok.received[0]; notification.Event != domainWebhook.EventOrderCreated || notification.Data.(map[string]interface{})["id"] != float64(7) {
+		t.Errorf("Partner received a wrong notification: %+v", notification)
+	}
+	if deliveries[0].Status != domainWebhook.StatusSucceeded {
+		t.Errorf("Delivery to the partner answering 200 is %s", deliveries[0].Status)
+	}
+	retried := deliveries[1]
+	if retried.Status != domainWebhook.StatusPending || retried.Attempts != 1 || retried.LastStatusCode != http.StatusServiceUnavailable {
+		t.Errorf("Delivery to the partner answering 503 is %s after %d attempts with %d", retried.Status, retried.Attempts, retried.LastStatusCode)
+	}
+	if wait := time.Until(*retried.NextAttemptAt); wait < webhookService.RetryBackoff-time.Second || wait > webhookService.RetryBackoff {
+		t.Errorf("Delivery to the partner answering 503 is retried in %v", wait)
+	}
+
+	// nothing is due before the backoff, and the last attempt failing makes the delivery dead
+	if attempted, _ := service.DeliverDue(context.Background()); attempted != 0 {
+		t.Errorf("DeliverDue attempted %d deliveries before their backoff", attempted)
+	}
+	past := time.Now().Add(-time.Second)
+	deliveries[1].NextAttemptAt = &past
+	deliveries[1].Attempts = webhookService.MaxAttempts - 1
+	if attempted, err := service.DeliverDue(context.Background()); err != nil || attempted != 1 {
+		t.Fatalf("DeliverDue attempted %d deliveries: %v", attempted, err)
+	}
+	if dead := deliveries[1]; dead.Status != domainWebhook.StatusDead || dead.NextAttemptAt != nil || dead.Attempts != webhookService.MaxAttempts {
+		t.Errorf("Delivery failing its last attempt is %s after %d attempts", dead.Status, dead.Attempts)
+	}
+	if received, unsigned := failing.count(); received != 2 || unsigned != 0 || len(attempts) != 3 {
+		t.Errorf("Partner answering 503 received wrong deliveries: %d signed, %d unsigned, %d attempts", received, unsigned, len(attempts))
+	}
+
+	// the backoff doubles on every attempt up to its longest
+	for attempts, backoff := range map[int]time.Duration{1: webhookService.RetryBackoff, 2: 2 * webhookService.RetryBackoff, 3: 4 * webhookService.RetryBackoff, 40: webhookService.MaxRetryBackoff} {
+		if got := webhookService.Backoff(attempts); got != backoff {
+			t.Errorf("Backoff after %d attempts is %v, expected %v", attempts, got, backoff)
+		}
+	}
+}
+
+func TestWebhookDeliveryTargets(t *testing.T) {
+	target := newWebhookReceiver(t, "partner-secret-crm-0001", http.StatusOK)
+	redirecting := httptest.NewServer(http.RedirectHandler(target.URL, http.StatusFound))
+	t.Cleanup(redirecting.Close)
+
+	tests := []struct {
+		name string
+		url  string
+		// client is the client the deliveries are sent with, the default one when nil
+		client           *http.Client
+		outputStatusCode int
+		outputError      string
+	}{
+		{
+			name:        "The default client refuses to connect to a loopback address",
+			url:         target.URL,
+			outputError: "not a public address",
+		},
+		{
+			name:             "A redirect is recorded as a failed attempt rather than followed",
+			url:              redirecting.URL,
+			client:           redirecting.Client(),
+			outputStatusCode: http.StatusFound,
+			outputError:      "unexpected status 302",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delivery := domainWebhook.Delivery{ID: 1, SubscriptionID: 1, Event: domainWebhook.EventOrderCreated, Payload: json.RawMessage(`{}`), Status: domainWebhook.StatusPending}
+			wRepository := mockRepository.NewMockWebhooks(gomock.NewController(t))
+			wRepository.EXPECT().ClaimDelivery(gomock.Any(), int64(1), gomock.Any(), gomock.Any()).Times(1).Return(&delivery, nil)
+			wRepository.EXPECT().GetDelivery(gomock.Any(), int64(1)).Times(1).Return(&delivery, nil)
+			wRepository.EXPECT().GetByID(gomock.Any(), int64(1)).Times(1).
+				Return(&domainWebhook.Subscription{ID: 1, URL: tt.url, Secret: "partner-secret-crm-0001"}, nil)
+			wRepository.EXPECT().RecordAttempt(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).
+				DoAndReturn(func(_ context.Context, _ *domainWebhook.Delivery, attempt *domainWebhook.Attempt) error {
+					if attempt.StatusCode != tt.outputStatusCode || !strings.Contains(attempt.Error, tt.outputError) {
+						t.Errorf("Attempt recorded wrongly. Expected: %d %q. Got: %d %q.", tt.outputStatusCode, tt.outputError, attempt.StatusCode, attempt.Error)
+					}
+					return nil
+				})
+
+			service := webhookService.Service{WebhookRepository: wRepository, Client: tt.client}
+			if _, err := service.Replay(context.Background(), 1); err != nil {
+				t.Fatalf("Replay returned unexpected error %v", err)
+			}
+			if received, unsigned := target.count(); received != 0 || unsigned != 0 {
+				t.Errorf("Partner behind the target received %d deliveries", received+unsigned)
+			}
+		})
+	}
+}
+
+func TestWebhookEventsTransaction(t *testing.T) {
+	subscriptions := []domainWebhook.Subscription{{ID: 1, URL: "https://partner.example.com/hooks", Events: []string{domainWebhook.EventOrderCreated, domainWebhook.EventOrderDeleted}}}
+
+	tests := []struct {
+		name         string
+		method       string
+		endpoint     string
+		body         string
+		mockrepoFn   func(t *testing.T, o *mockRepository.MockOrders, w *mockRepository.MockWebhooks)
+		outputStatus int
+		commits      int
+		rollbacks    int
+	}{
+		{
+			name:     "Place an Order and queue its event in the same transaction",
+			method:   "POST",
+			endpoint: "/v1/orders/",
+			body:     `{"diner_id": 1, "menu_id": 3, "quantity": 2}`,
+			mockrepoFn: func(t *testing.T, o *mockRepository.MockOrders, w *mockRepository.MockWebhooks) {
+				o.EXPECT().Create(gomock.Any(), gomock.Any()).Times(1).
+					DoAndReturn(func(ctx context.Context, _ *domainOrder.Request) (*domainOrder.Request, error) {
+						if !inTransaction(ctx) {
+							t.Error("Order created outside of the transaction")
+						}
+						return &domainOrder.Request{ID: 7, DinnerID: 1, MenuID: 3, Quantity: 2}, nil
+					})
+				w.EXPECT().GetByEvent(gomock.Any(), domainWebhook.EventOrderCreated).Times(1).Return(subscriptions, nil)
+				w.EXPECT().CreateDeliveries(gomock.Any(), gomock.Any()).Times(1).
+					DoAndReturn(func(ctx context.Context, deliveries []domainWebhook.Delivery) ([]domainWebhook.Delivery, error) {
+						if !inTransaction(ctx) {
+							t.Error("Deliveries queued outside of the transaction")
+						}
+						return deliveries, nil
+					})
+			},
+			outputStatus: http.StatusCreated,
+			commits:      1,
+		},
+		{
+			name:     "Failed to delete an Order whose event cannot be queued",
+			method:   "DELETE",
+			endpoint: "/v1/orders/7",
+			mockrepoFn: func(t *testing.T, o *mockRepository.MockOrders, w *mockRepository.MockWebhooks) {
+				o.EXPECT().Delete(gomock.Any(), 7).Times(1).Return(nil)
+				w.EXPECT().GetByEvent(gomock.Any(), domainWebhook.EventOrderDeleted).Times(1).Return(subscriptions, nil)
+				w.EXPECT().CreateDeliveries(gomock.Any(), gomock.Any()).Times(1).Return(nil, appErr.NewAppErrorWithType(appErr.RepositoryError))
+			},
+			outputStatus: http.StatusInternalServerError,
+			rollbacks:    1,
+		},
+		{
+			name:     "Failed to place an Order queues no event",
+			method:   "POST",
+			endpoint: "/v1/orders/",
+			body:     `{"diner_id": 1, "menu_id": 3, "quantity": 2}`,
+			mockrepoFn: func(t *testing.T, o *mockRepository.MockOrders, w *mockRepository.MockWebhooks) {
+				o.EXPECT().Create(gomock.Any(), gomock.Any()).Times(1).Return(nil, appErr.NewAppErrorWithType(appErr.RepositoryError))
+			},
+			outputStatus: http.StatusInternalServerError,
+			rollbacks:    1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			oRepository := mockRepository.NewMockOrders(gomock.NewController(t))
+			wRepository := mockRepository.NewMockWebhooks(gomock.NewController(t))
+			tt.mockrepoFn(t, oRepository, wRepository)
+			transactor := &fakeTransactor{}
+			service := orderService.Service{
+				OrderRepository: oRepository,
+				Events: webhookService.Events{
+					Publisher:  &webhookService.Service{WebhookRepository: wRepository},
+					Transactor: transactor,
+				},
+			}
+
+			req, err := http.NewRequest(tt.method, tt.endpoint, bytes.NewBufferString(tt.body))
+			if err != nil {
+				t.Errorf("Error creating a new request: %v", err)
+			}
+			rr := httptest.NewRecorder()
+			router, routerV1 := getTestRouter()
+			routes.OrderRoutes(routerV1, &orderController.Controller{OrderService: service})
+			router.ServeHTTP(rr, req)
+			assertContract(t, req, rr)
+
+			if status := rr.Code; status != tt.outputStatus {
+				t.Errorf("Handler returned wrong status code. Expected: %d. Got: %d.", tt.outputStatus, status)
+			}
+			if transactor.commits != tt.commits || transactor.rollbacks != tt.rollbacks {
+				t.Errorf("Transaction ended wrongly. Expected: %d commits, %d rollbacks. Got: %d commits, %d rollbacks.",
+					tt.commits, tt.rollbacks, transactor.commits, transactor.rollbacks)
+			}
+		})
+	}
+}
+
+func TestMenuImportEvents(t *testing.T) {
+	created := domainMenu.Menu{ID: 11, Name: "Paneer Tikka", Description: "Grilled cottage cheese", Category: "starters", Price: 120.5}
+	updated := domainMenu.Menu{ID: 4, Name: "Dal Makhani", Description: "Slow cooked black lentils", Category: domainMenu.DefaultCategory, Price: 80}
+	subscriptions := []domainWebhook.Subscription{{ID: 1, URL: "https://partner.example.com/hooks", Events: []string{domainWebhook.EventMenuCreated, domainWebhook.EventMenuUpdated}}}
+
+	mRepository := mockRepository.NewMockMenus(gomock.NewController(t))
+	mRepository.EXPECT().Upsert(gomock.Any(), gomock.Any()).Times(1).
+		DoAndReturn(func(ctx context.Context, menus []domainMenu.Menu) (*repository.UpsertResultMenu, error) {
+			if !inTransaction(ctx) {
+				t.Error("Menus upserted outside of the transaction")
+			}
+			return &repository.UpsertResultMenu{Created: 1, Updated: 1, Unchanged: 1, CreatedMenus: []domainMenu.Menu{created}, UpdatedMenus: []domainMenu.Menu{updated}}, nil
+		})
+	wRepository := mockRepository.NewMockWebhooks(gomock.NewController(t))
+	wRepository.EXPECT().GetByEvent(gomock.Any(), gomock.Any()).Times(2).Return(subscriptions, nil)
+	// one delivery is queued for every menu created or updated, not for the unchanged one
+	var published []domainWebhook.Notification
+	wRepository.EXPECT().CreateDeliveries(gomock.Any(), gomock.Any()).Times(2).
+		DoAndReturn(func(ctx context.Context, deliveries []domainWebhook.Delivery) ([]domainWebhook.Delivery, error) {
+			if !inTransaction(ctx) {
+				t.Error("Deliveries queued outside of the transaction")
+			}
+			var notification domainWebhook.Notification
+			if err := json.Unmarshal(deliveries[0].Payload, &notification); err != nil {
+				t.Errorf("Delivery queued with an invalid payload: %v", err)
+			}
+			published = append(published, notification)
+			return deliveries, nil
+		})
+	transactor := &fakeTransactor{}
+	service := menuService.Service{
+		MenuRepository: mRepository,
+		Events: webhookService.Events{
+			Publisher:  &webhookService.Service{WebhookRepository: wRepository},
+			Transactor: transactor,
+		},
+	}
+
+	body := "name,description,category,price\nPaneer Tikka,Grilled cottage cheese,starters,120.5\nDal Makhani,Slow cooked black lentils,,80\nJeera Rice,Cumin rice,mains,60\n"
+	req, err := http.NewRequest("POST", "/v1/menus/import", bytes.NewBufferString(body))
+	if err != nil {
+		t.Errorf("Error creating a new request: %v", err)
+	}
+	req.Header.Set("Content-Type", "text/csv")
+	rr := httptest.NewRecorder()
+	router, routerV1 := getTestRouter()
+	routes.MenuRoutes(routerV1, &menuController.Controller{MenuService: service})
+	router.ServeHTTP(rr, req)
+	assertContract(t, req, rr)
+
+	if rr.Code != http.StatusOK || transactor.commits != 1 {
+		t.Fatalf("Menus imported with status %d in %d transactions: %s", rr.Code, transactor.commits, rr.Body.String())
+	}
+	if len(published) != 2 || published[0].Event != domainWebhook.EventMenuCreated || published[1].Event != domainWebhook.EventMenuUpdated {
+		t.Fatalf("Import published wrong events: %+v", published)
+	}
+	for i, id := range []float64{11, 4} {
+		if data, ok := published[i].Data.(map[string]interface{}); !ok || data["id"] != id {
+			t.Errorf("Event %s published with wrong menu: %v", published[i].Event, published[i].Data)
+		}
+	}
+}
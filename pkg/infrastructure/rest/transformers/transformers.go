@@ -0,0 +1,154 @@
+// Package transformers contains the resources of the v2 API and the functions that transform the domain entities
+// and the use case results into them, so that the v2 responses evolve apart from the domain and from v1
+package transformers
+
+import (
+	"time"
+
+	useCaseDiner "github.com/Raj63/golang-rest-api/pkg/app/usecases/diner"
+	useCaseMenu "github.com/Raj63/golang-rest-api/pkg/app/usecases/menu"
+	domainDiner "github.com/Raj63/golang-rest-api/pkg/domain/diner"
+	domainMenu "github.com/Raj63/golang-rest-api/pkg/domain/menu"
+	domainOrder "github.com/Raj63/golang-rest-api/pkg/domain/order"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/envelope"
+)
+
+// Menu is a struct that contains the menu resource of the v2 API
+type Menu struct {
+	ID          int64     `json:"id" example:"123"`
+	Name        string    `json:"name" example:"Hyderabadi Dum Briyani"`
+	Description string    `json:"description" example:"Some Description"`
+	Category    string    `json:"category" example:"briyani"`
+	Price       float64   `json:"price" example:"200.50"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// Diner is a struct that contains the diner resource of the v2 API
+type Diner struct {
+	ID          int64     `json:"id" example:"123"`
+	Name        string    `json:"name" example:"Mr. Smith"`
+	TableNumber int       `json:"table_no" example:"101"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// Order is a struct that contains the order resource of the v2 API, the session, names and serving time are only
+// known once the order is read back
+type Order struct {
+	ID        int64      `json:"id" example:"123"`
+	SessionID int64      `json:"session_id,omitempty" example:"1"`
+	DinerID   int64      `json:"diner_id" example:"1"`
+	DinerName string     `json:"diner_name,omitempty" example:"Mr. Smith"`
+	MenuID    int64      `json:"menu_id" example:"3"`
+	MenuName  string     `json:"menu_name,omitempty" example:"HCDB"`
+	Quantity  int        `json:"quantity" example:"2"`
+	ServedAt  *time.Time `json:"served_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// FromMenu is a function that transforms a domain menu into a menu resource
+func FromMenu(menu *domainMenu.Menu) Menu {
+	return Menu{
+		ID:          menu.ID,
+		Name:        menu.Name,
+		Description: menu.Description,
+		Category:    menu.Category,
+		Price:       menu.Price,
+		CreatedAt:   menu.CreatedAt,
+		UpdatedAt:   menu.UpdatedAt,
+	}
+}
+
+// FromMenus is a function that transforms domain menus into menu resources
+func FromMenus(menus []domainMenu.Menu) []Menu {
+	resources := make([]Menu, len(menus))
+	for i := range menus {
+		resources[i] = FromMenu(&menus[i])
+	}
+	return resources
+}
+
+// FromMenuPage is a function that transforms a page of menus into menu resources and their pagination metadata,
+// with the total when the page was counted
+func FromMenuPage(page *useCaseMenu.PaginationResultMenu, counted bool) ([]Menu, *envelope.Meta) {
+	var menus []domainMenu.Menu
+	if page.Data != nil {
+		menus = *page.Data
+	}
+	return FromMenus(menus), pageMeta(page.Total, page.Limit, page.Current, page.NumPages, page.NextCursor, page.PrevCursor, counted)
+}
+
+// FromDiner is a function that transforms a domain diner into a diner resource
+func FromDiner(diner *domainDiner.Diner) Diner {
+	return Diner{
+		ID:          diner.ID,
+		Name:        diner.Name,
+		TableNumber: diner.TableNumber,
+		CreatedAt:   diner.CreatedAt,
+		UpdatedAt:   diner.UpdatedAt,
+	}
+}
+
+// FromDiners is a function that transforms domain diners into diner resources
+func FromDiners(diners []domainDiner.Diner) []Diner {
+	resources := make([]Diner, len(diners))
+	for i := range diners {
+		resources[i] = FromDiner(&diners[i])
+	}
+	return resources
+}
+
+// FromDinerPage is a function that transforms a page of diners into diner resources and their pagination metadata,
+// with the total when the page was counted
+func FromDinerPage(page *useCaseDiner.PaginationResultDiner, counted bool) ([]Diner, *envelope.Meta) {
+	var diners []domainDiner.Diner
+	if page.Data != nil {
+		diners = *page.Data
+	}
+	return FromDiners(diners), pageMeta(page.Total, page.Limit, page.Current, page.NumPages, page.NextCursor, page.PrevCursor, counted)
+}
+
+// FromOrderRequest is a function that transforms a placed order into an order resource
+func FromOrderRequest(order *domainOrder.Request) Order {
+	return Order{
+		ID:        order.ID,
+		DinerID:   order.DinnerID,
+		MenuID:    order.MenuID,
+		Quantity:  order.Quantity,
+		CreatedAt: order.CreatedAt,
+	}
+}
+
+// FromOrders is a function that transforms the orders read back into order resources
+func FromOrders(orders []domainOrder.Response) []Order {
+	resources := make([]Order, len(orders))
+	for i, order := range orders {
+		resources[i] = Order{
+			ID:        order.ID,
+			SessionID: order.SessionID,
+			DinerID:   order.DinerID,
+			DinerName: order.DinnerName,
+			MenuID:    order.MenuID,
+			MenuName:  order.MenuName,
+			Quantity:  order.Quantity,
+			ServedAt:  order.ServedAt,
+			CreatedAt: order.CreatedAt,
+		}
+	}
+	return resources
+}
+
+func pageMeta(total, limit, page, pages int64, nextCursor, prevCursor string, counted bool) *envelope.Meta {
+	meta := envelope.Meta{
+		Limit:      limit,
+		Page:       page,
+		Pages:      pages,
+		NextCursor: nextCursor,
+		PrevCursor: prevCursor,
+	}
+	if counted {
+		meta.Total = &total
+	}
+	return &meta
+}
@@ -0,0 +1,42 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+
+	domainErrors "github.com/Raj63/golang-rest-api/pkg/domain/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const internalErrorMessage = "We are working to improve the flow of this request."
+
+// toStatus maps an error returned by a service to a gRPC status, in the same way errorsController.Handler maps it
+// to an HTTP status. Errors other than the known domain errors never leak their message to the client.
+func toStatus(err error) *status.Status {
+	if s, ok := status.FromError(err); ok {
+		return s
+	}
+	if errors.Is(err, context.Canceled) {
+		return status.New(codes.Canceled, err.Error())
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return status.New(codes.DeadlineExceeded, err.Error())
+	}
+
+	var appErr *domainErrors.AppError
+	if !errors.As(err, &appErr) {
+		return status.New(codes.Internal, internalErrorMessage)
+	}
+
+	switch appErr.Type {
+	case domainErrors.NotFound:
+		return status.New(codes.NotFound, appErr.Error())
+	case domainErrors.ValidationError, domainErrors.InputEmpty:
+		return status.New(codes.InvalidArgument, appErr.Error())
+	case domainErrors.ResourceAlreadyExists:
+		return status.New(codes.AlreadyExists, appErr.Error())
+	default:
+		return status.New(codes.Internal, internalErrorMessage)
+	}
+}
@@ -0,0 +1,109 @@
+package rpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/logger"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryTracerInterceptor starts a server span for every call, continuing the trace propagated by the client in
+// the request metadata.
+func UnaryTracerInterceptor(serviceName string) grpc.UnaryServerInterceptor {
+	tracer := otel.Tracer(serviceName)
+
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		ctx = otel.GetTextMapPropagator().Extract(ctx, metadataCarrier(md))
+
+		ctx, span := tracer.Start(ctx, info.FullMethod,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(attribute.String("rpc.system", "grpc"), attribute.String("rpc.method", info.FullMethod)),
+		)
+		defer span.End()
+
+		resp, err := handler(ctx, req)
+		code := status.Code(err)
+		span.SetAttributes(attribute.Int("rpc.grpc.status_code", int(code)))
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelcodes.Error, code.String())
+		}
+
+		return resp, err
+	}
+}
+
+// UnaryLoggerInterceptor logs the method, status code and duration of every call.
+func UnaryLoggerInterceptor(logger *logger.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		code := status.Code(err)
+
+		if code == codes.Internal || code == codes.Unknown {
+			logger.ErrorfContext(ctx, "gRPC %s %s %s: %v", info.FullMethod, code, time.Since(start), err)
+		} else {
+			logger.InfofContext(ctx, "gRPC %s %s %s", info.FullMethod, code, time.Since(start))
+		}
+
+		return resp, err
+	}
+}
+
+// UnaryRecoveryInterceptor turns a panic in a handler into an Internal error instead of crashing the server.
+func UnaryRecoveryInterceptor(logger *logger.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				logger.ErrorfContext(ctx, "gRPC %s panicked: %v", info.FullMethod, r)
+				err = status.Error(codes.Internal, internalErrorMessage)
+			}
+		}()
+
+		return handler(ctx, req)
+	}
+}
+
+// UnaryErrorInterceptor maps the domain errors returned by the handlers to gRPC status codes.
+func UnaryErrorInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return resp, toStatus(err).Err()
+		}
+
+		return resp, nil
+	}
+}
+
+// metadataCarrier adapts the gRPC metadata to the OpenTelemetry propagators.
+type metadataCarrier metadata.MD
+
+func (c metadataCarrier) Get(key string) string {
+	values := metadata.MD(c).Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+func (c metadataCarrier) Set(key, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+func (c metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for key := range c {
+		keys = append(keys, key)
+	}
+	return keys
+}
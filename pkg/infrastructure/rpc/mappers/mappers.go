@@ -0,0 +1,199 @@
+// Package mappers converts the domain entities to their protobuf messages, shared by the gRPC services and the
+// protobuf responses of the REST API
+package mappers
+
+import (
+	"time"
+
+	useCaseDiner "github.com/Raj63/golang-rest-api/pkg/app/usecases/diner"
+	useCaseMenu "github.com/Raj63/golang-rest-api/pkg/app/usecases/menu"
+	domainDiner "github.com/Raj63/golang-rest-api/pkg/domain/diner"
+	domainMenu "github.com/Raj63/golang-rest-api/pkg/domain/menu"
+	domainOrder "github.com/Raj63/golang-rest-api/pkg/domain/order"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rpc/pb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Timestamp converts a time to a protobuf timestamp, nil for the zero time
+func Timestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+// OptionalTimestamp converts an optional time to a protobuf timestamp
+func OptionalTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return Timestamp(*t)
+}
+
+// Menu converts a menu to its protobuf message
+func Menu(menu *domainMenu.Menu) *pb.Menu {
+	return &pb.Menu{
+		Id:          menu.ID,
+		Name:        menu.Name,
+		Description: menu.Description,
+		Category:    menu.Category,
+		Price:       menu.Price,
+		Count:       int64(menu.Count),
+		Revenue:     menu.Revenue,
+		CreatedAt:   Timestamp(menu.CreatedAt),
+		UpdatedAt:   Timestamp(menu.UpdatedAt),
+	}
+}
+
+// Menus converts menus to their protobuf messages
+func Menus(menus []domainMenu.Menu) []*pb.Menu {
+	result := make([]*pb.Menu, len(menus))
+	for i := range menus {
+		result[i] = Menu(&menus[i])
+	}
+	return result
+}
+
+// Diner converts a diner to its protobuf message
+func Diner(diner *domainDiner.Diner) *pb.Diner {
+	return &pb.Diner{
+		Id:          diner.ID,
+		Name:        diner.Name,
+		TableNumber: int32(diner.TableNumber),
+		CreatedAt:   Timestamp(diner.CreatedAt),
+		UpdatedAt:   Timestamp(diner.UpdatedAt),
+	}
+}
+
+// Diners converts diners to their protobuf messages
+func Diners(diners []domainDiner.Diner) []*pb.Diner {
+	result := make([]*pb.Diner, len(diners))
+	for i := range diners {
+		result[i] = Diner(&diners[i])
+	}
+	return result
+}
+
+// Session converts a dining session to its protobuf message
+func Session(session *domainDiner.Session) *pb.Session {
+	return &pb.Session{
+		Id:           session.ID,
+		DinerId:      session.DinerID,
+		CustomerId:   session.CustomerID,
+		TableNumber:  int32(session.TableNumber),
+		CheckedInAt:  Timestamp(session.CheckedInAt),
+		CheckedOutAt: OptionalTimestamp(session.CheckedOutAt),
+	}
+}
+
+// Payment converts a payment to its protobuf message
+func Payment(payment *domainDiner.Payment) *pb.Payment {
+	return &pb.Payment{
+		Id:        payment.ID,
+		SessionId: payment.SessionID,
+		Subtotal:  payment.Subtotal,
+		Discount:  payment.Discount,
+		Amount:    payment.Amount,
+		PaidAt:    Timestamp(payment.PaidAt),
+	}
+}
+
+// Order converts an order to its protobuf message
+func Order(order *domainOrder.Response) *pb.Order {
+	return &pb.Order{
+		Id:              order.ID,
+		SessionId:       order.SessionID,
+		DinerId:         order.DinerID,
+		MenuId:          order.MenuID,
+		DinerName:       order.DinnerName,
+		MenuName:        order.MenuName,
+		MenuDescription: order.MenuDescription,
+		Quantity:        int32(order.Quantity),
+		ServedAt:        OptionalTimestamp(order.ServedAt),
+		CreatedAt:       Timestamp(order.CreatedAt),
+		UpdatedAt:       Timestamp(order.UpdatedAt),
+	}
+}
+
+// MenuPage converts a page of menus to its protobuf message
+func MenuPage(result *useCaseMenu.PaginationResultMenu) *pb.ListMenusResponse {
+	var menus []*pb.Menu
+	if result.Data != nil {
+		menus = Menus(*result.Data)
+	}
+	return &pb.ListMenusResponse{
+		Menus: menus,
+		Pagination: &pb.Pagination{
+			Total:      result.Total,
+			Limit:      result.Limit,
+			Current:    result.Current,
+			NumPages:   result.NumPages,
+			NextCursor: result.NextCursor,
+			PrevCursor: result.PrevCursor,
+		},
+	}
+}
+
+// DinerPage converts a page of diners to its protobuf message
+func DinerPage(result *useCaseDiner.PaginationResultDiner) *pb.ListDinersResponse {
+	var diners []*pb.Diner
+	if result.Data != nil {
+		diners = Diners(*result.Data)
+	}
+	return &pb.ListDinersResponse{
+		Diners: diners,
+		Pagination: &pb.Pagination{
+			Total:      result.Total,
+			Limit:      result.Limit,
+			Current:    result.Current,
+			NumPages:   result.NumPages,
+			NextCursor: result.NextCursor,
+			PrevCursor: result.PrevCursor,
+		},
+	}
+}
+
+// Message converts a response of the REST API to its protobuf message, false when the response has none
+func Message(obj interface{}) (proto.Message, bool) {
+	switch value := obj.(type) {
+	case proto.Message:
+		return value, true
+	case *domainMenu.Menu:
+		return Menu(value), true
+	case []domainMenu.Menu:
+		return &pb.ListTopMenusResponse{Menus: Menus(value)}, true
+	case *useCaseMenu.PaginationResultMenu:
+		return MenuPage(value), true
+	case *domainDiner.Diner:
+		return Diner(value), true
+	case *useCaseDiner.PaginationResultDiner:
+		return DinerPage(value), true
+	case *domainDiner.Session:
+		return Session(value), true
+	case []domainDiner.Session:
+		response := &pb.ListSessionsResponse{Sessions: make([]*pb.Session, len(value))}
+		for i := range value {
+			response.Sessions[i] = Session(&value[i])
+		}
+		return response, true
+	case *domainDiner.Payment:
+		return Payment(value), true
+	case *domainOrder.Request:
+		return &pb.Order{
+			Id:        value.ID,
+			DinerId:   value.DinnerID,
+			MenuId:    value.MenuID,
+			Quantity:  int32(value.Quantity),
+			CreatedAt: Timestamp(value.CreatedAt),
+		}, true
+	case []domainOrder.Response:
+		response := &pb.ListOrdersResponse{Orders: make([]*pb.Order, len(value))}
+		for i := range value {
+			response.Orders[i] = Order(&value[i])
+		}
+		return response, true
+	default:
+		return nil, false
+	}
+}
@@ -0,0 +1,385 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: restaurant/v1/diner.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	DinerService_CreateDiner_FullMethodName  = "/restaurant.v1.DinerService/CreateDiner"
+	DinerService_GetDiner_FullMethodName     = "/restaurant.v1.DinerService/GetDiner"
+	DinerService_ListDiners_FullMethodName   = "/restaurant.v1.DinerService/ListDiners"
+	DinerService_UpdateDiner_FullMethodName  = "/restaurant.v1.DinerService/UpdateDiner"
+	DinerService_DeleteDiner_FullMethodName  = "/restaurant.v1.DinerService/DeleteDiner"
+	DinerService_CheckIn_FullMethodName      = "/restaurant.v1.DinerService/CheckIn"
+	DinerService_ListSessions_FullMethodName = "/restaurant.v1.DinerService/ListSessions"
+	DinerService_Checkout_FullMethodName     = "/restaurant.v1.DinerService/Checkout"
+)
+
+// DinerServiceClient is the client API for DinerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DinerServiceClient interface {
+	// CreateDiner adds a new diner and checks them in at a table.
+	CreateDiner(ctx context.Context, in *CreateDinerRequest, opts ...grpc.CallOption) (*Diner, error)
+	// GetDiner returns a diner by its ID.
+	GetDiner(ctx context.Context, in *GetDinerRequest, opts ...grpc.CallOption) (*Diner, error)
+	// ListDiners returns a page of diners, optionally searched by name and table number.
+	ListDiners(ctx context.Context, in *ListDinersRequest, opts ...grpc.CallOption) (*ListDinersResponse, error)
+	// UpdateDiner fixes the name of a diner or moves them to another table.
+	UpdateDiner(ctx context.Context, in *UpdateDinerRequest, opts ...grpc.CallOption) (*Diner, error)
+	// DeleteDiner removes a diner by its ID.
+	DeleteDiner(ctx context.Context, in *DeleteDinerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// CheckIn opens a new dining session for a returning diner.
+	CheckIn(ctx context.Context, in *CheckInRequest, opts ...grpc.CallOption) (*Session, error)
+	// ListSessions returns the dining sessions of a diner, latest first.
+	ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error)
+	// Checkout records the paid bill of the open session of a diner.
+	Checkout(ctx context.Context, in *CheckoutRequest, opts ...grpc.CallOption) (*Payment, error)
+}
+
+type dinerServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDinerServiceClient(cc grpc.ClientConnInterface) DinerServiceClient {
+	return &dinerServiceClient{cc}
+}
+
+func (c *dinerServiceClient) CreateDiner(ctx context.Context, in *CreateDinerRequest, opts ...grpc.CallOption) (*Diner, error) {
+	out := new(Diner)
+	err := c.cc.Invoke(ctx, DinerService_CreateDiner_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dinerServiceClient) GetDiner(ctx context.Context, in *GetDinerRequest, opts ...grpc.CallOption) (*Diner, error) {
+	out := new(Diner)
+	err := c.cc.Invoke(ctx, DinerService_GetDiner_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dinerServiceClient) ListDiners(ctx context.Context, in *ListDinersRequest, opts ...grpc.CallOption) (*ListDinersResponse, error) {
+	out := new(ListDinersResponse)
+	err := c.cc.Invoke(ctx, DinerService_ListDiners_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dinerServiceClient) UpdateDiner(ctx context.Context, in *UpdateDinerRequest, opts ...grpc.CallOption) (*Diner, error) {
+	out := new(Diner)
+	err := c.cc.Invoke(ctx, DinerService_UpdateDiner_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dinerServiceClient) DeleteDiner(ctx context.Context, in *DeleteDinerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, DinerService_DeleteDiner_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dinerServiceClient) CheckIn(ctx context.Context, in *CheckInRequest, opts ...grpc.CallOption) (*Session, error) {
+	out := new(Session)
+	err := c.cc.Invoke(ctx, DinerService_CheckIn_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dinerServiceClient) ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error) {
+	out := new(ListSessionsResponse)
+	err := c.cc.Invoke(ctx, DinerService_ListSessions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dinerServiceClient) Checkout(ctx context.Context, in *CheckoutRequest, opts ...grpc.CallOption) (*Payment, error) {
+	out := new(Payment)
+	err := c.cc.Invoke(ctx, DinerService_Checkout_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DinerServiceServer is the server API for DinerService service.
+// All implementations must embed UnimplementedDinerServiceServer
+// for forward compatibility
+type DinerServiceServer interface {
+	// CreateDiner adds a new diner and checks them in at a table.
+	CreateDiner(context.Context, *CreateDinerRequest) (*Diner, error)
+	// GetDiner returns a diner by its ID.
+	GetDiner(context.Context, *GetDinerRequest) (*Diner, error)
+	// ListDiners returns a page of diners, optionally searched by name and table number.
+	ListDiners(context.Context, *ListDinersRequest) (*ListDinersResponse, error)
+	// UpdateDiner fixes the name of a diner or moves them to another table.
+	UpdateDiner(context.Context, *UpdateDinerRequest) (*Diner, error)
+	// DeleteDiner removes a diner by its ID.
+	DeleteDiner(context.Context, *DeleteDinerRequest) (*emptypb.Empty, error)
+	// CheckIn opens a new dining session for a returning diner.
+	CheckIn(context.Context, *CheckInRequest) (*Session, error)
+	// ListSessions returns the dining sessions of a diner, latest first.
+	ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error)
+	// Checkout records the paid bill of the open session of a diner.
+	Checkout(context.Context, *CheckoutRequest) (*Payment, error)
+	mustEmbedUnimplementedDinerServiceServer()
+}
+
+// UnimplementedDinerServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedDinerServiceServer struct {
+}
+
+func (UnimplementedDinerServiceServer) CreateDiner(context.Context, *CreateDinerRequest) (*Diner, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDiner not implemented")
+}
+func (UnimplementedDinerServiceServer) GetDiner(context.Context, *GetDinerRequest) (*Diner, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDiner not implemented")
+}
+func (UnimplementedDinerServiceServer) ListDiners(context.Context, *ListDinersRequest) (*ListDinersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDiners not implemented")
+}
+func (UnimplementedDinerServiceServer) UpdateDiner(context.Context, *UpdateDinerRequest) (*Diner, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDiner not implemented")
+}
+func (UnimplementedDinerServiceServer) DeleteDiner(context.Context, *DeleteDinerRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDiner not implemented")
+}
+func (UnimplementedDinerServiceServer) CheckIn(context.Context, *CheckInRequest) (*Session, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckIn not implemented")
+}
+func (UnimplementedDinerServiceServer) ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessions not implemented")
+}
+func (UnimplementedDinerServiceServer) Checkout(context.Context, *CheckoutRequest) (*Payment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Checkout not implemented")
+}
+func (UnimplementedDinerServiceServer) mustEmbedUnimplementedDinerServiceServer() {}
+
+// UnsafeDinerServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DinerServiceServer will
+// result in compilation errors.
+type UnsafeDinerServiceServer interface {
+	mustEmbedUnimplementedDinerServiceServer()
+}
+
+func RegisterDinerServiceServer(s grpc.ServiceRegistrar, srv DinerServiceServer) {
+	s.RegisterService(&DinerService_ServiceDesc, srv)
+}
+
+func _DinerService_CreateDiner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDinerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DinerServiceServer).CreateDiner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DinerService_CreateDiner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DinerServiceServer).CreateDiner(ctx, req.(*CreateDinerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DinerService_GetDiner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDinerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DinerServiceServer).GetDiner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DinerService_GetDiner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DinerServiceServer).GetDiner(ctx, req.(*GetDinerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DinerService_ListDiners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDinersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DinerServiceServer).ListDiners(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DinerService_ListDiners_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DinerServiceServer).ListDiners(ctx, req.(*ListDinersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DinerService_UpdateDiner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDinerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DinerServiceServer).UpdateDiner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DinerService_UpdateDiner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DinerServiceServer).UpdateDiner(ctx, req.(*UpdateDinerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DinerService_DeleteDiner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDinerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DinerServiceServer).DeleteDiner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DinerService_DeleteDiner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DinerServiceServer).DeleteDiner(ctx, req.(*DeleteDinerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DinerService_CheckIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DinerServiceServer).CheckIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DinerService_CheckIn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DinerServiceServer).CheckIn(ctx, req.(*CheckInRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DinerService_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DinerServiceServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DinerService_ListSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DinerServiceServer).ListSessions(ctx, req.(*ListSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DinerService_Checkout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DinerServiceServer).Checkout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DinerService_Checkout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DinerServiceServer).Checkout(ctx, req.(*CheckoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DinerService_ServiceDesc is the grpc.ServiceDesc for DinerService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DinerService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "restaurant.v1.DinerService",
+	HandlerType: (*DinerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateDiner",
+			Handler:    _DinerService_CreateDiner_Handler,
+		},
+		{
+			MethodName: "GetDiner",
+			Handler:    _DinerService_GetDiner_Handler,
+		},
+		{
+			MethodName: "ListDiners",
+			Handler:    _DinerService_ListDiners_Handler,
+		},
+		{
+			MethodName: "UpdateDiner",
+			Handler:    _DinerService_UpdateDiner_Handler,
+		},
+		{
+			MethodName: "DeleteDiner",
+			Handler:    _DinerService_DeleteDiner_Handler,
+		},
+		{
+			MethodName: "CheckIn",
+			Handler:    _DinerService_CheckIn_Handler,
+		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _DinerService_ListSessions_Handler,
+		},
+		{
+			MethodName: "Checkout",
+			Handler:    _DinerService_Checkout_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "restaurant/v1/diner.proto",
+}
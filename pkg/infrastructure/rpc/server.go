@@ -0,0 +1,138 @@
+// Package rpc contains the gRPC server used for the internal communication between services
+package rpc
+
+import (
+	"net"
+	"time"
+
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/logger"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/http"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/reflection"
+)
+
+// Server wraps up *grpc.Server.
+type Server struct {
+	config           *http.ServerConfig
+	listener         net.Listener
+	logger           *logger.Logger
+	server           *grpc.Server
+	health           *health.Server
+	preStartCallback func() error
+}
+
+// NewServer initialises a gRPC server with the reflection and health services, and registers the application
+// services through register. The keep alive of the server config is applied to the gRPC connections.
+func NewServer(c *http.ServerConfig, logger *logger.Logger, preStartCallback func() error, register func(*grpc.Server)) (*Server, error) {
+	defaultServerConfig(c)
+	srv := grpc.NewServer(
+		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime:             c.KeepAlive.EnforcementPolicy.MinTime,
+			PermitWithoutStream: c.KeepAlive.EnforcementPolicy.PermitWithoutStream,
+		}),
+		grpc.KeepaliveParams(keepalive.ServerParameters{
+			MaxConnectionIdle: c.KeepAlive.ServerParameters.IdleTimeout,
+		}),
+		grpc.ChainUnaryInterceptor(
+			UnaryTracerInterceptor(c.Name),
+			UnaryLoggerInterceptor(logger),
+			UnaryRecoveryInterceptor(logger),
+			UnaryErrorInterceptor(),
+		),
+	)
+	if register != nil {
+		register(srv)
+	}
+
+	healthServer := health.NewServer()
+	for name := range srv.GetServiceInfo() {
+		healthServer.SetServingStatus(name, healthpb.HealthCheckResponse_SERVING)
+	}
+	healthpb.RegisterHealthServer(srv, healthServer)
+	reflection.Register(srv)
+
+	return &Server{
+		c,
+		nil,
+		logger,
+		srv,
+		healthServer,
+		preStartCallback,
+	}, nil
+}
+
+// Addr returns the server's network address.
+func (s *Server) Addr() string {
+	return s.config.Address
+}
+
+// GRPCServer returns the internal gRPC server instance.
+func (s *Server) GRPCServer() *grpc.Server {
+	return s.server
+}
+
+// GracefulShutdownHandler is a function that runs before the gRPC server is gracefully shut down.
+func (s *Server) GracefulShutdownHandler() error {
+	return s.config.GracefulShutdownHandler()
+}
+
+// GracefulStop stops the gRPC server gracefully. It reports the services as not serving, stops the server from
+// accepting new connections and blocks until all the pending calls are finished, or stops it forcefully after
+// the shutdown timeout.
+func (s *Server) GracefulStop() error {
+	s.health.Shutdown()
+
+	done := make(chan struct{})
+	go func() {
+		s.server.GracefulStop()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(9 * time.Second):
+		s.server.Stop()
+	}
+	return nil
+}
+
+// PreStartCallback is the callback function to trigger right before the server starts running.
+func (s *Server) PreStartCallback() func() error {
+	return s.preStartCallback
+}
+
+// Serve accepts incoming connections on the server address and serves the gRPC calls. Serve returns when the
+// listener fails with fatal errors, or nil once GracefulStop is called.
+func (s *Server) Serve() error {
+	lis, err := net.Listen("tcp", s.Addr())
+	if err != nil {
+		return err
+	}
+	s.listener = lis
+
+	return s.server.Serve(s.listener)
+}
+
+// Type indicates the protocol of the server.
+func (s *Server) Type() string {
+	return "gRPC"
+}
+
+// TracerProviderShutdownHandler is a function that shuts down the tracer's exporter/provider before
+// the gRPC server is gracefully shut down.
+func (s *Server) TracerProviderShutdownHandler() error {
+	return s.config.TracerProviderShutdownHandler()
+}
+
+func defaultServerConfig(c *http.ServerConfig) {
+	if c.KeepAlive.EnforcementPolicy.MinTime == 0 {
+		c.KeepAlive.EnforcementPolicy.MinTime = 5 * time.Second
+	}
+
+	if c.KeepAlive.ServerParameters.IdleTimeout == 0 {
+		c.KeepAlive.ServerParameters.IdleTimeout = 120 * time.Second
+	}
+}
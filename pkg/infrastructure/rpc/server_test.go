@@ -0,0 +1,208 @@
+package rpc_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	dinerService "github.com/Raj63/golang-rest-api/pkg/app/usecases/diner"
+	menuService "github.com/Raj63/golang-rest-api/pkg/app/usecases/menu"
+	orderService "github.com/Raj63/golang-rest-api/pkg/app/usecases/order"
+	domainDiner "github.com/Raj63/golang-rest-api/pkg/domain/diner"
+	appErr "github.com/Raj63/golang-rest-api/pkg/domain/errors"
+	domainMenu "github.com/Raj63/golang-rest-api/pkg/domain/menu"
+	domainOrder "github.com/Raj63/golang-rest-api/pkg/domain/order"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/logger"
+	mockRepository "github.com/Raj63/golang-rest-api/pkg/infrastructure/mocks/repository"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/repository"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/http"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rpc"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rpc/pb"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rpc/services"
+	"github.com/brianvoe/gofakeit"
+	"github.com/golang/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+type mocks struct {
+	menus  *mockRepository.MockMenus
+	diners *mockRepository.MockDiners
+	orders *mockRepository.MockOrders
+}
+
+// newTestClient serves the gRPC services over the mocked repositories in memory and returns a connection to it.
+func newTestClient(t *testing.T, setup func(m mocks)) *grpc.ClientConn {
+	m := mocks{
+		menus:  mockRepository.NewMockMenus(gomock.NewController(t)),
+		diners: mockRepository.NewMockDiners(gomock.NewController(t)),
+		orders: mockRepository.NewMockOrders(gomock.NewController(t)),
+	}
+	setup(m)
+
+	srv, err := rpc.NewServer(&http.ServerConfig{Name: "test"}, logger.NewLogger(), nil, func(s *grpc.Server) {
+		pb.RegisterMenuServiceServer(s, &services.MenuServer{MenuService: menuService.Service{MenuRepository: m.menus}})
+		pb.RegisterDinerServiceServer(s, &services.DinerServer{DinerService: dinerService.Service{DinerRepository: m.diners}})
+		pb.RegisterOrderServiceServer(s, &services.OrderServer{OrderService: orderService.Service{OrderRepository: m.orders}})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lis := bufconn.Listen(1 << 20)
+	go func() {
+		_ = srv.GRPCServer().Serve(lis)
+	}()
+	t.Cleanup(srv.GRPCServer().Stop)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	return conn
+}
+
+func TestGRPCServices(t *testing.T) {
+	menu := domainMenu.Menu{ID: 1, Name: gofakeit.BeerHop(), Description: gofakeit.BeerName(), Category: "briyani", Price: 200.5, CreatedAt: time.Now()}
+	diner := domainDiner.Diner{ID: 1, Name: gofakeit.Name(), TableNumber: 1, CreatedAt: time.Now()}
+	servedAt := time.Now()
+
+	tests := []struct {
+		name       string
+		mockrepoFn func(m mocks)
+		call       func(ctx context.Context, conn *grpc.ClientConn) error
+		outputCode codes.Code
+	}{
+		{
+			name: "Create a Menu successfully",
+			mockrepoFn: func(m mocks) {
+				m.menus.EXPECT().Create(gomock.Any(), gomock.Any()).Times(1).Return(&menu, nil)
+			},
+			call: func(ctx context.Context, conn *grpc.ClientConn) error {
+				_, err := pb.NewMenuServiceClient(conn).CreateMenu(ctx, &pb.CreateMenuRequest{Name: menu.Name, Description: menu.Description, Price: menu.Price})
+				return err
+			},
+			outputCode: codes.OK,
+		},
+		{
+			name:       "Failed to create a Menu without price",
+			mockrepoFn: func(m mocks) {},
+			call: func(ctx context.Context, conn *grpc.ClientConn) error {
+				_, err := pb.NewMenuServiceClient(conn).CreateMenu(ctx, &pb.CreateMenuRequest{Name: menu.Name, Description: menu.Description})
+				return err
+			},
+			outputCode: codes.InvalidArgument,
+		},
+		{
+			name:       "Failed to create a Menu with a negative price",
+			mockrepoFn: func(m mocks) {},
+			call: func(ctx context.Context, conn *grpc.ClientConn) error {
+				_, err := pb.NewMenuServiceClient(conn).CreateMenu(ctx, &pb.CreateMenuRequest{Name: menu.Name, Description: menu.Description, Price: -10})
+				return err
+			},
+			outputCode: codes.InvalidArgument,
+		},
+		{
+			name: "Failed to get a Menu that does not exist",
+			mockrepoFn: func(m mocks) {
+				m.menus.EXPECT().GetByID(gomock.Any(), int64(42)).Times(1).Return(nil, appErr.NewAppErrorWithType(appErr.NotFound))
+			},
+			call: func(ctx context.Context, conn *grpc.ClientConn) error {
+				_, err := pb.NewMenuServiceClient(conn).GetMenu(ctx, &pb.GetMenuRequest{Id: 42})
+				return err
+			},
+			outputCode: codes.NotFound,
+		},
+		{
+			name: "List Diners by name successfully",
+			mockrepoFn: func(m mocks) {
+				m.diners.EXPECT().SearchPage(gomock.Any(), &repository.DinerFilter{Name: "smith"}, &repository.PageRequest{Limit: 20}).Times(1).Return(&repository.PaginationResultDiner{
+					Data: &[]domainDiner.Diner{diner}, Limit: 20,
+				}, nil)
+			},
+			call: func(ctx context.Context, conn *grpc.ClientConn) error {
+				_, err := pb.NewDinerServiceClient(conn).ListDiners(ctx, &pb.ListDinersRequest{Name: "smith"})
+				return err
+			},
+			outputCode: codes.OK,
+		},
+		{
+			name: "Failed to check in a Diner who is already seated",
+			mockrepoFn: func(m mocks) {
+				m.diners.EXPECT().CheckIn(gomock.Any(), int64(1), 2).Times(1).Return(nil, appErr.NewAppErrorWithType(appErr.ResourceAlreadyExists))
+			},
+			call: func(ctx context.Context, conn *grpc.ClientConn) error {
+				_, err := pb.NewDinerServiceClient(conn).CheckIn(ctx, &pb.CheckInRequest{DinerId: 1, TableNumber: 2})
+				return err
+			},
+			outputCode: codes.AlreadyExists,
+		},
+		{
+			name: "List Orders of a Diner successfully",
+			mockrepoFn: func(m mocks) {
+				m.orders.EXPECT().GetByID(gomock.Any(), int64(1)).Times(1).Return([]domainOrder.Response{
+					{ID: 1, SessionID: 1, DinerID: 1, MenuID: 1, Quantity: 2, ServedAt: &servedAt, CreatedAt: time.Now()},
+				}, nil)
+			},
+			call: func(ctx context.Context, conn *grpc.ClientConn) error {
+				_, err := pb.NewOrderServiceClient(conn).ListOrders(ctx, &pb.ListOrdersRequest{DinerId: 1, History: true})
+				return err
+			},
+			outputCode: codes.OK,
+		},
+		{
+			name:       "Failed to create an Order of more items than allowed",
+			mockrepoFn: func(m mocks) {},
+			call: func(ctx context.Context, conn *grpc.ClientConn) error {
+				_, err := pb.NewOrderServiceClient(conn).CreateOrder(ctx, &pb.CreateOrderRequest{DinerId: 1, MenuId: 1, Quantity: 51})
+				return err
+			},
+			outputCode: codes.InvalidArgument,
+		},
+		{
+			name: "Failed to serve an Order due to repository error",
+			mockrepoFn: func(m mocks) {
+				m.orders.EXPECT().Serve(gomock.Any(), int64(1)).Times(1).Return(appErr.NewAppErrorWithType(appErr.RepositoryError))
+			},
+			call: func(ctx context.Context, conn *grpc.ClientConn) error {
+				_, err := pb.NewOrderServiceClient(conn).ServeOrder(ctx, &pb.ServeOrderRequest{Id: 1})
+				return err
+			},
+			outputCode: codes.Internal,
+		},
+		{
+			name:       "Report the services as serving",
+			mockrepoFn: func(m mocks) {},
+			call: func(ctx context.Context, conn *grpc.ClientConn) error {
+				resp, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{Service: "restaurant.v1.MenuService"})
+				if err == nil && resp.GetStatus() != healthpb.HealthCheckResponse_SERVING {
+					return status.Error(codes.Unavailable, resp.GetStatus().String())
+				}
+				return err
+			},
+			outputCode: codes.OK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn := newTestClient(t, tt.mockrepoFn)
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			err := tt.call(ctx, conn)
+			if code := status.Code(err); code != tt.outputCode {
+				t.Errorf("Service returned wrong status code. Expected: %s. Got: %s (%v).", tt.outputCode, code, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package services
+
+import (
+	"context"
+
+	useCaseDiner "github.com/Raj63/golang-rest-api/pkg/app/usecases/diner"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rpc/mappers"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rpc/pb"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// DinerServer implements the gRPC diner service over the diner use case
+type DinerServer struct {
+	pb.UnimplementedDinerServiceServer
+	DinerService useCaseDiner.Service
+}
+
+// CreateDiner adds a new diner and checks them in at a table
+func (s *DinerServer) CreateDiner(ctx context.Context, req *pb.CreateDinerRequest) (*pb.Diner, error) {
+	switch {
+	case req.GetName() == "":
+		return nil, required("name")
+	case req.GetTableNumber() == 0:
+		return nil, required("table_number")
+	}
+
+	diner, err := s.DinerService.Create(ctx, &useCaseDiner.NewDiner{
+		Name:        req.GetName(),
+		TableNumber: int(req.GetTableNumber()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return mappers.Diner(diner), nil
+}
+
+// GetDiner returns a diner by its ID
+func (s *DinerServer) GetDiner(ctx context.Context, req *pb.GetDinerRequest) (*pb.Diner, error) {
+	diner, err := s.DinerService.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return mappers.Diner(diner), nil
+}
+
+// ListDiners returns a page of diners, optionally searched by name and table number
+func (s *DinerServer) ListDiners(ctx context.Context, req *pb.ListDinersRequest) (*pb.ListDinersResponse, error) {
+	search := useCaseDiner.SearchDiner{
+		Name:        req.GetName(),
+		TableNumber: int(req.GetTableNumber()),
+	}
+	var result *useCaseDiner.PaginationResultDiner
+	var err error
+	if req.GetPage() > 0 && req.GetCursor() == "" {
+		result, err = s.DinerService.GetAll(ctx, req.GetPage(), limitOrDefault(req.GetLimit()), &search)
+	} else {
+		result, err = s.DinerService.GetPage(ctx, &useCaseDiner.PageQuery{
+			Cursor:    req.GetCursor(),
+			Limit:     limitOrDefault(req.GetLimit()),
+			WithTotal: req.GetWithTotal(),
+		}, &search)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return mappers.DinerPage(result), nil
+}
+
+// UpdateDiner fixes the name of a diner or moves them to another table
+func (s *DinerServer) UpdateDiner(ctx context.Context, req *pb.UpdateDinerRequest) (*pb.Diner, error) {
+	update := useCaseDiner.UpdateDiner{Name: req.Name}
+	if req.TableNumber != nil {
+		tableNumber := int(req.GetTableNumber())
+		update.TableNumber = &tableNumber
+	}
+
+	diner, err := s.DinerService.Update(ctx, req.GetId(), &update)
+	if err != nil {
+		return nil, err
+	}
+	return mappers.Diner(diner), nil
+}
+
+// DeleteDiner removes a diner by its ID
+func (s *DinerServer) DeleteDiner(ctx context.Context, req *pb.DeleteDinerRequest) (*emptypb.Empty, error) {
+	if err := s.DinerService.Delete(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// CheckIn opens a new dining session for a returning diner
+func (s *DinerServer) CheckIn(ctx context.Context, req *pb.CheckInRequest) (*pb.Session, error) {
+	if req.GetTableNumber() == 0 {
+		return nil, required("table_number")
+	}
+
+	session, err := s.DinerService.CheckIn(ctx, req.GetDinerId(), int(req.GetTableNumber()))
+	if err != nil {
+		return nil, err
+	}
+	return mappers.Session(session), nil
+}
+
+// ListSessions returns the dining sessions of a diner, latest first
+func (s *DinerServer) ListSessions(ctx context.Context, req *pb.ListSessionsRequest) (*pb.ListSessionsResponse, error) {
+	sessions, err := s.DinerService.GetSessions(ctx, req.GetDinerId())
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.ListSessionsResponse{Sessions: make([]*pb.Session, len(sessions))}
+	for i := range sessions {
+		response.Sessions[i] = mappers.Session(&sessions[i])
+	}
+	return response, nil
+}
+
+// Checkout records the paid bill of the open session of a diner
+func (s *DinerServer) Checkout(ctx context.Context, req *pb.CheckoutRequest) (*pb.Payment, error) {
+	payment, err := s.DinerService.Checkout(ctx, req.GetDinerId())
+	if err != nil {
+		return nil, err
+	}
+	return mappers.Payment(payment), nil
+}
@@ -0,0 +1,105 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	useCaseMenu "github.com/Raj63/golang-rest-api/pkg/app/usecases/menu"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rpc/mappers"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rpc/pb"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const defaultTopCount = 3
+
+// MenuServer implements the gRPC menu service over the menu use case
+type MenuServer struct {
+	pb.UnimplementedMenuServiceServer
+	MenuService useCaseMenu.Service
+}
+
+// CreateMenu adds a new menu
+func (s *MenuServer) CreateMenu(ctx context.Context, req *pb.CreateMenuRequest) (*pb.Menu, error) {
+	switch {
+	case req.GetName() == "":
+		return nil, required("name")
+	case req.GetDescription() == "":
+		return nil, required("description")
+	case req.GetPrice() == 0:
+		return nil, required("price")
+	}
+
+	menu, err := s.MenuService.Create(ctx, &useCaseMenu.NewMenu{
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+		Category:    req.GetCategory(),
+		Price:       req.GetPrice(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return mappers.Menu(menu), nil
+}
+
+// GetMenu returns a menu by its ID
+func (s *MenuServer) GetMenu(ctx context.Context, req *pb.GetMenuRequest) (*pb.Menu, error) {
+	menu, err := s.MenuService.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return mappers.Menu(menu), nil
+}
+
+// ListMenus returns a page of menus
+func (s *MenuServer) ListMenus(ctx context.Context, req *pb.ListMenusRequest) (*pb.ListMenusResponse, error) {
+	var result *useCaseMenu.PaginationResultMenu
+	var err error
+	if req.GetPage() > 0 && req.GetCursor() == "" {
+		result, err = s.MenuService.GetAll(ctx, req.GetPage(), limitOrDefault(req.GetLimit()))
+	} else {
+		result, err = s.MenuService.GetPage(ctx, &useCaseMenu.PageQuery{
+			Cursor:    req.GetCursor(),
+			Limit:     limitOrDefault(req.GetLimit()),
+			WithTotal: req.GetWithTotal(),
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return mappers.MenuPage(result), nil
+}
+
+// ListTopMenus returns the most ordered menus
+func (s *MenuServer) ListTopMenus(ctx context.Context, req *pb.ListTopMenusRequest) (*pb.ListTopMenusResponse, error) {
+	top := useCaseMenu.TopMenus{
+		Count:   int(req.GetCount()),
+		RankBy:  req.GetRankBy(),
+		GroupBy: req.GetGroupBy(),
+	}
+	if top.Count == 0 {
+		top.Count = defaultTopCount
+	}
+	if req.GetFrom() != nil {
+		from := req.GetFrom().AsTime().In(time.Local)
+		top.From = &from
+	}
+	if req.GetTo() != nil {
+		to := req.GetTo().AsTime().In(time.Local)
+		top.To = &to
+	}
+
+	menus, err := s.MenuService.GetByTopCount(ctx, &top)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListTopMenusResponse{Menus: mappers.Menus(menus)}, nil
+}
+
+// DeleteMenu removes a menu by its ID
+func (s *MenuServer) DeleteMenu(ctx context.Context, req *pb.DeleteMenuRequest) (*emptypb.Empty, error) {
+	if err := s.MenuService.Delete(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
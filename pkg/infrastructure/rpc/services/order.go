@@ -0,0 +1,75 @@
+package services
+
+import (
+	"context"
+
+	useCaseOrder "github.com/Raj63/golang-rest-api/pkg/app/usecases/order"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rpc/mappers"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rpc/pb"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// OrderServer implements the gRPC order service over the order use case
+type OrderServer struct {
+	pb.UnimplementedOrderServiceServer
+	OrderService useCaseOrder.Service
+}
+
+// CreateOrder places an order on the open session of a diner
+func (s *OrderServer) CreateOrder(ctx context.Context, req *pb.CreateOrderRequest) (*pb.Order, error) {
+	switch {
+	case req.GetDinerId() == 0:
+		return nil, required("diner_id")
+	case req.GetMenuId() == 0:
+		return nil, required("menu_id")
+	case req.GetQuantity() == 0:
+		return nil, required("quantity")
+	}
+
+	order, err := s.OrderService.Create(ctx, &useCaseOrder.NewOrder{
+		DinnerID: req.GetDinerId(),
+		MenuID:   req.GetMenuId(),
+		Quantity: int(req.GetQuantity()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Order{
+		Id:        order.ID,
+		DinerId:   req.GetDinerId(),
+		MenuId:    req.GetMenuId(),
+		Quantity:  req.GetQuantity(),
+		CreatedAt: mappers.Timestamp(order.CreatedAt),
+	}, nil
+}
+
+// ListOrders returns the orders of a diner
+func (s *OrderServer) ListOrders(ctx context.Context, req *pb.ListOrdersRequest) (*pb.ListOrdersResponse, error) {
+	orders, err := s.OrderService.GetByDinerID(ctx, req.GetDinerId(), req.GetHistory())
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.ListOrdersResponse{Orders: make([]*pb.Order, len(orders))}
+	for i := range orders {
+		response.Orders[i] = mappers.Order(&orders[i])
+	}
+	return response, nil
+}
+
+// ServeOrder marks an order as served
+func (s *OrderServer) ServeOrder(ctx context.Context, req *pb.ServeOrderRequest) (*emptypb.Empty, error) {
+	if err := s.OrderService.Serve(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// DeleteOrder removes an order by its ID
+func (s *OrderServer) DeleteOrder(ctx context.Context, req *pb.DeleteOrderRequest) (*emptypb.Empty, error) {
+	if err := s.OrderService.Delete(ctx, int(req.GetId())); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,34 @@
+// Package services contains the gRPC services over the application use cases
+package services
+
+import (
+	"fmt"
+
+	domainErrors "github.com/Raj63/golang-rest-api/pkg/domain/errors"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/logger"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/adapter"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rpc/pb"
+	sdksql "github.com/Raj63/golang-rest-api/pkg/infrastructure/sql"
+	"google.golang.org/grpc"
+)
+
+const defaultLimit = 20
+
+// Register registers the menu, diner and order services on the gRPC server, wired to the same use cases as the
+// REST controllers
+func Register(server *grpc.Server, db *sdksql.DB, logger *logger.Logger) {
+	pb.RegisterMenuServiceServer(server, &MenuServer{MenuService: adapter.MenuAdapter(db, logger).MenuService})
+	pb.RegisterDinerServiceServer(server, &DinerServer{DinerService: adapter.DinerAdapter(db, logger).DinerService})
+	pb.RegisterOrderServiceServer(server, &OrderServer{OrderService: adapter.OrderAdapter(db, logger).OrderService})
+}
+
+func required(field string) error {
+	return domainErrors.NewAppError(fmt.Errorf("%s is required", field), domainErrors.ValidationError)
+}
+
+func limitOrDefault(limit int64) int64 {
+	if limit == 0 {
+		return defaultLimit
+	}
+	return limit
+}
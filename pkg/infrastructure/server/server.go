@@ -6,7 +6,9 @@ import (
 	"github.com/Raj63/golang-rest-api/pkg/infrastructure/config"
 	"github.com/Raj63/golang-rest-api/pkg/infrastructure/logger"
 	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rest/http"
+	"github.com/Raj63/golang-rest-api/pkg/infrastructure/rpc"
 	"github.com/Raj63/golang-rest-api/pkg/infrastructure/tracer"
+	"google.golang.org/grpc"
 )
 
 // DI is used to inject the server dependencies
@@ -17,9 +19,10 @@ type DI struct {
 	TracerProvider                                *tracer.Provider
 	TracerProviderShutdownHandler, PreRunCallback func() error
 	Handler                                       nethttp.Handler
+	RegisterServices                              func(*grpc.Server)
 }
 
-// NewServer initialises the gRPC server.
+// NewServer initialises the HTTP server.
 func NewServer(di DI) (*http.Server, error) {
 	return http.NewServer(
 		&http.ServerConfig{
@@ -36,3 +39,21 @@ func NewServer(di DI) (*http.Server, error) {
 		di.Handler,
 	)
 }
+
+// NewGRPCServer initialises the gRPC server.
+func NewGRPCServer(di DI) (*rpc.Server, error) {
+	return rpc.NewServer(
+		&http.ServerConfig{
+			Address: di.Address,
+			GracefulShutdownHandler: func() error {
+				return nil
+			},
+			Name:                          di.Config.ServiceName,
+			TracerProvider:                di.TracerProvider,
+			TracerProviderShutdownHandler: di.TracerProviderShutdownHandler,
+		},
+		di.Logger,
+		di.PreRunCallback,
+		di.RegisterServices,
+	)
+}
@@ -0,0 +1,113 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// Querier is the part of sqlx.DB and sqlx.Tx the repositories run their queries with
+type Querier interface {
+	sqlx.ExtContext
+	Get(dest interface{}, query string, args ...interface{}) error
+	Select(dest interface{}, query string, args ...interface{}) error
+	Queryx(query string, args ...interface{}) (*sqlx.Rows, error)
+	GetContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error
+	SelectContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error
+	NamedExecContext(ctx context.Context, query string, arg interface{}) (sql.Result, error)
+}
+
+// Tx is a transaction begun by Begin or BeginTx
+type Tx interface {
+	Querier
+	PrepareNamedContext(ctx context.Context, query string) (*sqlx.NamedStmt, error)
+	Commit() error
+	Rollback() error
+}
+
+// ErrRolledBack is returned by Transaction when a transaction begun within it was rolled back while fn succeeded
+var ErrRolledBack = errors.New("transaction rolled back")
+
+type txKey struct{}
+
+// sharedTx is the transaction a context carries, all the queries run with the context and all the transactions
+// begun with it are part of
+type sharedTx struct {
+	*sqlx.Tx
+	rollbackOnly bool
+}
+
+// joinedTx is a transaction begun with a context carrying a shared transaction: it is only committed or rolled back
+// with the shared one, and rolling it back dooms the shared one
+type joinedTx struct {
+	*sharedTx
+}
+
+// Commit leaves the commit to the shared transaction
+func (tx joinedTx) Commit() error {
+	return nil
+}
+
+// Rollback marks the shared transaction to be rolled back
+func (tx joinedTx) Rollback() error {
+	tx.rollbackOnly = true
+	return nil
+}
+
+// Querier returns the transaction the context carries, if any, and the database otherwise
+func (db *DB) Querier(ctx context.Context) Querier {
+	if tx, ok := ctx.Value(txKey{}).(*sharedTx); ok {
+		return tx.Tx
+	}
+	return db.master
+}
+
+// Begin begins a transaction, or joins the transaction the context carries
+func (db *DB) Begin(ctx context.Context) (Tx, error) {
+	if tx, ok := ctx.Value(txKey{}).(*sharedTx); ok {
+		return joinedTx{tx}, nil
+	}
+	tx, err := db.master.Beginx()
+	if err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// BeginTx begins a transaction with the options, or joins the transaction the context carries
+func (db *DB) BeginTx(ctx context.Context, opts *sql.TxOptions) (Tx, error) {
+	if tx, ok := ctx.Value(txKey{}).(*sharedTx); ok {
+		return joinedTx{tx}, nil
+	}
+	tx, err := db.master.BeginTxx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// Transaction runs fn with a context carrying a transaction, that the queries run with it are part of, and commits
+// the transaction when fn succeeds. The transaction is rolled back when fn fails, or when a transaction begun within
+// it was rolled back, in which case ErrRolledBack is returned.
+func (db *DB) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(*sharedTx); ok {
+		return fn(ctx)
+	}
+
+	sqlTx, err := db.master.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	tx := &sharedTx{Tx: sqlTx}
+	if err := fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		_ = tx.Tx.Rollback()
+		return err
+	}
+	if tx.rollbackOnly {
+		_ = tx.Tx.Rollback()
+		return ErrRolledBack
+	}
+	return tx.Tx.Commit()
+}